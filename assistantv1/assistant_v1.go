@@ -22,6 +22,7 @@ import (
 	"github.com/edwindvinas/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/edwindvinas/go-sdk/common"
+	"net/http"
 )
 
 // AssistantV1 : The IBM Watson&trade; Assistant service combines machine learning, natural language understanding, and
@@ -43,6 +44,7 @@ type AssistantV1Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewAssistantV1 : Instantiate AssistantV1
@@ -57,7 +59,7 @@ func NewAssistantV1(options *AssistantV1Options) (service *AssistantV1, err erro
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("conversation")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("conversation")
 		if err != nil {
 			return
 		}
@@ -68,6 +70,10 @@ func NewAssistantV1(options *AssistantV1Options) (service *AssistantV1, err erro
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &AssistantV1{
 		Service: baseService,
 		Version: options.Version,
@@ -160,6 +166,7 @@ func (assistant *AssistantV1) Message(messageOptions *MessageOptions) (result *M
 	}
 
 	response, err = assistant.Service.Request(request, new(MessageResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MessageResponse)
@@ -221,6 +228,7 @@ func (assistant *AssistantV1) ListWorkspaces(listWorkspacesOptions *ListWorkspac
 	}
 
 	response, err = assistant.Service.Request(request, new(WorkspaceCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*WorkspaceCollection)
@@ -310,6 +318,7 @@ func (assistant *AssistantV1) CreateWorkspace(createWorkspaceOptions *CreateWork
 	}
 
 	response, err = assistant.Service.Request(request, new(Workspace))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Workspace)
@@ -373,6 +382,7 @@ func (assistant *AssistantV1) GetWorkspace(getWorkspaceOptions *GetWorkspaceOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(Workspace))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Workspace)
@@ -470,6 +480,7 @@ func (assistant *AssistantV1) UpdateWorkspace(updateWorkspaceOptions *UpdateWork
 	}
 
 	response, err = assistant.Service.Request(request, new(Workspace))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Workspace)
@@ -522,6 +533,7 @@ func (assistant *AssistantV1) DeleteWorkspace(deleteWorkspaceOptions *DeleteWork
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -584,6 +596,7 @@ func (assistant *AssistantV1) ListIntents(listIntentsOptions *ListIntentsOptions
 	}
 
 	response, err = assistant.Service.Request(request, new(IntentCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*IntentCollection)
@@ -655,6 +668,7 @@ func (assistant *AssistantV1) CreateIntent(createIntentOptions *CreateIntentOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(Intent))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Intent)
@@ -715,6 +729,7 @@ func (assistant *AssistantV1) GetIntent(getIntentOptions *GetIntentOptions) (res
 	}
 
 	response, err = assistant.Service.Request(request, new(Intent))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Intent)
@@ -787,6 +802,7 @@ func (assistant *AssistantV1) UpdateIntent(updateIntentOptions *UpdateIntentOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(Intent))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Intent)
@@ -839,6 +855,7 @@ func (assistant *AssistantV1) DeleteIntent(deleteIntentOptions *DeleteIntentOpti
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -897,6 +914,7 @@ func (assistant *AssistantV1) ListExamples(listExamplesOptions *ListExamplesOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(ExampleCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ExampleCollection)
@@ -965,6 +983,7 @@ func (assistant *AssistantV1) CreateExample(createExampleOptions *CreateExampleO
 	}
 
 	response, err = assistant.Service.Request(request, new(Example))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Example)
@@ -1021,6 +1040,7 @@ func (assistant *AssistantV1) GetExample(getExampleOptions *GetExampleOptions) (
 	}
 
 	response, err = assistant.Service.Request(request, new(Example))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Example)
@@ -1089,6 +1109,7 @@ func (assistant *AssistantV1) UpdateExample(updateExampleOptions *UpdateExampleO
 	}
 
 	response, err = assistant.Service.Request(request, new(Example))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Example)
@@ -1141,6 +1162,7 @@ func (assistant *AssistantV1) DeleteExample(deleteExampleOptions *DeleteExampleO
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1199,6 +1221,7 @@ func (assistant *AssistantV1) ListCounterexamples(listCounterexamplesOptions *Li
 	}
 
 	response, err = assistant.Service.Request(request, new(CounterexampleCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CounterexampleCollection)
@@ -1264,6 +1287,7 @@ func (assistant *AssistantV1) CreateCounterexample(createCounterexampleOptions *
 	}
 
 	response, err = assistant.Service.Request(request, new(Counterexample))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Counterexample)
@@ -1320,6 +1344,7 @@ func (assistant *AssistantV1) GetCounterexample(getCounterexampleOptions *GetCou
 	}
 
 	response, err = assistant.Service.Request(request, new(Counterexample))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Counterexample)
@@ -1385,6 +1410,7 @@ func (assistant *AssistantV1) UpdateCounterexample(updateCounterexampleOptions *
 	}
 
 	response, err = assistant.Service.Request(request, new(Counterexample))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Counterexample)
@@ -1437,6 +1463,7 @@ func (assistant *AssistantV1) DeleteCounterexample(deleteCounterexampleOptions *
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1499,6 +1526,7 @@ func (assistant *AssistantV1) ListEntities(listEntitiesOptions *ListEntitiesOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(EntityCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*EntityCollection)
@@ -1576,6 +1604,7 @@ func (assistant *AssistantV1) CreateEntity(createEntityOptions *CreateEntityOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(Entity))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Entity)
@@ -1636,6 +1665,7 @@ func (assistant *AssistantV1) GetEntity(getEntityOptions *GetEntityOptions) (res
 	}
 
 	response, err = assistant.Service.Request(request, new(Entity))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Entity)
@@ -1714,6 +1744,7 @@ func (assistant *AssistantV1) UpdateEntity(updateEntityOptions *UpdateEntityOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(Entity))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Entity)
@@ -1766,6 +1797,7 @@ func (assistant *AssistantV1) DeleteEntity(deleteEntityOptions *DeleteEntityOpti
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1819,6 +1851,7 @@ func (assistant *AssistantV1) ListMentions(listMentionsOptions *ListMentionsOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(EntityMentionCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*EntityMentionCollection)
@@ -1887,6 +1920,7 @@ func (assistant *AssistantV1) ListValues(listValuesOptions *ListValuesOptions) (
 	}
 
 	response, err = assistant.Service.Request(request, new(ValueCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ValueCollection)
@@ -1964,6 +1998,7 @@ func (assistant *AssistantV1) CreateValue(createValueOptions *CreateValueOptions
 	}
 
 	response, err = assistant.Service.Request(request, new(Value))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Value)
@@ -2023,6 +2058,7 @@ func (assistant *AssistantV1) GetValue(getValueOptions *GetValueOptions) (result
 	}
 
 	response, err = assistant.Service.Request(request, new(Value))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Value)
@@ -2101,6 +2137,7 @@ func (assistant *AssistantV1) UpdateValue(updateValueOptions *UpdateValueOptions
 	}
 
 	response, err = assistant.Service.Request(request, new(Value))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Value)
@@ -2153,6 +2190,7 @@ func (assistant *AssistantV1) DeleteValue(deleteValueOptions *DeleteValueOptions
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2211,6 +2249,7 @@ func (assistant *AssistantV1) ListSynonyms(listSynonymsOptions *ListSynonymsOpti
 	}
 
 	response, err = assistant.Service.Request(request, new(SynonymCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SynonymCollection)
@@ -2276,6 +2315,7 @@ func (assistant *AssistantV1) CreateSynonym(createSynonymOptions *CreateSynonymO
 	}
 
 	response, err = assistant.Service.Request(request, new(Synonym))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Synonym)
@@ -2332,6 +2372,7 @@ func (assistant *AssistantV1) GetSynonym(getSynonymOptions *GetSynonymOptions) (
 	}
 
 	response, err = assistant.Service.Request(request, new(Synonym))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Synonym)
@@ -2397,6 +2438,7 @@ func (assistant *AssistantV1) UpdateSynonym(updateSynonymOptions *UpdateSynonymO
 	}
 
 	response, err = assistant.Service.Request(request, new(Synonym))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Synonym)
@@ -2449,6 +2491,7 @@ func (assistant *AssistantV1) DeleteSynonym(deleteSynonymOptions *DeleteSynonymO
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2507,6 +2550,7 @@ func (assistant *AssistantV1) ListDialogNodes(listDialogNodesOptions *ListDialog
 	}
 
 	response, err = assistant.Service.Request(request, new(DialogNodeCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DialogNodeCollection)
@@ -2626,6 +2670,7 @@ func (assistant *AssistantV1) CreateDialogNode(createDialogNodeOptions *CreateDi
 	}
 
 	response, err = assistant.Service.Request(request, new(DialogNode))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DialogNode)
@@ -2682,6 +2727,7 @@ func (assistant *AssistantV1) GetDialogNode(getDialogNodeOptions *GetDialogNodeO
 	}
 
 	response, err = assistant.Service.Request(request, new(DialogNode))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DialogNode)
@@ -2801,6 +2847,7 @@ func (assistant *AssistantV1) UpdateDialogNode(updateDialogNodeOptions *UpdateDi
 	}
 
 	response, err = assistant.Service.Request(request, new(DialogNode))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DialogNode)
@@ -2853,6 +2900,7 @@ func (assistant *AssistantV1) DeleteDialogNode(deleteDialogNodeOptions *DeleteDi
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2912,6 +2960,7 @@ func (assistant *AssistantV1) ListLogs(listLogsOptions *ListLogsOptions) (result
 	}
 
 	response, err = assistant.Service.Request(request, new(LogCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LogCollection)
@@ -2976,6 +3025,7 @@ func (assistant *AssistantV1) ListAllLogs(listAllLogsOptions *ListAllLogsOptions
 	}
 
 	response, err = assistant.Service.Request(request, new(LogCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LogCollection)
@@ -3033,6 +3083,7 @@ func (assistant *AssistantV1) DeleteUserData(deleteUserDataOptions *DeleteUserDa
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
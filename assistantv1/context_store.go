@@ -0,0 +1,185 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// ContextStore : Saves the conversation context of each user between calls to Message
+type ContextStore interface {
+
+	// Load returns the saved context of a user, or nil if there is none.
+	Load(userID string) (*Context, error)
+
+	// Save saves the context of a user, replacing the saved context.
+	Save(userID string, context *Context) error
+
+	// Delete removes the saved context of a user, which starts a new conversation.
+	Delete(userID string) error
+}
+
+// MemoryContextStore : A ContextStore that keeps contexts in memory. Contexts are copied as they are saved and loaded.
+type MemoryContextStore struct {
+	ttl      time.Duration
+	mutex    sync.Mutex
+	contexts map[string]memoryContext
+}
+
+type memoryContext struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewMemoryContextStore : Instantiate MemoryContextStore. Contexts that have not been saved for the ttl are
+// discarded; a ttl of 0 keeps them until they are deleted.
+func NewMemoryContextStore(ttl time.Duration) *MemoryContextStore {
+	return &MemoryContextStore{
+		ttl:      ttl,
+		contexts: make(map[string]memoryContext),
+	}
+}
+
+// Load : Returns the saved context of a user, or nil if there is none
+func (store *MemoryContextStore) Load(userID string) (*Context, error) {
+	store.mutex.Lock()
+	saved, ok := store.contexts[userID]
+	if ok && !saved.expires.IsZero() && time.Now().After(saved.expires) {
+		delete(store.contexts, userID)
+		ok = false
+	}
+	store.mutex.Unlock()
+	if !ok {
+		return nil, nil
+	}
+	return decodeContext(saved.data)
+}
+
+// Save : Saves the context of a user
+func (store *MemoryContextStore) Save(userID string, context *Context) error {
+	data, err := json.Marshal(context)
+	if err != nil {
+		return err
+	}
+	saved := memoryContext{data: data}
+	if store.ttl > 0 {
+		saved.expires = time.Now().Add(store.ttl)
+	}
+	store.mutex.Lock()
+	store.contexts[userID] = saved
+	store.mutex.Unlock()
+	return nil
+}
+
+// Delete : Removes the saved context of a user
+func (store *MemoryContextStore) Delete(userID string) error {
+	store.mutex.Lock()
+	delete(store.contexts, userID)
+	store.mutex.Unlock()
+	return nil
+}
+
+// KeyValueClient : The operations of a key-value database, such as Redis, that KeyValueContextStore uses
+type KeyValueClient interface {
+
+	// Get returns the value of a key, or nil and no error if the key does not exist.
+	Get(key string) ([]byte, error)
+
+	// Set sets the value of a key, which expires after the expiration, or never if the expiration is 0.
+	Set(key string, value []byte, expiration time.Duration) error
+
+	// Del removes a key.
+	Del(key string) error
+}
+
+// KeyValueContextStore : A ContextStore that saves contexts as JSON in a key-value database, so that they are shared by
+// the instances of an application
+type KeyValueContextStore struct {
+	client KeyValueClient
+	prefix string
+	ttl    time.Duration
+}
+
+// NewKeyValueContextStore : Instantiate KeyValueContextStore. The key of each context is the prefix followed by the
+// user ID, and it expires after the ttl, or never if the ttl is 0.
+func NewKeyValueContextStore(client KeyValueClient, prefix string, ttl time.Duration) *KeyValueContextStore {
+	return &KeyValueContextStore{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+// Load : Returns the saved context of a user, or nil if there is none
+func (store *KeyValueContextStore) Load(userID string) (*Context, error) {
+	data, err := store.client.Get(store.prefix + userID)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return decodeContext(data)
+}
+
+// Save : Saves the context of a user
+func (store *KeyValueContextStore) Save(userID string, context *Context) error {
+	data, err := json.Marshal(context)
+	if err != nil {
+		return err
+	}
+	return store.client.Set(store.prefix+userID, data, store.ttl)
+}
+
+// Delete : Removes the saved context of a user
+func (store *KeyValueContextStore) Delete(userID string) error {
+	return store.client.Del(store.prefix + userID)
+}
+
+func decodeContext(data []byte) (*Context, error) {
+	context := new(Context)
+	if err := json.Unmarshal(data, context); err != nil {
+		return nil, err
+	}
+	return context, nil
+}
+
+// MessageForUser : Get response to user input, continuing the conversation of a user
+// Unless the options have a context, the saved context of the user is sent with the input. The context of the response
+// is saved for the next call.
+func (assistant *AssistantV1) MessageForUser(store ContextStore, userID string, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(messageOptions, "messageOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	options := *messageOptions
+	if options.Context == nil {
+		if options.Context, err = store.Load(userID); err != nil {
+			return
+		}
+	}
+
+	result, response, err = assistant.Message(&options)
+	if err != nil {
+		return
+	}
+	if result.Context != nil {
+		err = store.Save(userID, result.Context)
+	}
+	return
+}
@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/assistantv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// mapClient : A KeyValueClient that keeps values in a map
+type mapClient struct {
+	values      map[string][]byte
+	expirations map[string]time.Duration
+}
+
+func (client *mapClient) Get(key string) ([]byte, error) {
+	return client.values[key], nil
+}
+
+func (client *mapClient) Set(key string, value []byte, expiration time.Duration) error {
+	client.values[key] = value
+	client.expirations[key] = expiration
+	return nil
+}
+
+func (client *mapClient) Del(key string) error {
+	delete(client.values, key)
+	return nil
+}
+
+var _ = Describe(`ContextStore`, func() {
+	version := "exampleString"
+	bearerToken := "0ui9876453"
+	workspaceID := "exampleString"
+	Describe(`NewMemoryContextStore(ttl time.Duration)`, func() {
+		It(`Succeed to save and load contexts`, func() {
+			store := assistantv1.NewMemoryContextStore(0)
+			context, err := store.Load("user1")
+			Expect(err).To(BeNil())
+			Expect(context).To(BeNil())
+
+			Expect(store.Save("user1", &assistantv1.Context{"conversation_id": "conversation1"})).To(Succeed())
+			context, err = store.Load("user1")
+			Expect(err).To(BeNil())
+			Expect((*context)["conversation_id"]).To(Equal("conversation1"))
+
+			Expect(store.Delete("user1")).To(Succeed())
+			context, err = store.Load("user1")
+			Expect(err).To(BeNil())
+			Expect(context).To(BeNil())
+		})
+		It(`Succeed to expire contexts`, func() {
+			store := assistantv1.NewMemoryContextStore(time.Millisecond)
+			Expect(store.Save("user1", &assistantv1.Context{"conversation_id": "conversation1"})).To(Succeed())
+			time.Sleep(5 * time.Millisecond)
+			context, err := store.Load("user1")
+			Expect(err).To(BeNil())
+			Expect(context).To(BeNil())
+		})
+	})
+	Describe(`NewKeyValueContextStore(client KeyValueClient, prefix string, ttl time.Duration)`, func() {
+		It(`Succeed to save and load contexts`, func() {
+			client := &mapClient{values: make(map[string][]byte), expirations: make(map[string]time.Duration)}
+			store := assistantv1.NewKeyValueContextStore(client, "assistant:", time.Hour)
+
+			Expect(store.Save("user1", &assistantv1.Context{"conversation_id": "conversation1"})).To(Succeed())
+			Expect(client.values).To(HaveKey("assistant:user1"))
+			Expect(client.expirations["assistant:user1"]).To(Equal(time.Hour))
+
+			context, err := store.Load("user1")
+			Expect(err).To(BeNil())
+			Expect((*context)["conversation_id"]).To(Equal("conversation1"))
+
+			Expect(store.Delete("user1")).To(Succeed())
+			context, err = store.Load("user1")
+			Expect(err).To(BeNil())
+			Expect(context).To(BeNil())
+		})
+	})
+	Describe(`MessageForUser(store ContextStore, userID string, messageOptions *MessageOptions)`, func() {
+		Context(`Successfully - Continue the conversation of a user`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal("/v1/workspaces/" + workspaceID + "/message"))
+				var body struct {
+					Context map[string]interface{} `json:"context"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				turn := 0.0
+				if body.Context != nil {
+					turn = body.Context["turn"].(float64)
+				}
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"input": {}, "intents": [], "entities": [], "context": {"conversation_id": "conversation1", "turn": %v}, "output": {"text": []}}`, turn+1)
+			}))
+			It(`Succeed to call MessageForUser`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				store := assistantv1.NewMemoryContextStore(0)
+				for turn := 1.0; turn <= 3; turn++ {
+					result, response, operationErr := testService.MessageForUser(store, "user1", testService.NewMessageOptions(workspaceID))
+					Expect(operationErr).To(BeNil())
+					Expect(response).ToNot(BeNil())
+					Expect((*result.Context)["turn"]).To(Equal(turn))
+				}
+
+				context, err := store.Load("user1")
+				Expect(err).To(BeNil())
+				Expect((*context)["turn"]).To(Equal(3.0))
+			})
+		})
+	})
+})
@@ -0,0 +1,265 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// DEFAULT_INTENT_IMPORT_BATCH_SIZE is the number of examples that ImportIntents sends in one request by default.
+const DEFAULT_INTENT_IMPORT_BATCH_SIZE = 500
+
+const (
+	maxIntentNameLength  = 128
+	maxExampleTextLength = 1024
+)
+
+var intentNamePattern = regexp.MustCompile(`^[\p{L}\p{N}_.-]+$`)
+
+// IntentRowError : A row of intent training data that was not imported
+type IntentRowError struct {
+
+	// The number of the row, starting at 1.
+	Row int
+
+	// The example text of the row.
+	Example string
+
+	// The intent name of the row.
+	Intent string
+
+	// Why the row was not imported.
+	Message string
+}
+
+// Error : Returns the row number and the reason the row was not imported
+func (rowError IntentRowError) Error() string {
+	return fmt.Sprintf("row %d: %s", rowError.Row, rowError.Message)
+}
+
+// ReadIntentsCSV : Reads intent training data in the CSV format of the Watson Assistant tool
+// Each row has an example in the first column and the name of its intent in the second column. Examples are grouped
+// by intent, in the order in which the intents first appear. Rows that are not valid training data, including duplicate
+// examples of an intent, are returned as row errors; an error is returned only if the data cannot be read.
+func ReadIntentsCSV(reader io.Reader) (intents []CreateIntent, rowErrors []IntentRowError, err error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	indexes := make(map[string]int)
+	examples := make(map[string]bool)
+	for row := 1; ; row++ {
+		record, readErr := csvReader.Read()
+		if readErr == io.EOF {
+			return
+		}
+		if parseErr, ok := readErr.(*csv.ParseError); ok {
+			rowErrors = append(rowErrors, IntentRowError{Row: row, Message: parseErr.Err.Error()})
+			continue
+		}
+		if readErr != nil {
+			err = readErr
+			return
+		}
+
+		rowError := IntentRowError{Row: row}
+		if len(record) != 2 {
+			rowError.Message = fmt.Sprintf("expected 2 columns, found %d", len(record))
+			rowErrors = append(rowErrors, rowError)
+			continue
+		}
+		rowError.Example = strings.TrimSpace(record[0])
+		rowError.Intent = strings.TrimSpace(record[1])
+		if row == 1 && strings.EqualFold(rowError.Example, "example") && strings.EqualFold(rowError.Intent, "intent") {
+			continue
+		}
+		if rowError.Message = validateIntentRow(rowError.Example, rowError.Intent); rowError.Message == "" {
+			key := rowError.Intent + "\x00" + rowError.Example
+			if examples[key] {
+				rowError.Message = "duplicate example of the intent"
+			}
+			examples[key] = true
+		}
+		if rowError.Message != "" {
+			rowErrors = append(rowErrors, rowError)
+			continue
+		}
+
+		index, ok := indexes[rowError.Intent]
+		if !ok {
+			index = len(intents)
+			indexes[rowError.Intent] = index
+			intents = append(intents, CreateIntent{Intent: core.StringPtr(rowError.Intent)})
+		}
+		intents[index].Examples = append(intents[index].Examples, Example{Text: core.StringPtr(rowError.Example)})
+	}
+}
+
+// validateIntentRow : Returns why an example and intent name are not valid training data, or an empty string
+func validateIntentRow(example string, intent string) string {
+	switch {
+	case example == "":
+		return "example is empty"
+	case strings.ContainsAny(example, "\r\n\t"):
+		return "example contains a carriage return, newline, or tab character"
+	case utf8.RuneCountInString(example) > maxExampleTextLength:
+		return fmt.Sprintf("example is longer than %d characters", maxExampleTextLength)
+	case intent == "":
+		return "intent is empty"
+	case !intentNamePattern.MatchString(intent):
+		return fmt.Sprintf("intent '%s' contains characters other than letters, digits, underscores, hyphens, and dots", intent)
+	case strings.HasPrefix(intent, "sys-"):
+		return fmt.Sprintf("intent '%s' begins with the reserved prefix 'sys-'", intent)
+	case utf8.RuneCountInString(intent) > maxIntentNameLength:
+		return fmt.Sprintf("intent '%s' is longer than %d characters", intent, maxIntentNameLength)
+	}
+	return ""
+}
+
+// ImportIntentsOptions : The ImportIntents options.
+type ImportIntentsOptions struct {
+
+	// Unique identifier of the workspace.
+	WorkspaceID *string `json:"workspace_id" validate:"required"`
+
+	// Intent training data in the CSV format of the Watson Assistant tool, as read by ReadIntentsCSV.
+	Data io.Reader `json:"-" validate:"required"`
+
+	// The number of examples to send in each request. Defaults to DEFAULT_INTENT_IMPORT_BATCH_SIZE.
+	BatchSize *int64 `json:"batch_size,omitempty"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewImportIntentsOptions : Instantiate ImportIntentsOptions
+func (assistant *AssistantV1) NewImportIntentsOptions(workspaceID string, data io.Reader) *ImportIntentsOptions {
+	return &ImportIntentsOptions{
+		WorkspaceID: core.StringPtr(workspaceID),
+		Data:        data,
+	}
+}
+
+// SetWorkspaceID : Allow user to set WorkspaceID
+func (options *ImportIntentsOptions) SetWorkspaceID(workspaceID string) *ImportIntentsOptions {
+	options.WorkspaceID = core.StringPtr(workspaceID)
+	return options
+}
+
+// SetData : Allow user to set Data
+func (options *ImportIntentsOptions) SetData(data io.Reader) *ImportIntentsOptions {
+	options.Data = data
+	return options
+}
+
+// SetBatchSize : Allow user to set BatchSize
+func (options *ImportIntentsOptions) SetBatchSize(batchSize int64) *ImportIntentsOptions {
+	options.BatchSize = core.Int64Ptr(batchSize)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ImportIntentsOptions) SetHeaders(param map[string]string) *ImportIntentsOptions {
+	options.Headers = param
+	return options
+}
+
+// IntentImportReport : The result of ImportIntents
+type IntentImportReport struct {
+
+	// The number of intents that were created or updated.
+	Intents int
+
+	// The number of examples that were imported.
+	Examples int
+
+	// The rows that were not imported because they are not valid training data.
+	RowErrors []IntentRowError
+}
+
+// ImportIntents : Import intents from CSV
+// Reads intent training data with ReadIntentsCSV and adds it to a workspace, creating the intents that do not exist and
+// adding examples to those that do. The examples are sent in batches with UpdateWorkspace, so each batch counts toward
+// its rate limit. If a batch fails, the report describes the batches that were imported before it.
+func (assistant *AssistantV1) ImportIntents(importIntentsOptions *ImportIntentsOptions) (report *IntentImportReport, err error) {
+	err = core.ValidateNotNil(importIntentsOptions, "importIntentsOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(importIntentsOptions, "importIntentsOptions")
+	if err != nil {
+		return
+	}
+	batchSize := DEFAULT_INTENT_IMPORT_BATCH_SIZE
+	if importIntentsOptions.BatchSize != nil && *importIntentsOptions.BatchSize > 0 {
+		batchSize = int(*importIntentsOptions.BatchSize)
+	}
+
+	intents, rowErrors, err := ReadIntentsCSV(importIntentsOptions.Data)
+	if err != nil {
+		return
+	}
+	report = &IntentImportReport{RowErrors: rowErrors}
+	imported := make(map[string]bool)
+	for _, batch := range batchIntents(intents, batchSize) {
+		updateWorkspaceOptions := assistant.NewUpdateWorkspaceOptions(*importIntentsOptions.WorkspaceID).
+			SetIntents(batch).
+			SetAppend(true).
+			SetHeaders(importIntentsOptions.Headers)
+		if _, _, err = assistant.UpdateWorkspace(updateWorkspaceOptions); err != nil {
+			return
+		}
+		for _, intent := range batch {
+			imported[*intent.Intent] = true
+			report.Examples += len(intent.Examples)
+		}
+		report.Intents = len(imported)
+	}
+	return
+}
+
+// batchIntents : Divides intents into batches of at most batchSize examples. The examples of an intent that does not
+// fit in one batch are divided between batches.
+func batchIntents(intents []CreateIntent, batchSize int) (batches [][]CreateIntent) {
+	var batch []CreateIntent
+	size := 0
+	for _, intent := range intents {
+		examples := intent.Examples
+		for len(examples) > 0 {
+			if size == batchSize {
+				batches = append(batches, batch)
+				batch, size = nil, 0
+			}
+			count := len(examples)
+			if count > batchSize-size {
+				count = batchSize - size
+			}
+			batch = append(batch, CreateIntent{Intent: intent.Intent, Description: intent.Description, Examples: examples[:count]})
+			examples = examples[count:]
+			size += count
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return
+}
@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/assistantv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IntentImport`, func() {
+	version := "exampleString"
+	bearerToken := "0ui9876453"
+	workspaceID := "exampleString"
+	data := "hello,greeting\nhi there,greeting\nhi there,greeting\nbye,farewell\nhey,sys-greeting\nonly one column\n"
+	Describe(`ReadIntentsCSV(reader io.Reader)`, func() {
+		It(`Succeed to call ReadIntentsCSV`, func() {
+			intents, rowErrors, err := assistantv1.ReadIntentsCSV(strings.NewReader(data))
+			Expect(err).To(BeNil())
+			Expect(intents).To(HaveLen(2))
+			Expect(*intents[0].Intent).To(Equal("greeting"))
+			Expect(intents[0].Examples).To(HaveLen(2))
+			Expect(*intents[1].Intent).To(Equal("farewell"))
+			Expect(rowErrors).To(HaveLen(3))
+			Expect(rowErrors[0].Row).To(Equal(3))
+			Expect(rowErrors[1].Intent).To(Equal("sys-greeting"))
+			Expect(rowErrors[2].Row).To(Equal(6))
+		})
+	})
+	Describe(`ImportIntents(importIntentsOptions *ImportIntentsOptions)`, func() {
+		Context(`Successfully - Import intents from CSV`, func() {
+			var batches [][]assistantv1.CreateIntent
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal("/v1/workspaces/" + workspaceID))
+				Expect(req.URL.Query()["append"]).To(Equal([]string{"true"}))
+				Expect(req.Method).To(Equal("POST"))
+				var body struct {
+					Intents []assistantv1.CreateIntent `json:"intents"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				batches = append(batches, body.Intents)
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"name": "fake Name", "language": "en", "learning_opt_out": true, "workspace_id": "fake WorkspaceID"}`)
+			}))
+			It(`Succeed to call ImportIntents`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				importIntentsOptions := testService.NewImportIntentsOptions(workspaceID, strings.NewReader(data)).
+					SetBatchSize(2)
+				report, err := testService.ImportIntents(importIntentsOptions)
+				Expect(err).To(BeNil())
+				Expect(report.Intents).To(Equal(2))
+				Expect(report.Examples).To(Equal(3))
+				Expect(report.RowErrors).To(HaveLen(3))
+
+				Expect(batches).To(HaveLen(2))
+				Expect(batches[0]).To(HaveLen(1))
+				Expect(batches[0][0].Examples).To(HaveLen(2))
+				Expect(*batches[1][0].Intent).To(Equal("farewell"))
+			})
+		})
+		Context(`Unsuccessfully - Import intents from CSV`, func() {
+			It(`Fail to call ImportIntents`, func() {
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     "http://localhost",
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				report, err := testService.ImportIntents(nil)
+				Expect(err).ToNot(BeNil())
+				Expect(report).To(BeNil())
+			})
+		})
+	})
+})
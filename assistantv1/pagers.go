@@ -0,0 +1,310 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1
+
+import (
+	"fmt"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// pageContext : The cursor of the next page of a list, which follows the `next_cursor` of each page
+type pageContext struct {
+	started bool
+	cursor  *string
+}
+
+func (context *pageContext) hasNext() bool {
+	return !context.started || context.cursor != nil
+}
+
+// next : Returns the cursor of the next page, or an error if the last page has been returned
+func (context *pageContext) next() (*string, error) {
+	if !context.hasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+	return context.cursor, nil
+}
+
+func (context *pageContext) advance(nextCursor *string) {
+	context.started = true
+	context.cursor = nil
+	if nextCursor != nil && *nextCursor != "" {
+		context.cursor = nextCursor
+	}
+}
+
+func nextCursor(pagination *Pagination) *string {
+	if pagination == nil {
+		return nil
+	}
+	return pagination.NextCursor
+}
+
+func nextLogCursor(pagination *LogPagination) *string {
+	if pagination == nil {
+		return nil
+	}
+	return pagination.NextCursor
+}
+
+// WorkspacesPager : Returns the pages of ListWorkspaces, following the cursor of each page
+type WorkspacesPager struct {
+	assistant *AssistantV1
+	options   ListWorkspacesOptions
+	context   pageContext
+}
+
+// NewWorkspacesPager : Instantiate WorkspacesPager. The options may be nil; their cursor, if any, is the first page.
+func (assistant *AssistantV1) NewWorkspacesPager(listWorkspacesOptions *ListWorkspacesOptions) (*WorkspacesPager, error) {
+	pager := &WorkspacesPager{assistant: assistant}
+	if listWorkspacesOptions != nil {
+		pager.options = *listWorkspacesOptions
+	}
+	pager.context.cursor = pager.options.Cursor
+	return pager, nil
+}
+
+// HasNext : Returns whether there is another page
+func (pager *WorkspacesPager) HasNext() bool {
+	return pager.context.hasNext()
+}
+
+// GetNext : Returns the workspaces of the next page
+func (pager *WorkspacesPager) GetNext() (page []Workspace, err error) {
+	if pager.options.Cursor, err = pager.context.next(); err != nil {
+		return
+	}
+	result, _, err := pager.assistant.ListWorkspaces(&pager.options)
+	if err != nil {
+		return
+	}
+	pager.context.advance(nextCursor(result.Pagination))
+	return result.Workspaces, nil
+}
+
+// GetAll : Returns the workspaces of all of the remaining pages
+func (pager *WorkspacesPager) GetAll() (allItems []Workspace, err error) {
+	for pager.HasNext() {
+		var page []Workspace
+		if page, err = pager.GetNext(); err != nil {
+			return
+		}
+		allItems = append(allItems, page...)
+	}
+	return
+}
+
+// IntentsPager : Returns the pages of ListIntents, following the cursor of each page
+type IntentsPager struct {
+	assistant *AssistantV1
+	options   ListIntentsOptions
+	context   pageContext
+}
+
+// NewIntentsPager : Instantiate IntentsPager. The cursor of the options, if any, is the first page.
+func (assistant *AssistantV1) NewIntentsPager(listIntentsOptions *ListIntentsOptions) (*IntentsPager, error) {
+	if err := core.ValidateNotNil(listIntentsOptions, "listIntentsOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listIntentsOptions, "listIntentsOptions"); err != nil {
+		return nil, err
+	}
+	pager := &IntentsPager{assistant: assistant, options: *listIntentsOptions}
+	pager.context.cursor = pager.options.Cursor
+	return pager, nil
+}
+
+// HasNext : Returns whether there is another page
+func (pager *IntentsPager) HasNext() bool {
+	return pager.context.hasNext()
+}
+
+// GetNext : Returns the intents of the next page
+func (pager *IntentsPager) GetNext() (page []Intent, err error) {
+	if pager.options.Cursor, err = pager.context.next(); err != nil {
+		return
+	}
+	result, _, err := pager.assistant.ListIntents(&pager.options)
+	if err != nil {
+		return
+	}
+	pager.context.advance(nextCursor(result.Pagination))
+	return result.Intents, nil
+}
+
+// GetAll : Returns the intents of all of the remaining pages
+func (pager *IntentsPager) GetAll() (allItems []Intent, err error) {
+	for pager.HasNext() {
+		var page []Intent
+		if page, err = pager.GetNext(); err != nil {
+			return
+		}
+		allItems = append(allItems, page...)
+	}
+	return
+}
+
+// ExamplesPager : Returns the pages of ListExamples, following the cursor of each page
+type ExamplesPager struct {
+	assistant *AssistantV1
+	options   ListExamplesOptions
+	context   pageContext
+}
+
+// NewExamplesPager : Instantiate ExamplesPager. The cursor of the options, if any, is the first page.
+func (assistant *AssistantV1) NewExamplesPager(listExamplesOptions *ListExamplesOptions) (*ExamplesPager, error) {
+	if err := core.ValidateNotNil(listExamplesOptions, "listExamplesOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listExamplesOptions, "listExamplesOptions"); err != nil {
+		return nil, err
+	}
+	pager := &ExamplesPager{assistant: assistant, options: *listExamplesOptions}
+	pager.context.cursor = pager.options.Cursor
+	return pager, nil
+}
+
+// HasNext : Returns whether there is another page
+func (pager *ExamplesPager) HasNext() bool {
+	return pager.context.hasNext()
+}
+
+// GetNext : Returns the examples of the next page
+func (pager *ExamplesPager) GetNext() (page []Example, err error) {
+	if pager.options.Cursor, err = pager.context.next(); err != nil {
+		return
+	}
+	result, _, err := pager.assistant.ListExamples(&pager.options)
+	if err != nil {
+		return
+	}
+	pager.context.advance(nextCursor(result.Pagination))
+	return result.Examples, nil
+}
+
+// GetAll : Returns the examples of all of the remaining pages
+func (pager *ExamplesPager) GetAll() (allItems []Example, err error) {
+	for pager.HasNext() {
+		var page []Example
+		if page, err = pager.GetNext(); err != nil {
+			return
+		}
+		allItems = append(allItems, page...)
+	}
+	return
+}
+
+// LogsPager : Returns the pages of ListLogs, following the cursor of each page
+type LogsPager struct {
+	assistant *AssistantV1
+	options   ListLogsOptions
+	context   pageContext
+}
+
+// NewLogsPager : Instantiate LogsPager. The cursor of the options, if any, is the first page.
+func (assistant *AssistantV1) NewLogsPager(listLogsOptions *ListLogsOptions) (*LogsPager, error) {
+	if err := core.ValidateNotNil(listLogsOptions, "listLogsOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listLogsOptions, "listLogsOptions"); err != nil {
+		return nil, err
+	}
+	pager := &LogsPager{assistant: assistant, options: *listLogsOptions}
+	pager.context.cursor = pager.options.Cursor
+	return pager, nil
+}
+
+// HasNext : Returns whether there is another page
+func (pager *LogsPager) HasNext() bool {
+	return pager.context.hasNext()
+}
+
+// GetNext : Returns the log events of the next page
+func (pager *LogsPager) GetNext() (page []Log, err error) {
+	if pager.options.Cursor, err = pager.context.next(); err != nil {
+		return
+	}
+	result, _, err := pager.assistant.ListLogs(&pager.options)
+	if err != nil {
+		return
+	}
+	pager.context.advance(nextLogCursor(result.Pagination))
+	return result.Logs, nil
+}
+
+// GetAll : Returns the log events of all of the remaining pages
+func (pager *LogsPager) GetAll() (allItems []Log, err error) {
+	for pager.HasNext() {
+		var page []Log
+		if page, err = pager.GetNext(); err != nil {
+			return
+		}
+		allItems = append(allItems, page...)
+	}
+	return
+}
+
+// AllLogsPager : Returns the pages of ListAllLogs, following the cursor of each page
+type AllLogsPager struct {
+	assistant *AssistantV1
+	options   ListAllLogsOptions
+	context   pageContext
+}
+
+// NewAllLogsPager : Instantiate AllLogsPager. The cursor of the options, if any, is the first page.
+func (assistant *AssistantV1) NewAllLogsPager(listAllLogsOptions *ListAllLogsOptions) (*AllLogsPager, error) {
+	if err := core.ValidateNotNil(listAllLogsOptions, "listAllLogsOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listAllLogsOptions, "listAllLogsOptions"); err != nil {
+		return nil, err
+	}
+	pager := &AllLogsPager{assistant: assistant, options: *listAllLogsOptions}
+	pager.context.cursor = pager.options.Cursor
+	return pager, nil
+}
+
+// HasNext : Returns whether there is another page
+func (pager *AllLogsPager) HasNext() bool {
+	return pager.context.hasNext()
+}
+
+// GetNext : Returns the log events of the next page
+func (pager *AllLogsPager) GetNext() (page []Log, err error) {
+	if pager.options.Cursor, err = pager.context.next(); err != nil {
+		return
+	}
+	result, _, err := pager.assistant.ListAllLogs(&pager.options)
+	if err != nil {
+		return
+	}
+	pager.context.advance(nextLogCursor(result.Pagination))
+	return result.Logs, nil
+}
+
+// GetAll : Returns the log events of all of the remaining pages
+func (pager *AllLogsPager) GetAll() (allItems []Log, err error) {
+	for pager.HasNext() {
+		var page []Log
+		if page, err = pager.GetNext(); err != nil {
+			return
+		}
+		allItems = append(allItems, page...)
+	}
+	return
+}
@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/assistantv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Pagers`, func() {
+	version := "exampleString"
+	bearerToken := "0ui9876453"
+	workspaceID := "exampleString"
+	Describe(`NewWorkspacesPager(listWorkspacesOptions *ListWorkspacesOptions)`, func() {
+		Context(`Successfully - List all workspaces`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal("/v1/workspaces"))
+				Expect(req.URL.Query()["page_limit"]).To(Equal([]string{"1"}))
+				res.Header().Set("Content-type", "application/json")
+				if req.URL.Query().Get("cursor") == "" {
+					fmt.Fprint(res, `{"workspaces": [{"name": "first", "language": "en", "learning_opt_out": true, "workspace_id": "1"}], "pagination": {"refresh_url": "url", "next_cursor": "page2"}}`)
+					return
+				}
+				Expect(req.URL.Query()["cursor"]).To(Equal([]string{"page2"}))
+				fmt.Fprint(res, `{"workspaces": [{"name": "second", "language": "en", "learning_opt_out": true, "workspace_id": "2"}], "pagination": {"refresh_url": "url"}}`)
+			}))
+			It(`Succeed to call GetAll`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				listWorkspacesOptions := testService.NewListWorkspacesOptions().SetPageLimit(1)
+				pager, err := testService.NewWorkspacesPager(listWorkspacesOptions)
+				Expect(err).To(BeNil())
+				Expect(pager.HasNext()).To(BeTrue())
+
+				workspaces, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(workspaces).To(HaveLen(2))
+				Expect(*workspaces[0].Name).To(Equal("first"))
+				Expect(*workspaces[1].Name).To(Equal("second"))
+				Expect(pager.HasNext()).To(BeFalse())
+				Expect(listWorkspacesOptions.Cursor).To(BeNil())
+
+				_, err = pager.GetNext()
+				Expect(err).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`NewLogsPager(listLogsOptions *ListLogsOptions)`, func() {
+		Context(`Successfully - List the logs of a workspace`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal("/v1/workspaces/" + workspaceID + "/logs"))
+				res.Header().Set("Content-type", "application/json")
+				if req.URL.Query().Get("cursor") == "" {
+					fmt.Fprint(res, `{"logs": [{"log_id": "1"}], "pagination": {"next_cursor": "page2"}}`)
+					return
+				}
+				fmt.Fprint(res, `{"logs": [{"log_id": "2"}, {"log_id": "3"}], "pagination": {}}`)
+			}))
+			It(`Succeed to call GetNext`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				pager, err := testService.NewLogsPager(testService.NewListLogsOptions(workspaceID))
+				Expect(err).To(BeNil())
+
+				var pages [][]assistantv1.Log
+				for pager.HasNext() {
+					page, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					pages = append(pages, page)
+				}
+				Expect(pages).To(HaveLen(2))
+				Expect(pages[1]).To(HaveLen(2))
+			})
+		})
+		Context(`Unsuccessfully - List the logs of a workspace`, func() {
+			It(`Fail to call NewLogsPager`, func() {
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     "http://localhost",
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				pager, err := testService.NewLogsPager(nil)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+		})
+	})
+})
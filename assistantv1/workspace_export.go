@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// ExportWorkspace : Export a workspace
+// Writes the JSON of a workspace with all of its content, including intents, entities, dialog nodes, counterexamples,
+// and webhooks, sorted so that exports of the same content are identical. The JSON can be recreated in another service
+// instance with ImportWorkspace.
+func (assistant *AssistantV1) ExportWorkspace(workspaceID string, writer io.Writer) (response *core.DetailedResponse, err error) {
+	getWorkspaceOptions := assistant.NewGetWorkspaceOptions(workspaceID).
+		SetExport(true).
+		SetSort(GetWorkspaceOptions_Sort_Stable)
+	workspace, response, err := assistant.GetWorkspace(getWorkspaceOptions)
+	if err != nil {
+		return
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(workspace)
+	return
+}
+
+// NewCreateWorkspaceOptionsFromExport : Instantiate CreateWorkspaceOptions from the JSON of an exported workspace
+// The workspace ID, status, and timestamps of the export are not used. The options can be changed, for example to
+// rename the workspace, before they are passed to CreateWorkspace.
+func (assistant *AssistantV1) NewCreateWorkspaceOptionsFromExport(reader io.Reader) (*CreateWorkspaceOptions, error) {
+	var workspace Workspace
+	if err := json.NewDecoder(reader).Decode(&workspace); err != nil {
+		return nil, fmt.Errorf("could not read the exported workspace: %s", err.Error())
+	}
+
+	// The content of an export has the same JSON form as the content of a new workspace
+	content, err := json.Marshal(struct {
+		Intents         []Intent         `json:"intents,omitempty"`
+		Entities        []Entity         `json:"entities,omitempty"`
+		DialogNodes     []DialogNode     `json:"dialog_nodes,omitempty"`
+		Counterexamples []Counterexample `json:"counterexamples,omitempty"`
+		Webhooks        []Webhook        `json:"webhooks,omitempty"`
+	}{workspace.Intents, workspace.Entities, workspace.DialogNodes, workspace.Counterexamples, workspace.Webhooks})
+	if err != nil {
+		return nil, err
+	}
+	createWorkspaceOptions := assistant.NewCreateWorkspaceOptions()
+	if err = json.Unmarshal(content, createWorkspaceOptions); err != nil {
+		return nil, err
+	}
+	createWorkspaceOptions.Name = workspace.Name
+	createWorkspaceOptions.Description = workspace.Description
+	createWorkspaceOptions.Language = workspace.Language
+	createWorkspaceOptions.Metadata = workspace.Metadata
+	createWorkspaceOptions.LearningOptOut = workspace.LearningOptOut
+	createWorkspaceOptions.SystemSettings = workspace.SystemSettings
+	return createWorkspaceOptions, nil
+}
+
+// ImportWorkspace : Import a workspace
+// Creates a workspace from the JSON of a workspace that was exported with ExportWorkspace, possibly from another service
+// instance. The new workspace has its own workspace ID.
+//
+// This operation is limited to 30 requests per 30 minutes. For more information, see **Rate limiting**.
+func (assistant *AssistantV1) ImportWorkspace(reader io.Reader) (result *Workspace, response *core.DetailedResponse, err error) {
+	createWorkspaceOptions, err := assistant.NewCreateWorkspaceOptionsFromExport(reader)
+	if err != nil {
+		return
+	}
+	return assistant.CreateWorkspace(createWorkspaceOptions)
+}
@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/assistantv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WorkspaceExport`, func() {
+	version := "exampleString"
+	bearerToken := "0ui9876453"
+	workspaceID := "exampleString"
+	exportedWorkspace := `{"name": "fake Name", "language": "en", "learning_opt_out": true, "workspace_id": "fake WorkspaceID", "status": "Available", ` +
+		`"intents": [{"intent": "hello", "examples": [{"text": "hi"}]}], ` +
+		`"entities": [{"entity": "color", "values": [{"value": "red", "type": "synonyms", "synonyms": ["crimson"]}]}], ` +
+		`"dialog_nodes": [{"dialog_node": "root", "conditions": "#hello"}], ` +
+		`"counterexamples": [{"text": "nope"}]}`
+	Describe(`ExportWorkspace(workspaceID string, writer io.Writer)`, func() {
+		Context(`Successfully - Export a workspace`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal("/v1/workspaces/" + workspaceID))
+				Expect(req.URL.Query()["export"]).To(Equal([]string{"true"}))
+				Expect(req.URL.Query()["sort"]).To(Equal([]string{assistantv1.GetWorkspaceOptions_Sort_Stable}))
+				Expect(req.Method).To(Equal("GET"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, exportedWorkspace)
+			}))
+			It(`Succeed to call ExportWorkspace`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				var export bytes.Buffer
+				response, operationErr := testService.ExportWorkspace(workspaceID, &export)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+
+				var workspace assistantv1.Workspace
+				Expect(json.Unmarshal(export.Bytes(), &workspace)).To(Succeed())
+				Expect(*workspace.Name).To(Equal("fake Name"))
+				Expect(workspace.Intents).To(HaveLen(1))
+				Expect(workspace.Entities[0].Values).To(HaveLen(1))
+			})
+		})
+	})
+	Describe(`ImportWorkspace(reader io.Reader)`, func() {
+		Context(`Successfully - Import a workspace`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal("/v1/workspaces"))
+				Expect(req.Method).To(Equal("POST"))
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["name"]).To(Equal("fake Name"))
+				Expect(body).To(HaveKey("intents"))
+				Expect(body).To(HaveKey("entities"))
+				Expect(body).To(HaveKey("dialog_nodes"))
+				Expect(body).To(HaveKey("counterexamples"))
+				Expect(body).ToNot(HaveKey("workspace_id"))
+				Expect(body).ToNot(HaveKey("status"))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"name": "fake Name", "language": "en", "learning_opt_out": true, "workspace_id": "new WorkspaceID"}`)
+			}))
+			It(`Succeed to call ImportWorkspace`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				result, response, operationErr := testService.ImportWorkspace(strings.NewReader(exportedWorkspace))
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.WorkspaceID).To(Equal("new WorkspaceID"))
+			})
+		})
+		Context(`Unsuccessfully - Import a workspace`, func() {
+			It(`Fail to call ImportWorkspace`, func() {
+				testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+					URL:     "http://localhost",
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				result, _, operationErr := testService.ImportWorkspace(strings.NewReader("not JSON"))
+				Expect(operationErr).ToNot(BeNil())
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+})
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"github.com/edwindvinas/go-sdk-core/core"
 	common "github.com/edwindvinas/go-sdk/common"
+	"net/http"
 )
 
 // AssistantV2 : The IBM Watson&trade; Assistant service combines machine learning, natural language understanding, and
@@ -43,6 +44,7 @@ type AssistantV2Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewAssistantV2 : Instantiate AssistantV2
@@ -57,7 +59,7 @@ func NewAssistantV2(options *AssistantV2Options) (service *AssistantV2, err erro
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("conversation")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("conversation")
 		if err != nil {
 			return
 		}
@@ -69,6 +71,10 @@ func NewAssistantV2(options *AssistantV2Options) (service *AssistantV2, err erro
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &AssistantV2{
 		Service: baseService,
 		Version: options.Version,
@@ -129,6 +135,7 @@ func (assistant *AssistantV2) CreateSession(createSessionOptions *CreateSessionO
 	}
 
 	response, err = assistant.Service.Request(request, new(SessionResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SessionResponse)
@@ -180,6 +187,7 @@ func (assistant *AssistantV2) DeleteSession(deleteSessionOptions *DeleteSessionO
 	}
 
 	response, err = assistant.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -238,6 +246,7 @@ func (assistant *AssistantV2) Message(messageOptions *MessageOptions) (result *M
 	}
 
 	response, err = assistant.Service.Request(request, new(MessageResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MessageResponse)
@@ -0,0 +1,133 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// NO_RETRIES is the MaxRetries value of the options of a batch operation that disables retries, because zero means
+// the default number of retries.
+const NO_RETRIES = -1
+
+// RetryOptions control how Retry sends a request again after a transient failure.
+type RetryOptions struct {
+	// The number of times a failed request is retried.
+	MaxRetries int
+
+	// The delay before the first retry. The delay doubles for each further retry. A response with status code 429 and
+	// a Retry-After header is retried after the delay that the service asks for.
+	RetryDelay time.Duration
+
+	// The limiter that every attempt waits for. Nil means that attempts are not limited.
+	Limiter *RateLimiter
+}
+
+// NonRetryableError wraps an error that Retry never retries, such as an error reading the input of a request.
+type NonRetryableError struct {
+	Err error
+}
+
+func (e *NonRetryableError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *NonRetryableError) Unwrap() error {
+	return e.Err
+}
+
+// NewIntervalRateLimiter returns a RateLimiter that spaces requests by at least the interval, without bursts. An
+// interval of zero or less means that requests are not limited.
+func NewIntervalRateLimiter(interval time.Duration) *RateLimiter {
+	if interval <= 0 {
+		return NewRateLimiter(0, 1)
+	}
+	return NewRateLimiter(float64(time.Second)/float64(interval), 1)
+}
+
+// RunConcurrently calls process with each index from 0 to count-1 on up to concurrency goroutines. It returns at once,
+// and calls done when every call of process has returned.
+func RunConcurrently(count int, concurrency int, process func(index int), done func()) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	indexes := make(chan int)
+	var workers sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for index := range indexes {
+				process(index)
+			}
+		}()
+	}
+
+	go func() {
+		for i := 0; i < count; i++ {
+			indexes <- i
+		}
+		close(indexes)
+		workers.Wait()
+		done()
+	}()
+}
+
+// Retry calls send until it succeeds, fails with an error that IsRetryableError rejects, or has been retried
+// MaxRetries times. It returns the number of calls and the error of the last one. Cancelling the context stops the
+// retries with the error of the context.
+func Retry(ctx context.Context, options RetryOptions, send func() error) (attempts int, err error) {
+	retryDelay := options.RetryDelay
+	for {
+		if options.Limiter != nil {
+			err = options.Limiter.Wait(ctx)
+		} else {
+			err = ctx.Err()
+		}
+		if err != nil {
+			return
+		}
+
+		attempts++
+		err = send()
+		if err == nil || attempts > options.MaxRetries || !IsRetryableError(ctx, err) {
+			return
+		}
+
+		timer := time.NewTimer(serviceRetryDelay(err, retryDelay))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, ctx.Err()
+		case <-timer.C:
+		}
+		retryDelay *= 2
+	}
+}
+
+// IsRetryableError reports whether a failed request can succeed when it is sent again: transport failures and
+// responses with status code 429 or 5xx can, unless the context is done.
+func IsRetryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch err := err.(type) {
+	case *NonRetryableError:
+		return false
+	case *ServiceError:
+		return err.StatusCode == http.StatusTooManyRequests || err.StatusCode >= 500
+	}
+	return true
+}
+
+// serviceRetryDelay returns the delay that a rate limited response asks for in its Retry-After header, or retryDelay
+// otherwise.
+func serviceRetryDelay(err error, retryDelay time.Duration) time.Duration {
+	serviceError, ok := err.(*ServiceError)
+	if !ok || serviceError.StatusCode != http.StatusTooManyRequests || serviceError.Response == nil || serviceError.Response.Headers == nil {
+		return retryDelay
+	}
+	return retryAfterDelay(serviceError.Response.Headers, retryDelay)
+}
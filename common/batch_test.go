@@ -0,0 +1,115 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func serviceErrorWithStatus(statusCode int, header http.Header) error {
+	return &ServiceError{
+		StatusCode: statusCode,
+		Response:   &core.DetailedResponse{StatusCode: statusCode, Headers: header},
+	}
+}
+
+func TestRunConcurrently(t *testing.T) {
+	var mutex sync.Mutex
+	processed := make([]bool, 10)
+	done := make(chan struct{})
+	RunConcurrently(len(processed), 3, func(index int) {
+		mutex.Lock()
+		processed[index] = true
+		mutex.Unlock()
+	}, func() {
+		close(done)
+	})
+
+	<-done
+	for _, ok := range processed {
+		assert.True(t, ok)
+	}
+}
+
+func TestRetryRetriesTransientErrors(t *testing.T) {
+	calls := 0
+	attempts, err := Retry(context.Background(), RetryOptions{MaxRetries: 2, RetryDelay: time.Millisecond}, func() error {
+		calls++
+		if calls < 3 {
+			return serviceErrorWithStatus(http.StatusServiceUnavailable, nil)
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryStopsAfterMaxRetries(t *testing.T) {
+	attempts, err := Retry(context.Background(), RetryOptions{MaxRetries: 1, RetryDelay: time.Millisecond}, func() error {
+		return errors.New("connection reset")
+	})
+	assert.Equal(t, "connection reset", err.Error())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryDoesNotRetryPermanentErrors(t *testing.T) {
+	attempts, err := Retry(context.Background(), RetryOptions{MaxRetries: 2}, func() error {
+		return serviceErrorWithStatus(http.StatusBadRequest, nil)
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+
+	attempts, err = Retry(context.Background(), RetryOptions{MaxRetries: 2}, func() error {
+		return &NonRetryableError{errors.New("no such file")}
+	})
+	assert.Equal(t, "no such file", err.Error())
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	header := http.Header{}
+	header.Set("Retry-After", "0")
+	calls := 0
+	start := time.Now()
+	attempts, err := Retry(context.Background(), RetryOptions{MaxRetries: 1, RetryDelay: time.Minute}, func() error {
+		calls++
+		if calls == 1 {
+			return serviceErrorWithStatus(http.StatusTooManyRequests, header)
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	attempts, err := Retry(ctx, RetryOptions{MaxRetries: 2}, func() error {
+		return nil
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, attempts)
+}
+
+func TestNewIntervalRateLimiter(t *testing.T) {
+	limiter := NewIntervalRateLimiter(20 * time.Millisecond)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, limiter.Wait(context.Background()))
+	}
+	// The first request is not delayed; the next two wait 20ms each.
+	assert.True(t, time.Since(start) >= 35*time.Millisecond)
+
+	limiter = NewIntervalRateLimiter(0)
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, limiter.Wait(context.Background()))
+	}
+}
@@ -0,0 +1,188 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// ErrDryRun is returned by service operations while dry-run mode is enabled, in place of sending the request.
+var ErrDryRun = errors.New("dry run: request was not sent")
+
+// REDACTED replaces the value of credential headers in request descriptions.
+const REDACTED = "[REDACTED]"
+
+// maxDescribedBody is the largest textual request body included verbatim in a request description.
+const maxDescribedBody = 4 * 1024
+
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "X-Watson-Authorization-Token"}
+
+// DryRunTransport is an http.RoundTripper that records every outbound request instead of sending it.
+type DryRunTransport struct {
+	mutex    sync.Mutex
+	requests []*http.Request
+}
+
+// EnableDryRun replaces the HTTP transport of the service so that requests are built, authenticated and
+// recorded, but never sent. Operations invoked on the service return ErrDryRun (wrapped in a *url.Error).
+func EnableDryRun(service *core.BaseService) *DryRunTransport {
+	transport := &DryRunTransport{}
+	service.SetHTTPClient(&http.Client{Transport: transport})
+	return transport
+}
+
+// RoundTrip records the request and returns ErrDryRun.
+func (transport *DryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	transport.mutex.Lock()
+	transport.requests = append(transport.requests, req)
+	transport.mutex.Unlock()
+	return nil, ErrDryRun
+}
+
+// Requests returns the requests recorded so far, oldest first.
+func (transport *DryRunTransport) Requests() []*http.Request {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+	return append([]*http.Request(nil), transport.requests...)
+}
+
+// LastRequest returns the most recently recorded request, or nil if no request was recorded.
+func (transport *DryRunTransport) LastRequest() *http.Request {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+	if len(transport.requests) == 0 {
+		return nil
+	}
+	return transport.requests[len(transport.requests)-1]
+}
+
+// Reset discards the recorded requests.
+func (transport *DryRunTransport) Reset() {
+	transport.mutex.Lock()
+	transport.requests = nil
+	transport.mutex.Unlock()
+}
+
+// RedactedHeaders returns a copy of the request headers with credential values replaced by REDACTED.
+func RedactedHeaders(req *http.Request) http.Header {
+	headers := make(http.Header, len(req.Header))
+	for name, values := range req.Header {
+		headers[name] = append([]string(nil), values...)
+	}
+	for _, name := range redactedHeaders {
+		if headers.Get(name) != "" {
+			headers.Set(name, REDACTED)
+		}
+	}
+	return headers
+}
+
+// DescribeRequest returns a human-readable description of a request: its method and URL, its headers with
+// credentials redacted, and its body. Textual bodies up to 4 KB are included verbatim; other bodies are summarized
+// by their length.
+func DescribeRequest(req *http.Request) string {
+	var description strings.Builder
+	fmt.Fprintf(&description, "%s %s\n", req.Method, req.URL.String())
+
+	headers := RedactedHeaders(req)
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&description, "%s: %s\n", name, strings.Join(headers[name], ", "))
+	}
+
+	if req.GetBody == nil {
+		return description.String()
+	}
+	bodyReader, err := req.GetBody()
+	if err != nil {
+		fmt.Fprintf(&description, "\n<body unavailable: %s>\n", err.Error())
+		return description.String()
+	}
+	defer bodyReader.Close()
+	body, err := ioutil.ReadAll(bodyReader)
+	if err != nil {
+		fmt.Fprintf(&description, "\n<body unavailable: %s>\n", err.Error())
+		return description.String()
+	}
+
+	if len(body) > 0 {
+		description.WriteString("\n")
+		describeBody(&description, req.Header.Get("Content-Type"), body)
+	}
+	return description.String()
+}
+
+// describeBody writes a description of a request body. The parts of a multipart body are described individually,
+// so that the headers of each part are visible.
+func describeBody(description *strings.Builder, contentType string, body []byte) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err == nil && strings.HasPrefix(mediaType, "multipart/") && params["boundary"] != "" {
+		reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(description, "<malformed multipart body: %s>\n", err.Error())
+				return
+			}
+			partBody, err := ioutil.ReadAll(part)
+			if err != nil {
+				fmt.Fprintf(description, "<malformed multipart body: %s>\n", err.Error())
+				return
+			}
+			fmt.Fprintf(description, "--%s\n", params["boundary"])
+			names := make([]string, 0, len(part.Header))
+			for name := range part.Header {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Fprintf(description, "%s: %s\n", name, strings.Join(part.Header[name], ", "))
+			}
+			description.WriteString("\n")
+			describeBody(description, part.Header.Get("Content-Type"), partBody)
+		}
+	}
+
+	if len(body) <= maxDescribedBody && isTextualContentType(contentType) {
+		fmt.Fprintf(description, "%s\n", body)
+	} else {
+		fmt.Fprintf(description, "<%d bytes>\n", len(body))
+	}
+}
+
+func isTextualContentType(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return strings.HasPrefix(contentType, "text/") ||
+		strings.Contains(contentType, "json") ||
+		strings.Contains(contentType, "xml") ||
+		strings.HasPrefix(contentType, "application/x-www-form-urlencoded")
+}
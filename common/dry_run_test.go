@@ -0,0 +1,48 @@
+package common
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunTransport(t *testing.T) {
+	transport := &DryRunTransport{}
+	client := &http.Client{Transport: transport}
+
+	req, _ := http.NewRequest("POST", "https://example.com/v1/recognize?keywords=a%2Cb", strings.NewReader(`{"a":1}`))
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Content-Type", "application/json")
+	_, err := client.Do(req)
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), ErrDryRun.Error()))
+
+	assert.Equal(t, 1, len(transport.Requests()))
+	description := DescribeRequest(transport.LastRequest())
+	assert.True(t, strings.Contains(description, "POST https://example.com/v1/recognize?keywords=a%2Cb"))
+	assert.True(t, strings.Contains(description, "Authorization: "+REDACTED))
+	assert.False(t, strings.Contains(description, "secret"))
+	assert.True(t, strings.Contains(description, `{"a":1}`))
+
+	transport.Reset()
+	assert.Nil(t, transport.LastRequest())
+}
+
+func TestDescribeRequestMultipart(t *testing.T) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("audio", "audio.wav")
+	part.Write(make([]byte, 10))
+	writer.Close()
+
+	req, _ := http.NewRequest("POST", "https://example.com/v1/upload", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	description := DescribeRequest(req)
+	assert.True(t, strings.Contains(description, "Content-Disposition: form-data; name=\"audio\"; filename=\"audio.wav\""))
+	assert.True(t, strings.Contains(description, "<10 bytes>"))
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"os"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// GetAuthenticatorFromEnvironment returns the authenticator configured for a service in external configuration. The
+// configuration is read by core.GetAuthenticatorFromEnvironment from, in order, the credential file, the
+// <SERVICE_NAME>_* environment variables and VCAP_SERVICES. When none of those configure a usable authenticator, the
+// legacy <SERVICE_NAME>_IAM_APIKEY and <SERVICE_NAME>_IAM_URL environment variables are used to configure an
+// IamTokenManager.
+func GetAuthenticatorFromEnvironment(credentialKey string) (core.Authenticator, error) {
+	authenticator, err := core.GetAuthenticatorFromEnvironment(credentialKey)
+	if authenticator != nil && err == nil {
+		return authenticator, nil
+	}
+
+	prefix := EnvironmentPrefix(credentialKey)
+	apiKey := os.Getenv(prefix + "_IAM_APIKEY")
+	if apiKey == "" {
+		return authenticator, err
+	}
+	manager := NewIamTokenManager(apiKey)
+	manager.URL = os.Getenv(prefix + "_IAM_URL")
+	return manager, nil
+}
+
+// EnvironmentPrefix returns the prefix of the environment variables that configure a service, for example
+// SPEECH_TO_TEXT for speech_to_text and NATURAL_LANGUAGE_UNDERSTANDING for natural-language-understanding.
+func EnvironmentPrefix(credentialKey string) string {
+	return strings.ToUpper(strings.Replace(credentialKey, "-", "_", -1))
+}
@@ -0,0 +1,27 @@
+package common
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentPrefix(t *testing.T) {
+	assert.Equal(t, "SPEECH_TO_TEXT", EnvironmentPrefix("speech_to_text"))
+	assert.Equal(t, "NATURAL_LANGUAGE_UNDERSTANDING", EnvironmentPrefix("natural-language-understanding"))
+}
+
+func TestGetAuthenticatorFromLegacyEnvironment(t *testing.T) {
+	os.Setenv("LEGACY_TEST_SERVICE_IAM_APIKEY", "my-api-key")
+	os.Setenv("LEGACY_TEST_SERVICE_IAM_URL", "https://iam.example.com/identity/token")
+	defer os.Unsetenv("LEGACY_TEST_SERVICE_IAM_APIKEY")
+	defer os.Unsetenv("LEGACY_TEST_SERVICE_IAM_URL")
+
+	authenticator, err := GetAuthenticatorFromEnvironment("legacy-test-service")
+	assert.Nil(t, err)
+	manager, ok := authenticator.(*IamTokenManager)
+	assert.True(t, ok)
+	assert.Equal(t, "my-api-key", manager.ApiKey)
+	assert.Equal(t, "https://iam.example.com/identity/token", manager.URL)
+}
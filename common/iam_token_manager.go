@@ -0,0 +1,221 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// DEFAULT_IAM_URL is the IAM token endpoint used when IamTokenManager.URL is empty.
+const DEFAULT_IAM_URL = "https://iam.cloud.ibm.com/identity/token"
+
+// DEFAULT_REFRESH_WINDOW is the fraction of a token's lifetime, before its expiration, during which the token is
+// refreshed in the background.
+const DEFAULT_REFRESH_WINDOW = 0.2
+
+// IamTokenManager is an Authenticator that exchanges an IAM API key for an access token and caches it. Once a token
+// enters its refresh window, it is refreshed in the background while the cached token continues to be used, so that
+// long-running work is not interrupted by token expiration. Refreshes are serialized: concurrent callers never
+// request more than one token at a time.
+type IamTokenManager struct {
+	// The IAM API key.
+	ApiKey string
+
+	// The IAM token endpoint. Defaults to DEFAULT_IAM_URL.
+	URL string
+
+	// The client ID and secret sent to IAM using basic authentication, if required.
+	ClientID     string
+	ClientSecret string
+
+	// The fraction of the token's lifetime before its expiration during which it is refreshed. Defaults to
+	// DEFAULT_REFRESH_WINDOW.
+	RefreshWindow float64
+
+	// The HTTP client used to request tokens. Defaults to http.DefaultClient.
+	Client *http.Client
+
+	mutex        sync.Mutex
+	refreshMutex sync.Mutex
+	token        *iamToken
+	refreshing   bool
+}
+
+type iamToken struct {
+	accessToken string
+	expiration  time.Time
+	refreshTime time.Time
+}
+
+type iamTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Expiration  int64  `json:"expiration"`
+}
+
+// NewIamTokenManager returns an IamTokenManager for the API key.
+func NewIamTokenManager(apiKey string) *IamTokenManager {
+	return &IamTokenManager{
+		ApiKey: apiKey,
+	}
+}
+
+// AuthenticationType returns the authentication type of the manager.
+func (manager *IamTokenManager) AuthenticationType() string {
+	return core.AUTHTYPE_IAM
+}
+
+// Validate checks that the manager is configured with an API key.
+func (manager *IamTokenManager) Validate() error {
+	if manager.ApiKey == "" {
+		return fmt.Errorf("The ApiKey property is required but was not specified.")
+	}
+	if (manager.ClientID == "") != (manager.ClientSecret == "") {
+		return fmt.Errorf("Both ClientID and ClientSecret must be specified, or neither.")
+	}
+	return nil
+}
+
+// Authenticate adds a bearer token to the Authorization header of the request.
+func (manager *IamTokenManager) Authenticate(request *http.Request) error {
+	token, err := manager.GetToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// GetToken returns a valid access token. A token is requested synchronously only when there is no token or it has
+// expired; a token in its refresh window is returned immediately and refreshed in the background.
+func (manager *IamTokenManager) GetToken() (string, error) {
+	manager.mutex.Lock()
+	token := manager.token
+	manager.mutex.Unlock()
+
+	now := time.Now()
+	if token == nil || !now.Before(token.expiration) {
+		return manager.refresh()
+	}
+	if !now.Before(token.refreshTime) {
+		manager.refreshInBackground()
+	}
+	return token.accessToken, nil
+}
+
+// refresh requests a new token unless another caller refreshed it while this one waited.
+func (manager *IamTokenManager) refresh() (string, error) {
+	manager.refreshMutex.Lock()
+	defer manager.refreshMutex.Unlock()
+
+	manager.mutex.Lock()
+	token := manager.token
+	manager.mutex.Unlock()
+	if token != nil && time.Now().Before(token.refreshTime) {
+		return token.accessToken, nil
+	}
+
+	token, err := manager.requestToken()
+	if err != nil {
+		return "", err
+	}
+
+	manager.mutex.Lock()
+	manager.token = token
+	manager.mutex.Unlock()
+	return token.accessToken, nil
+}
+
+// refreshInBackground starts a refresh unless one is already running. A failed background refresh is retried on a
+// later call; the cached token remains in use until it expires.
+func (manager *IamTokenManager) refreshInBackground() {
+	manager.mutex.Lock()
+	if manager.refreshing {
+		manager.mutex.Unlock()
+		return
+	}
+	manager.refreshing = true
+	manager.mutex.Unlock()
+
+	go func() {
+		_, _ = manager.refresh()
+
+		manager.mutex.Lock()
+		manager.refreshing = false
+		manager.mutex.Unlock()
+	}()
+}
+
+// requestToken exchanges the API key for a new token.
+func (manager *IamTokenManager) requestToken() (*iamToken, error) {
+	tokenURL := manager.URL
+	if tokenURL == "" {
+		tokenURL = DEFAULT_IAM_URL
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ibm:params:oauth:grant-type:apikey")
+	form.Set("apikey", manager.ApiKey)
+	form.Set("response_type", "cloud_iam")
+
+	request, err := http.NewRequest(http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if manager.ClientID != "" {
+		request.SetBasicAuth(manager.ClientID, manager.ClientSecret)
+	}
+
+	client := manager.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("IAM token request failed with status %d: %s", response.StatusCode, string(body))
+	}
+
+	var tokenResponse iamTokenResponse
+	if err = json.Unmarshal(body, &tokenResponse); err != nil {
+		return nil, err
+	}
+	if tokenResponse.AccessToken == "" {
+		return nil, fmt.Errorf("IAM token response did not contain an access token")
+	}
+
+	now := time.Now()
+	lifetime := time.Duration(tokenResponse.ExpiresIn) * time.Second
+	expiration := now.Add(lifetime)
+	if tokenResponse.Expiration > 0 {
+		expiration = time.Unix(tokenResponse.Expiration, 0)
+		lifetime = expiration.Sub(now)
+	}
+
+	window := manager.RefreshWindow
+	if window <= 0 || window >= 1 {
+		window = DEFAULT_REFRESH_WINDOW
+	}
+	return &iamToken{
+		accessToken: tokenResponse.AccessToken,
+		expiration:  expiration,
+		refreshTime: expiration.Add(-time.Duration(float64(lifetime) * window)),
+	}, nil
+}
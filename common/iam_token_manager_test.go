@@ -0,0 +1,93 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIamTokenManagerCachesToken(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		count := atomic.AddInt32(&requests, 1)
+		assert.Equal(t, "my-api-key", req.FormValue("apikey"))
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(res, `{"access_token":"token-%d","expires_in":3600}`, count)
+	}))
+	defer server.Close()
+
+	manager := NewIamTokenManager("my-api-key")
+	manager.URL = server.URL
+	assert.Nil(t, manager.Validate())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := manager.GetToken()
+			assert.Nil(t, err)
+			assert.Equal(t, "token-1", token)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	req, _ := http.NewRequest("GET", "https://example.com", nil)
+	assert.Nil(t, manager.Authenticate(req))
+	assert.Equal(t, "Bearer token-1", req.Header.Get("Authorization"))
+}
+
+func TestIamTokenManagerRefreshesInBackground(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		count := atomic.AddInt32(&requests, 1)
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(res, `{"access_token":"token-%d","expires_in":2}`, count)
+	}))
+	defer server.Close()
+
+	// The token enters its refresh window 200 milliseconds after it is issued.
+	manager := NewIamTokenManager("my-api-key")
+	manager.URL = server.URL
+	manager.RefreshWindow = 0.9
+
+	token, err := manager.GetToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", token)
+
+	time.Sleep(300 * time.Millisecond)
+	token, err = manager.GetToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", token)
+
+	deadline := time.Now().Add(time.Second)
+	for token == "token-1" && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		token, err = manager.GetToken()
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, "token-2", token)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestIamTokenManagerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(res, `{"errorMessage":"Provided API key could not be found"}`)
+	}))
+	defer server.Close()
+
+	manager := NewIamTokenManager("bad-api-key")
+	manager.URL = server.URL
+
+	_, err := manager.GetToken()
+	assert.NotNil(t, err)
+	assert.NotNil(t, NewIamTokenManager("").Validate())
+}
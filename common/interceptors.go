@@ -0,0 +1,134 @@
+package common
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// RequestInterceptor is called with every request sent by a service, after the request has been authenticated. It
+// may add headers to the request or otherwise modify it. Returning an error aborts the request.
+type RequestInterceptor func(req *http.Request) error
+
+// ResponseInterceptor is called with every response received by a service, before the response is processed.
+// Returning an error discards the response and fails the operation.
+type ResponseInterceptor func(req *http.Request, res *http.Response) error
+
+// InterceptorTransport is an http.RoundTripper that runs the registered interceptors around every request sent
+// through the underlying transport. Interceptors run in the order they were registered.
+type InterceptorTransport struct {
+	// The transport that sends the requests. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	mutex                sync.RWMutex
+	requestInterceptors  []RequestInterceptor
+	responseInterceptors []ResponseInterceptor
+}
+
+// RegisterRequestInterceptor adds an interceptor that is called with every request sent by the service. The first
+// interceptor registered on a service wraps the transport of its HTTP client; register interceptors after calling
+// DisableSSLVerification() or SetHTTPClient(), which replace the transport.
+func RegisterRequestInterceptor(service *core.BaseService, interceptor RequestInterceptor) *InterceptorTransport {
+	transport := interceptorTransport(service)
+	transport.AddRequestInterceptor(interceptor)
+	return transport
+}
+
+// RegisterResponseInterceptor adds an interceptor that is called with every response received by the service. The
+// first interceptor registered on a service wraps the transport of its HTTP client; register interceptors after
+// calling DisableSSLVerification() or SetHTTPClient(), which replace the transport.
+func RegisterResponseInterceptor(service *core.BaseService, interceptor ResponseInterceptor) *InterceptorTransport {
+	transport := interceptorTransport(service)
+	transport.AddResponseInterceptor(interceptor)
+	return transport
+}
+
+// interceptorTransport returns the InterceptorTransport of the service, installing one if it has none.
+func interceptorTransport(service *core.BaseService) *InterceptorTransport {
+	if service.Client != nil {
+		if transport, ok := service.Client.Transport.(*InterceptorTransport); ok {
+			return transport
+		}
+	}
+
+	var transport *InterceptorTransport
+	wrapTransport(service, func(next http.RoundTripper) http.RoundTripper {
+		transport = &InterceptorTransport{Transport: next}
+		return transport
+	})
+	return transport
+}
+
+// wrapTransport replaces the HTTP client of the service with a copy whose transport is the result of wrap, which is
+// called with the current transport.
+func wrapTransport(service *core.BaseService, wrap func(next http.RoundTripper) http.RoundTripper) {
+	client := service.Client
+	if client == nil {
+		client = &http.Client{}
+	}
+	wrapped := *client
+	wrapped.Transport = wrap(client.Transport)
+	service.SetHTTPClient(&wrapped)
+}
+
+// AddRequestInterceptor adds an interceptor that is called with every request.
+func (transport *InterceptorTransport) AddRequestInterceptor(interceptor RequestInterceptor) {
+	transport.mutex.Lock()
+	transport.requestInterceptors = append(transport.requestInterceptors, interceptor)
+	transport.mutex.Unlock()
+}
+
+// AddResponseInterceptor adds an interceptor that is called with every response.
+func (transport *InterceptorTransport) AddResponseInterceptor(interceptor ResponseInterceptor) {
+	transport.mutex.Lock()
+	transport.responseInterceptors = append(transport.responseInterceptors, interceptor)
+	transport.mutex.Unlock()
+}
+
+// RoundTrip runs the request interceptors on a copy of the request, sends it, and runs the response interceptors on
+// the response.
+func (transport *InterceptorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport.mutex.RLock()
+	requestInterceptors := transport.requestInterceptors
+	responseInterceptors := transport.responseInterceptors
+	transport.mutex.RUnlock()
+
+	intercepted := cloneRequest(req)
+	for _, interceptor := range requestInterceptors {
+		if err := interceptor(intercepted); err != nil {
+			if intercepted.Body != nil {
+				intercepted.Body.Close()
+			}
+			return nil, err
+		}
+	}
+
+	next := transport.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	res, err := next.RoundTrip(intercepted)
+	if err != nil {
+		return nil, err
+	}
+	for _, interceptor := range responseInterceptors {
+		if err := interceptor(intercepted, res); err != nil {
+			res.Body.Close()
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// cloneRequest returns a shallow copy of the request with a copy of its headers, which may be modified: a
+// RoundTripper must not modify the request it is given.
+func cloneRequest(req *http.Request) *http.Request {
+	clone := new(http.Request)
+	*clone = *req
+	clone.Header = make(http.Header, len(req.Header))
+	for name, values := range req.Header {
+		clone.Header[name] = append([]string(nil), values...)
+	}
+	return clone
+}
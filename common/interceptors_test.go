@@ -0,0 +1,77 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterceptorTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "trace-1", req.Header.Get("X-Trace-Id"))
+		assert.Equal(t, "Bearer secret", req.Header.Get("Authorization"))
+		res.Header().Set("X-Global-Transaction-Id", "transaction-1")
+	}))
+	defer server.Close()
+
+	transport := &InterceptorTransport{}
+	var order []string
+	transport.AddRequestInterceptor(func(req *http.Request) error {
+		order = append(order, "request")
+		req.Header.Set("X-Trace-Id", "trace-1")
+		return nil
+	})
+	var transactionID string
+	transport.AddResponseInterceptor(func(req *http.Request, res *http.Response) error {
+		order = append(order, "response")
+		assert.Equal(t, "trace-1", req.Header.Get("X-Trace-Id"))
+		transactionID = res.Header.Get("X-Global-Transaction-Id")
+		return nil
+	})
+	client := &http.Client{Transport: transport}
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, []string{"request", "response"}, order)
+	assert.Equal(t, "transaction-1", transactionID)
+	assert.Equal(t, "", req.Header.Get("X-Trace-Id"))
+}
+
+func TestInterceptorTransportErrors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	transport := &InterceptorTransport{}
+	transport.AddRequestInterceptor(func(req *http.Request) error {
+		if req.Method == "DELETE" {
+			return errors.New("deletes are not allowed")
+		}
+		return nil
+	})
+	transport.AddResponseInterceptor(func(req *http.Request, res *http.Response) error {
+		return errors.New("response rejected")
+	})
+	client := &http.Client{Transport: transport}
+
+	req, _ := http.NewRequest("DELETE", server.URL, nil)
+	_, err := client.Do(req)
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "deletes are not allowed"))
+	assert.Equal(t, 0, requests)
+
+	req, _ = http.NewRequest("GET", server.URL, nil)
+	_, err = client.Do(req)
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "response rejected"))
+	assert.Equal(t, 1, requests)
+}
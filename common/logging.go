@@ -0,0 +1,189 @@
+package common
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+var redactedQueryParameters = []string{"apikey", "api_key", "access_token", "watson-token"}
+
+// RequestLog is the record of a request sent by a service, with credentials redacted.
+type RequestLog struct {
+	Method string
+
+	// The URL of the request, with credential query parameters replaced by REDACTED.
+	URL string
+
+	// The headers of the request, with credential values replaced by REDACTED.
+	RequestHeaders http.Header
+
+	// The status code and headers of the response. StatusCode is zero if no response was received.
+	StatusCode      int
+	ResponseHeaders http.Header
+
+	// The time from sending the request until the response headers were received.
+	Latency time.Duration
+
+	// The error that prevented a response from being received, if any.
+	Err error
+}
+
+// Logger receives a RequestLog for every request sent by a service on which logging is enabled.
+type Logger interface {
+	LogRequest(entry *RequestLog)
+}
+
+// LoggerFunc adapts a function to the Logger interface, for example to forward entries to a structured logger.
+type LoggerFunc func(entry *RequestLog)
+
+// LogRequest calls the function.
+func (logger LoggerFunc) LogRequest(entry *RequestLog) {
+	logger(entry)
+}
+
+// NewStdLogger returns a Logger that prints one line per request to a logger of the standard log package. A nil
+// logger prints to the standard logger.
+func NewStdLogger(logger *log.Logger) Logger {
+	return LoggerFunc(func(entry *RequestLog) {
+		if logger == nil {
+			log.Print(entry.String())
+			return
+		}
+		logger.Print(entry.String())
+	})
+}
+
+// LoggingTransport is an http.RoundTripper that sends requests through the underlying transport and logs each of
+// them.
+type LoggingTransport struct {
+	// The transport that sends the requests. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	Logger Logger
+}
+
+// EnableLogging wraps the transport of the HTTP client of the service so that every request is logged. Enable
+// logging after calling DisableSSLVerification() or SetHTTPClient(), which replace the transport.
+func EnableLogging(service *core.BaseService, logger Logger) *LoggingTransport {
+	var transport *LoggingTransport
+	wrapTransport(service, func(next http.RoundTripper) http.RoundTripper {
+		transport = &LoggingTransport{Transport: next, Logger: logger}
+		return transport
+	})
+	return transport
+}
+
+// RoundTrip sends the request and logs it together with its response.
+func (transport *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	start := time.Now()
+	res, err := next.RoundTrip(req)
+
+	entry := &RequestLog{
+		Method:         req.Method,
+		URL:            RedactedURL(req.URL),
+		RequestHeaders: RedactedHeaders(req),
+		Latency:        time.Since(start),
+		Err:            err,
+	}
+	if res != nil {
+		entry.StatusCode = res.StatusCode
+		entry.ResponseHeaders = res.Header
+	}
+	if transport.Logger != nil {
+		transport.Logger.LogRequest(entry)
+	}
+	return res, err
+}
+
+// RedactedURL returns the URL with the values of credential query parameters replaced by REDACTED.
+func RedactedURL(requestURL *url.URL) string {
+	if requestURL.RawQuery == "" {
+		return requestURL.String()
+	}
+	query := requestURL.Query()
+	redacted := false
+	for name := range query {
+		for _, credential := range redactedQueryParameters {
+			if strings.EqualFold(name, credential) {
+				query.Set(name, REDACTED)
+				redacted = true
+			}
+		}
+	}
+	if !redacted {
+		return requestURL.String()
+	}
+	withoutCredentials := *requestURL
+	withoutCredentials.RawQuery = query.Encode()
+	return withoutCredentials.String()
+}
+
+// Fields returns the entry as a map, for loggers that accept structured fields.
+func (entry *RequestLog) Fields() map[string]interface{} {
+	fields := map[string]interface{}{
+		"method":          entry.Method,
+		"url":             entry.URL,
+		"request_headers": entry.RequestHeaders,
+		"latency":         entry.Latency,
+	}
+	if entry.StatusCode != 0 {
+		fields["status"] = entry.StatusCode
+		fields["response_headers"] = entry.ResponseHeaders
+	}
+	if entry.Err != nil {
+		fields["error"] = entry.Err.Error()
+	}
+	return fields
+}
+
+// KeyValues returns the fields of the entry as alternating keys and values, sorted by key, for loggers that accept
+// them in that form.
+func (entry *RequestLog) KeyValues() []interface{} {
+	fields := entry.Fields()
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	keyValues := make([]interface{}, 0, 2*len(keys))
+	for _, key := range keys {
+		keyValues = append(keyValues, key, fields[key])
+	}
+	return keyValues
+}
+
+// String returns a one-line description of the entry: the method, URL, status or error, latency and request headers.
+func (entry *RequestLog) String() string {
+	var description strings.Builder
+	fmt.Fprintf(&description, "%s %s", entry.Method, entry.URL)
+	if entry.Err != nil {
+		fmt.Fprintf(&description, " error=%q", entry.Err.Error())
+	} else {
+		fmt.Fprintf(&description, " status=%d", entry.StatusCode)
+	}
+	fmt.Fprintf(&description, " latency=%s", entry.Latency)
+
+	names := make([]string, 0, len(entry.RequestHeaders))
+	for name := range entry.RequestHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&description, " %s=%q", name, strings.Join(entry.RequestHeaders[name], ", "))
+	}
+	if transactionID := entry.ResponseHeaders.Get("X-Global-Transaction-Id"); transactionID != "" {
+		fmt.Fprintf(&description, " transaction_id=%s", transactionID)
+	}
+	return description.String()
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("X-Global-Transaction-Id", "transaction-1")
+		res.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	var entries []*RequestLog
+	transport := &LoggingTransport{Logger: LoggerFunc(func(entry *RequestLog) {
+		entries = append(entries, entry)
+	})}
+	client := &http.Client{Transport: transport}
+
+	req, _ := http.NewRequest("POST", server.URL+"/v1/recognize?model=en-US_BroadbandModel&watson-token=secret-token", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+
+	assert.Equal(t, 1, len(entries))
+	entry := entries[0]
+	assert.Equal(t, "POST", entry.Method)
+	assert.Equal(t, http.StatusBadRequest, entry.StatusCode)
+	assert.Equal(t, REDACTED, entry.RequestHeaders.Get("Authorization"))
+	assert.True(t, strings.Contains(entry.URL, "model=en-US_BroadbandModel"))
+	assert.False(t, strings.Contains(entry.URL, "secret-token"))
+	assert.Equal(t, 400, entry.Fields()["status"])
+	assert.Equal(t, "latency", entry.KeyValues()[0])
+
+	var output bytes.Buffer
+	NewStdLogger(log.New(&output, "", 0)).LogRequest(entry)
+	assert.True(t, strings.Contains(output.String(), "status=400"))
+	assert.True(t, strings.Contains(output.String(), "transaction_id=transaction-1"))
+	assert.False(t, strings.Contains(output.String(), "secret"))
+}
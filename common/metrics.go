@@ -0,0 +1,136 @@
+package common
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// MetricLabels identify the operation that a metric is recorded for.
+type MetricLabels struct {
+	// The service and operation, as sent in the HEADER_SDK_ANALYTICS header, for example `speech_to_text` and
+	// `Recognize`. Both are empty for requests without that header.
+	Service   string
+	Operation string
+
+	// The HTTP method of the request.
+	Method string
+}
+
+// Metrics receives the measurements of the requests sent by a service on which metrics are enabled. Implementations
+// typically increment counters and observe histograms of a metrics library such as Prometheus or statsd, and must be
+// safe for concurrent use.
+type Metrics interface {
+	// IncRequests is called once for every request sent.
+	IncRequests(labels MetricLabels)
+
+	// IncErrors is called for every request that failed. The status is the status code of the response, or zero if no
+	// response was received.
+	IncErrors(labels MetricLabels, status int)
+
+	// ObserveLatency is called for every response with the time from sending the request until the response headers
+	// were received.
+	ObserveLatency(labels MetricLabels, latency time.Duration)
+
+	// AddBytesUploaded is called for every request with a body, once the body has been sent, with its size.
+	AddBytesUploaded(labels MetricLabels, bytes int64)
+}
+
+// MetricsTransport is an http.RoundTripper that sends requests through the underlying transport and records metrics
+// for each of them.
+type MetricsTransport struct {
+	// The transport that sends the requests. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	Metrics Metrics
+}
+
+// EnableMetrics wraps the transport of the HTTP client of the service so that metrics are recorded for every request.
+// Enable metrics after calling DisableSSLVerification() or SetHTTPClient(), which replace the transport. Audio that is
+// streamed over a WebSocket connection is not sent through the HTTP client and is not measured.
+func EnableMetrics(service *core.BaseService, metrics Metrics) *MetricsTransport {
+	var transport *MetricsTransport
+	wrapTransport(service, func(next http.RoundTripper) http.RoundTripper {
+		transport = &MetricsTransport{Transport: next, Metrics: metrics}
+		return transport
+	})
+	return transport
+}
+
+// RoundTrip sends the request and records its metrics.
+func (transport *MetricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	labels := RequestMetricLabels(req)
+	transport.Metrics.IncRequests(labels)
+
+	if req.Body != nil && req.Body != http.NoBody {
+		counted := new(http.Request)
+		*counted = *req
+		counted.Body = &countingBody{
+			ReadCloser: req.Body,
+			report: func(bytes int64) {
+				transport.Metrics.AddBytesUploaded(labels, bytes)
+			},
+		}
+		req = counted
+	}
+
+	start := time.Now()
+	res, err := next.RoundTrip(req)
+	if err != nil {
+		transport.Metrics.IncErrors(labels, 0)
+		return nil, err
+	}
+	transport.Metrics.ObserveLatency(labels, time.Since(start))
+	if res.StatusCode >= 400 {
+		transport.Metrics.IncErrors(labels, res.StatusCode)
+	}
+	return res, nil
+}
+
+// RequestMetricLabels returns the labels of the metrics of a request.
+func RequestMetricLabels(req *http.Request) MetricLabels {
+	labels := MetricLabels{Method: req.Method}
+	for _, field := range strings.Split(req.Header.Get(HEADER_SDK_ANALYTICS), ";") {
+		keyValue := strings.SplitN(field, "=", 2)
+		if len(keyValue) != 2 {
+			continue
+		}
+		switch keyValue[0] {
+		case "service_name":
+			labels.Service = keyValue[1]
+		case "operation_id":
+			labels.Operation = keyValue[1]
+		}
+	}
+	return labels
+}
+
+// countingBody counts the bytes read from a request body and reports them once, when the body is closed.
+type countingBody struct {
+	io.ReadCloser
+	bytes  int64
+	once   sync.Once
+	report func(bytes int64)
+}
+
+func (body *countingBody) Read(p []byte) (int, error) {
+	n, err := body.ReadCloser.Read(p)
+	body.bytes += int64(n)
+	return n, err
+}
+
+func (body *countingBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.once.Do(func() {
+		body.report(body.bytes)
+	})
+	return err
+}
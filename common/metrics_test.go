@@ -0,0 +1,81 @@
+package common
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testMetrics struct {
+	mutex         sync.Mutex
+	requests      []MetricLabels
+	errors        []int
+	latencies     int
+	bytesUploaded int64
+}
+
+func (metrics *testMetrics) IncRequests(labels MetricLabels) {
+	metrics.mutex.Lock()
+	metrics.requests = append(metrics.requests, labels)
+	metrics.mutex.Unlock()
+}
+
+func (metrics *testMetrics) IncErrors(labels MetricLabels, status int) {
+	metrics.mutex.Lock()
+	metrics.errors = append(metrics.errors, status)
+	metrics.mutex.Unlock()
+}
+
+func (metrics *testMetrics) ObserveLatency(labels MetricLabels, latency time.Duration) {
+	metrics.mutex.Lock()
+	metrics.latencies++
+	metrics.mutex.Unlock()
+}
+
+func (metrics *testMetrics) AddBytesUploaded(labels MetricLabels, bytes int64) {
+	metrics.mutex.Lock()
+	metrics.bytesUploaded += bytes
+	metrics.mutex.Unlock()
+}
+
+func TestMetricsTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ioutil.ReadAll(req.Body)
+		if req.URL.Path == "/missing" {
+			res.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	metrics := &testMetrics{}
+	client := &http.Client{Transport: &MetricsTransport{Metrics: metrics}}
+
+	req, _ := http.NewRequest("POST", server.URL+"/v1/recognize", strings.NewReader("0123456789"))
+	for name, value := range GetSdkHeaders("speech_to_text", "V1", "Recognize") {
+		req.Header.Set(name, value)
+	}
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+
+	req, _ = http.NewRequest("GET", server.URL+"/missing", nil)
+	res, err = client.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	assert.Equal(t, []MetricLabels{
+		{Service: "speech_to_text", Operation: "Recognize", Method: "POST"},
+		{Method: "GET"},
+	}, metrics.requests)
+	assert.Equal(t, []int{http.StatusNotFound}, metrics.errors)
+	assert.Equal(t, 2, metrics.latencies)
+	assert.Equal(t, int64(10), metrics.bytesUploaded)
+}
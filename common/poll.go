@@ -0,0 +1,40 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrPollTimeout is returned by Poll when its timeout expires before the check is done.
+var ErrPollTimeout = errors.New("timed out")
+
+// Poll calls check immediately and then at each interval, which must be positive, until check reports that it is done
+// or returns an error, which Poll returns. It stops with ErrPollTimeout when the timeout expires, unless the timeout is
+// zero, and with the error of the context when the context is done.
+func Poll(ctx context.Context, interval time.Duration, timeout time.Duration, check func() (done bool, err error)) error {
+	var timeoutC <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check()
+		if done || err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeoutC:
+			return ErrPollTimeout
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollUntilDone(t *testing.T) {
+	checks := 0
+	err := Poll(context.Background(), time.Millisecond, 0, func() (bool, error) {
+		checks++
+		return checks == 3, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, checks)
+}
+
+func TestPollReturnsCheckError(t *testing.T) {
+	err := Poll(context.Background(), time.Millisecond, 0, func() (bool, error) {
+		return false, errors.New("not found")
+	})
+	assert.Equal(t, "not found", err.Error())
+}
+
+func TestPollTimesOut(t *testing.T) {
+	err := Poll(context.Background(), time.Millisecond, 10*time.Millisecond, func() (bool, error) {
+		return false, nil
+	})
+	assert.Equal(t, ErrPollTimeout, err)
+}
+
+func TestPollStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	checks := 0
+	err := Poll(ctx, time.Millisecond, 0, func() (bool, error) {
+		checks++
+		cancel()
+		return false, nil
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, checks)
+}
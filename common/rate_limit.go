@@ -0,0 +1,180 @@
+package common
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// DEFAULT_RATE_LIMIT_MAX_RETRIES is the number of times a request that is throttled with a 429 response is retried
+// by default.
+const DEFAULT_RATE_LIMIT_MAX_RETRIES = 3
+
+// DEFAULT_RATE_LIMIT_RETRY_DELAY is the delay before a throttled request is retried when the response has no
+// Retry-After header.
+const DEFAULT_RATE_LIMIT_RETRY_DELAY = time.Second
+
+// RateLimiter is a token bucket that allows a sustained rate of requests with bursts of up to a number of requests.
+// It is safe for concurrent use, so one limiter can be shared by several services.
+type RateLimiter struct {
+	requestsPerSecond float64
+	burst             float64
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that allows requestsPerSecond requests per second on average, and bursts of
+// up to burst requests. The bucket starts full.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		burst:             float64(burst),
+		tokens:            float64(burst),
+		last:              time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed, or until the context is done.
+func (limiter *RateLimiter) Wait(ctx context.Context) error {
+	delay := limiter.reserve()
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		limiter.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait until the token is available.
+func (limiter *RateLimiter) reserve() time.Duration {
+	if limiter.requestsPerSecond <= 0 {
+		return 0
+	}
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := time.Now()
+	limiter.tokens += now.Sub(limiter.last).Seconds() * limiter.requestsPerSecond
+	if limiter.tokens > limiter.burst {
+		limiter.tokens = limiter.burst
+	}
+	limiter.last = now
+
+	limiter.tokens--
+	if limiter.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-limiter.tokens / limiter.requestsPerSecond * float64(time.Second))
+}
+
+// cancel returns a reserved token that was not used.
+func (limiter *RateLimiter) cancel() {
+	limiter.mutex.Lock()
+	limiter.tokens++
+	limiter.mutex.Unlock()
+}
+
+// RateLimitTransport is an http.RoundTripper that sends requests through the underlying transport no faster than its
+// limiter allows, and retries requests that the service throttles with a 429 response.
+type RateLimitTransport struct {
+	// The transport that sends the requests. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// The limiter that requests wait for, including retries. Nil means that requests are not limited.
+	Limiter *RateLimiter
+
+	// The number of times a throttled request is retried. Requests whose body cannot be sent again are not retried.
+	MaxRetries int
+
+	// The delay before a throttled request is retried when the response has no Retry-After header.
+	RetryDelay time.Duration
+}
+
+// EnableRateLimit wraps the transport of the HTTP client of the service so that it sends at most requestsPerSecond
+// requests per second on average, in bursts of up to burst requests, and retries throttled requests up to
+// DEFAULT_RATE_LIMIT_MAX_RETRIES times. Enable rate limiting after calling DisableSSLVerification(),
+// SetHTTPClient() or ConfigureTLS(), which replace the transport.
+func EnableRateLimit(service *core.BaseService, requestsPerSecond float64, burst int) *RateLimitTransport {
+	var transport *RateLimitTransport
+	wrapTransport(service, func(next http.RoundTripper) http.RoundTripper {
+		transport = &RateLimitTransport{
+			Transport:  next,
+			Limiter:    NewRateLimiter(requestsPerSecond, burst),
+			MaxRetries: DEFAULT_RATE_LIMIT_MAX_RETRIES,
+			RetryDelay: DEFAULT_RATE_LIMIT_RETRY_DELAY,
+		}
+		return transport
+	})
+	return transport
+}
+
+// RoundTrip waits for the limiter and sends the request, retrying it while the service throttles it.
+func (transport *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	ctx := req.Context()
+	attempt := req
+	for retries := 0; ; retries++ {
+		if transport.Limiter != nil {
+			if err := transport.Limiter.Wait(ctx); err != nil {
+				if attempt.Body != nil {
+					attempt.Body.Close()
+				}
+				return nil, err
+			}
+		}
+		res, err := next.RoundTrip(attempt)
+		if err != nil || res.StatusCode != http.StatusTooManyRequests || retries >= transport.MaxRetries {
+			return res, err
+		}
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return res, nil
+		}
+
+		delay := retryAfterDelay(res.Header, transport.RetryDelay)
+		io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		attempt = cloneRequest(req)
+		if req.GetBody != nil {
+			if attempt.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// retryAfterDelay returns the delay requested by the Retry-After header, in seconds, or retryDelay if there is none.
+func retryAfterDelay(header http.Header, retryDelay time.Duration) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return retryDelay
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(50, 2)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, limiter.Wait(context.Background()))
+	}
+	// The first two requests are a burst; the next two wait 20ms each.
+	assert.True(t, time.Since(start) >= 35*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NotNil(t, limiter.Wait(ctx))
+}
+
+func TestRateLimitTransportRetriesThrottledRequests(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		assert.Equal(t, `{"text":"hello"}`, string(body))
+		if atomic.AddInt32(&requests, 1) < 3 {
+			res.Header().Set("Retry-After", "0")
+			res.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	transport := &RateLimitTransport{
+		Limiter:    NewRateLimiter(1000, 1),
+		MaxRetries: DEFAULT_RATE_LIMIT_MAX_RETRIES,
+		RetryDelay: time.Millisecond,
+	}
+	client := &http.Client{Transport: transport}
+
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{"text":"hello"}`))
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	transport.MaxRetries = 1
+	atomic.StoreInt32(&requests, 0)
+	req, _ = http.NewRequest("POST", server.URL, strings.NewReader(`{"text":"hello"}`))
+	res, err = client.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	header := http.Header{}
+	assert.Equal(t, time.Second, retryAfterDelay(header, time.Second))
+	header.Set("Retry-After", "5")
+	assert.Equal(t, 5*time.Second, retryAfterDelay(header, time.Second))
+}
@@ -0,0 +1,163 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+const (
+	HEADER_GLOBAL_TRANSACTION_ID = "X-Global-Transaction-Id"
+	HEADER_WATSON_TRANSACTION_ID = "X-Dp-Watson-Tran-Id"
+)
+
+// Sentinel errors matched by ServiceError.Is according to the HTTP status code of the failed request, so that
+// callers can write errors.Is(err, common.ErrNotFound).
+var (
+	ErrBadRequest          = errors.New("bad request")
+	ErrUnauthorized        = errors.New("unauthorized")
+	ErrForbidden           = errors.New("forbidden")
+	ErrNotFound            = errors.New("not found")
+	ErrConflict            = errors.New("conflict")
+	ErrRequestTooLarge     = errors.New("request entity too large")
+	ErrUnsupportedMedia    = errors.New("unsupported media type")
+	ErrTooManyRequests     = errors.New("too many requests")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrServiceUnavailable  = errors.New("service unavailable")
+)
+
+var statusSentinels = map[int]error{
+	http.StatusBadRequest:            ErrBadRequest,
+	http.StatusUnauthorized:          ErrUnauthorized,
+	http.StatusForbidden:             ErrForbidden,
+	http.StatusNotFound:              ErrNotFound,
+	http.StatusConflict:              ErrConflict,
+	http.StatusRequestEntityTooLarge: ErrRequestTooLarge,
+	http.StatusUnsupportedMediaType:  ErrUnsupportedMedia,
+	http.StatusTooManyRequests:       ErrTooManyRequests,
+	http.StatusInternalServerError:   ErrInternalServerError,
+	http.StatusServiceUnavailable:    ErrServiceUnavailable,
+}
+
+// ServiceError is returned by service operations when the service responds with an error status code.
+type ServiceError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The service-specific error code, when the service provides one.
+	ErrorCode string
+
+	// The error message returned by the service.
+	Message string
+
+	// The value of the X-Global-Transaction-Id response header, which IBM support uses to trace the request.
+	TransactionID string
+
+	// The full response, including headers and the decoded error body.
+	Response *core.DetailedResponse
+
+	// The error returned by the core request handling.
+	Err error
+}
+
+// NewServiceError converts the error returned by BaseService.Request into a *ServiceError when the service
+// responded with an error status code. Other errors, such as transport failures, are returned unchanged.
+func NewServiceError(response *core.DetailedResponse, err error) error {
+	if err == nil || response == nil || response.StatusCode < 400 {
+		return err
+	}
+
+	serviceError := &ServiceError{
+		StatusCode: response.StatusCode,
+		Message:    err.Error(),
+		Response:   response,
+		Err:        err,
+	}
+	if response.Headers != nil {
+		serviceError.TransactionID = response.Headers.Get(HEADER_GLOBAL_TRANSACTION_ID)
+		if serviceError.TransactionID == "" {
+			serviceError.TransactionID = response.Headers.Get(HEADER_WATSON_TRANSACTION_ID)
+		}
+	}
+	serviceError.parseBody(response.Result)
+	return serviceError
+}
+
+// Error returns the error message, prefixed with the status code.
+func (serviceError *ServiceError) Error() string {
+	if serviceError.TransactionID != "" {
+		return fmt.Sprintf("%d: %s (transaction ID %s)", serviceError.StatusCode, serviceError.Message, serviceError.TransactionID)
+	}
+	return fmt.Sprintf("%d: %s", serviceError.StatusCode, serviceError.Message)
+}
+
+// Unwrap returns the error returned by the core request handling.
+func (serviceError *ServiceError) Unwrap() error {
+	return serviceError.Err
+}
+
+// Is reports whether target is the sentinel error for the status code, such as ErrNotFound for a 404 response.
+func (serviceError *ServiceError) Is(target error) bool {
+	sentinel, ok := statusSentinels[serviceError.StatusCode]
+	return ok && sentinel == target
+}
+
+// parseBody extracts the error code and message from the error body returned by the service. Watson services use
+// several error body formats, for example `{"code": 404, "error": "..."}` and
+// `{"errors": [{"code": "...", "message": "..."}]}`.
+func (serviceError *ServiceError) parseBody(result interface{}) {
+	var body map[string]interface{}
+	switch result := result.(type) {
+	case map[string]interface{}:
+		body = result
+	case *map[string]interface{}:
+		if result != nil {
+			body = *result
+		}
+	case []byte:
+		_ = json.Unmarshal(result, &body)
+	case string:
+		_ = json.Unmarshal([]byte(result), &body)
+	}
+	if body == nil {
+		return
+	}
+
+	if errorList, ok := body["errors"].([]interface{}); ok && len(errorList) > 0 {
+		if first, ok := errorList[0].(map[string]interface{}); ok {
+			serviceError.ErrorCode = stringValue(first["code"])
+			if message, ok := first["message"].(string); ok && message != "" {
+				serviceError.Message = message
+				body = first
+			}
+		}
+	}
+	for _, key := range []string{"error_code", "code_description", "code"} {
+		if serviceError.ErrorCode != "" {
+			break
+		}
+		serviceError.ErrorCode = stringValue(body[key])
+	}
+	for _, key := range []string{"message", "error", "description"} {
+		if message, ok := body[key].(string); ok && message != "" {
+			serviceError.Message = message
+			break
+		}
+	}
+}
+
+func stringValue(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case float64:
+		return fmt.Sprintf("%g", value)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,55 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewServiceError(t *testing.T) {
+	headers := http.Header{}
+	headers.Set(HEADER_GLOBAL_TRANSACTION_ID, "abc-123")
+	response := &core.DetailedResponse{
+		StatusCode: http.StatusNotFound,
+		Headers:    headers,
+		Result: map[string]interface{}{
+			"code":             float64(404),
+			"code_description": "Not Found",
+			"error":            "Model en-XX_BroadbandModel not found",
+		},
+	}
+	coreErr := errors.New("Model en-XX_BroadbandModel not found")
+
+	err := NewServiceError(response, coreErr)
+	serviceError, ok := err.(*ServiceError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, serviceError.StatusCode)
+	assert.Equal(t, "Not Found", serviceError.ErrorCode)
+	assert.Equal(t, "Model en-XX_BroadbandModel not found", serviceError.Message)
+	assert.Equal(t, "abc-123", serviceError.TransactionID)
+	assert.True(t, serviceError.Is(ErrNotFound))
+	assert.False(t, serviceError.Is(ErrConflict))
+	assert.Equal(t, coreErr, serviceError.Unwrap())
+}
+
+func TestNewServiceErrorErrorsList(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: http.StatusConflict,
+		Result:     []byte(`{"errors":[{"code":"already_exists","message":"Project exists"}]}`),
+	}
+
+	serviceError := NewServiceError(response, errors.New("conflict")).(*ServiceError)
+	assert.Equal(t, "already_exists", serviceError.ErrorCode)
+	assert.Equal(t, "Project exists", serviceError.Message)
+	assert.True(t, serviceError.Is(ErrConflict))
+}
+
+func TestNewServiceErrorPassthrough(t *testing.T) {
+	assert.Nil(t, NewServiceError(&core.DetailedResponse{StatusCode: 200}, nil))
+
+	transportErr := errors.New("connection refused")
+	assert.Equal(t, transportErr, NewServiceError(nil, transportErr))
+}
@@ -0,0 +1,96 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// TLSOptions configure the TLS connections of a service, for example to reach an on-premises gateway whose
+// certificate is signed by a private certificate authority.
+type TLSOptions struct {
+	// PEM-encoded certificates of certificate authorities that are trusted in addition to the system ones.
+	CACertificates []byte
+
+	// A PEM-encoded client certificate and its key, presented to services that require mutual TLS.
+	ClientCertificate []byte
+	ClientKey         []byte
+
+	// Disables the verification of the certificate of the service. Only use it for testing.
+	InsecureSkipVerify bool
+}
+
+// TLSConfig returns the tls.Config described by the options.
+func (options *TLSOptions) TLSConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	if len(options.CACertificates) > 0 {
+		roots, err := x509.SystemCertPool()
+		if err != nil {
+			roots = x509.NewCertPool()
+		}
+		if !roots.AppendCertsFromPEM(options.CACertificates) {
+			return nil, fmt.Errorf("No certificates could be parsed from the CACertificates")
+		}
+		config.RootCAs = roots
+	}
+
+	if len(options.ClientCertificate) > 0 || len(options.ClientKey) > 0 {
+		certificate, err := tls.X509KeyPair(options.ClientCertificate, options.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("The client certificate could not be loaded: %s", err.Error())
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	return config, nil
+}
+
+// ConfigureTLS replaces the transport of the HTTP client of the service with one that uses the TLS options. Other
+// settings of the client, such as its timeout, and of its transport, such as its proxy, are kept. Configure TLS before enabling logging, metrics, tracing,
+// rate limiting or interceptors, which wrap the transport, and instead of calling DisableSSLVerification().
+func ConfigureTLS(service *core.BaseService, options *TLSOptions) error {
+	config, err := options.TLSConfig()
+	if err != nil {
+		return err
+	}
+	client := service.Client
+	if client == nil {
+		client = &http.Client{}
+	}
+	withTLS := *client
+	if client.Transport == nil {
+		withTLS.Transport = newTLSTransport(config)
+	} else if transport, ok := client.Transport.(*http.Transport); ok {
+		clone := transport.Clone()
+		clone.TLSClientConfig = config
+		withTLS.Transport = clone
+	} else {
+		return fmt.Errorf("TLS can only be configured before the transport of the service is wrapped")
+	}
+	service.SetHTTPClient(&withTLS)
+	return nil
+}
+
+// newTLSTransport returns a transport with the settings of http.DefaultTransport and the TLS configuration.
+func newTLSTransport(config *tls.Config) *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			DualStack: true,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       config,
+	}
+}
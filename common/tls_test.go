@@ -0,0 +1,64 @@
+package common
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {}))
+	defer server.Close()
+
+	_, err := (&http.Client{}).Get(server.URL)
+	assert.NotNil(t, err)
+
+	caCertificates := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	config, err := (&TLSOptions{CACertificates: caCertificates}).TLSConfig()
+	assert.Nil(t, err)
+	res, err := (&http.Client{Transport: newTLSTransport(config)}).Get(server.URL)
+	assert.Nil(t, err)
+	res.Body.Close()
+
+	config, err = (&TLSOptions{InsecureSkipVerify: true}).TLSConfig()
+	assert.Nil(t, err)
+	res, err = (&http.Client{Transport: newTLSTransport(config)}).Get(server.URL)
+	assert.Nil(t, err)
+	res.Body.Close()
+}
+
+func TestTLSOptionsErrors(t *testing.T) {
+	_, err := (&TLSOptions{CACertificates: []byte("not a certificate")}).TLSConfig()
+	assert.NotNil(t, err)
+
+	_, err = (&TLSOptions{ClientCertificate: []byte("not a certificate")}).TLSConfig()
+	assert.NotNil(t, err)
+}
+
+func TestConfigureTLSKeepsTheTransportSettings(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+	transport := &http.Transport{
+		Proxy:        http.ProxyURL(proxyURL),
+		MaxIdleConns: 7,
+	}
+	service := &core.BaseService{Client: &http.Client{Transport: transport, Timeout: 5 * time.Second}}
+
+	err := ConfigureTLS(service, &TLSOptions{InsecureSkipVerify: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 5*time.Second, service.Client.Timeout)
+	withTLS := service.Client.Transport.(*http.Transport)
+	assert.True(t, withTLS.TLSClientConfig.InsecureSkipVerify)
+	assert.Equal(t, 7, withTLS.MaxIdleConns)
+	proxy, err := withTLS.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "api.example.com"}})
+	assert.Nil(t, err)
+	assert.Equal(t, proxyURL, proxy)
+
+	// The transport of the client that was passed in is not changed.
+	assert.True(t, transport.TLSClientConfig == nil || !transport.TLSClientConfig.InsecureSkipVerify)
+}
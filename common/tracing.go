@@ -0,0 +1,72 @@
+package common
+
+import (
+	"net/http"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// Tracer starts a span for every request sent by a service on which tracing is enabled. It is the bridge to a
+// tracing library such as OpenTracing or OpenCensus.
+type Tracer interface {
+	// StartSpan starts the span of a request. The parent span can be extracted from the headers of the request, which
+	// include the headers set on the options of the operation, and the trace headers of the new span should be injected
+	// into them so that they are propagated to the service.
+	StartSpan(req *http.Request, labels MetricLabels) Span
+}
+
+// Span is the span of a single request.
+type Span interface {
+	// Finish ends the span. The status is the status code of the response, or zero if no response was received, in
+	// which case err is the reason.
+	Finish(status int, err error)
+}
+
+// TracingTransport is an http.RoundTripper that sends requests through the underlying transport within a span.
+type TracingTransport struct {
+	// The transport that sends the requests. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	Tracer Tracer
+}
+
+// EnableTracing wraps the transport of the HTTP client of the service so that a span is created for every request.
+// Enable tracing after calling DisableSSLVerification() or SetHTTPClient(), which replace the transport.
+func EnableTracing(service *core.BaseService, tracer Tracer) *TracingTransport {
+	var transport *TracingTransport
+	wrapTransport(service, func(next http.RoundTripper) http.RoundTripper {
+		transport = &TracingTransport{Transport: next, Tracer: tracer}
+		return transport
+	})
+	return transport
+}
+
+// RoundTrip starts a span, sends the request with the trace headers of the span, and finishes the span.
+func (transport *TracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	traced := cloneRequest(req)
+	span := transport.Tracer.StartSpan(traced, RequestMetricLabels(req))
+
+	res, err := next.RoundTrip(traced)
+	if err != nil {
+		span.Finish(0, err)
+		return nil, err
+	}
+	span.Finish(res.StatusCode, nil)
+	return res, nil
+}
+
+// SpanName returns the name of the span of an operation, for example `speech_to_text.Recognize`. Requests without an
+// operation are named after their HTTP method.
+func SpanName(labels MetricLabels) string {
+	if labels.Operation == "" {
+		return labels.Method
+	}
+	if labels.Service == "" {
+		return labels.Operation
+	}
+	return labels.Service + "." + labels.Operation
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSpan struct {
+	name   string
+	parent string
+	status int
+	err    error
+}
+
+func (span *testSpan) Finish(status int, err error) {
+	span.status = status
+	span.err = err
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (tracer *testTracer) StartSpan(req *http.Request, labels MetricLabels) Span {
+	span := &testSpan{name: SpanName(labels), parent: req.Header.Get("X-Trace-Id")}
+	tracer.spans = append(tracer.spans, span)
+	req.Header.Set("X-Trace-Id", "span-1")
+	return span
+}
+
+func TestTracingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "span-1", req.Header.Get("X-Trace-Id"))
+		res.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	client := &http.Client{Transport: &TracingTransport{Tracer: tracer}}
+
+	req, _ := http.NewRequest("POST", server.URL+"/v1/recognitions", nil)
+	for name, value := range GetSdkHeaders("speech_to_text", "V1", "CreateJob") {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("X-Trace-Id", "parent-1")
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, "parent-1", req.Header.Get("X-Trace-Id"))
+
+	assert.Equal(t, 1, len(tracer.spans))
+	assert.Equal(t, "speech_to_text.CreateJob", tracer.spans[0].name)
+	assert.Equal(t, "parent-1", tracer.spans[0].parent)
+	assert.Equal(t, http.StatusAccepted, tracer.spans[0].status)
+	assert.Nil(t, tracer.spans[0].err)
+}
+
+func TestSpanName(t *testing.T) {
+	assert.Equal(t, "speech_to_text.Recognize", SpanName(MetricLabels{Service: "speech_to_text", Operation: "Recognize", Method: "POST"}))
+	assert.Equal(t, "GET", SpanName(MetricLabels{Method: "GET"}))
+}
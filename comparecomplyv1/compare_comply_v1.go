@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 )
 
 // CompareComplyV1 : IBM Watson&trade; Compare and Comply analyzes governing documents to provide details about critical
@@ -42,6 +43,7 @@ type CompareComplyV1Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewCompareComplyV1 : Instantiate CompareComplyV1
@@ -56,7 +58,7 @@ func NewCompareComplyV1(options *CompareComplyV1Options) (service *CompareComply
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("compare-comply")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("compare-comply")
 		if err != nil {
 			return
 		}
@@ -67,6 +69,10 @@ func NewCompareComplyV1(options *CompareComplyV1Options) (service *CompareComply
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &CompareComplyV1{
 		Service: baseService,
 		Version: options.Version,
@@ -131,6 +137,7 @@ func (compareComply *CompareComplyV1) ConvertToHTML(convertToHTMLOptions *Conver
 	}
 
 	response, err = compareComply.Service.Request(request, new(HTMLReturn))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*HTMLReturn)
@@ -188,6 +195,7 @@ func (compareComply *CompareComplyV1) ClassifyElements(classifyElementsOptions *
 	}
 
 	response, err = compareComply.Service.Request(request, new(ClassifyReturn))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ClassifyReturn)
@@ -245,6 +253,7 @@ func (compareComply *CompareComplyV1) ExtractTables(extractTablesOptions *Extrac
 	}
 
 	response, err = compareComply.Service.Request(request, new(TableReturn))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TableReturn)
@@ -310,6 +319,7 @@ func (compareComply *CompareComplyV1) CompareDocuments(compareDocumentsOptions *
 	}
 
 	response, err = compareComply.Service.Request(request, new(CompareReturn))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CompareReturn)
@@ -378,6 +388,7 @@ func (compareComply *CompareComplyV1) AddFeedback(addFeedbackOptions *AddFeedbac
 	}
 
 	response, err = compareComply.Service.Request(request, new(FeedbackReturn))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*FeedbackReturn)
@@ -473,6 +484,7 @@ func (compareComply *CompareComplyV1) ListFeedback(listFeedbackOptions *ListFeed
 	}
 
 	response, err = compareComply.Service.Request(request, new(FeedbackList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*FeedbackList)
@@ -527,6 +539,7 @@ func (compareComply *CompareComplyV1) GetFeedback(getFeedbackOptions *GetFeedbac
 	}
 
 	response, err = compareComply.Service.Request(request, new(GetFeedback))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*GetFeedback)
@@ -581,6 +594,7 @@ func (compareComply *CompareComplyV1) DeleteFeedback(deleteFeedbackOptions *Dele
 	}
 
 	response, err = compareComply.Service.Request(request, new(FeedbackDeleted))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*FeedbackDeleted)
@@ -650,6 +664,7 @@ func (compareComply *CompareComplyV1) CreateBatch(createBatchOptions *CreateBatc
 	}
 
 	response, err = compareComply.Service.Request(request, new(BatchStatus))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*BatchStatus)
@@ -696,6 +711,7 @@ func (compareComply *CompareComplyV1) ListBatches(listBatchesOptions *ListBatche
 	}
 
 	response, err = compareComply.Service.Request(request, new(Batches))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Batches)
@@ -746,6 +762,7 @@ func (compareComply *CompareComplyV1) GetBatch(getBatchOptions *GetBatchOptions)
 	}
 
 	response, err = compareComply.Service.Request(request, new(BatchStatus))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*BatchStatus)
@@ -802,6 +819,7 @@ func (compareComply *CompareComplyV1) UpdateBatch(updateBatchOptions *UpdateBatc
 	}
 
 	response, err = compareComply.Service.Request(request, new(BatchStatus))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*BatchStatus)
@@ -18,11 +18,13 @@
 package discoveryv1
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/edwindvinas/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 	"strings"
 )
 
@@ -45,6 +47,7 @@ type DiscoveryV1Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewDiscoveryV1 : Instantiate DiscoveryV1
@@ -59,7 +62,7 @@ func NewDiscoveryV1(options *DiscoveryV1Options) (service *DiscoveryV1, err erro
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("discovery")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("discovery")
 		if err != nil {
 			return
 		}
@@ -70,6 +73,10 @@ func NewDiscoveryV1(options *DiscoveryV1Options) (service *DiscoveryV1, err erro
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &DiscoveryV1{
 		Service: baseService,
 		Version: options.Version,
@@ -146,6 +153,7 @@ func (discovery *DiscoveryV1) CreateEnvironment(createEnvironmentOptions *Create
 	}
 
 	response, err = discovery.Service.Request(request, new(Environment))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Environment)
@@ -196,6 +204,7 @@ func (discovery *DiscoveryV1) ListEnvironments(listEnvironmentsOptions *ListEnvi
 	}
 
 	response, err = discovery.Service.Request(request, new(ListEnvironmentsResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListEnvironmentsResponse)
@@ -245,6 +254,7 @@ func (discovery *DiscoveryV1) GetEnvironment(getEnvironmentOptions *GetEnvironme
 	}
 
 	response, err = discovery.Service.Request(request, new(Environment))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Environment)
@@ -312,6 +322,7 @@ func (discovery *DiscoveryV1) UpdateEnvironment(updateEnvironmentOptions *Update
 	}
 
 	response, err = discovery.Service.Request(request, new(Environment))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Environment)
@@ -361,6 +372,7 @@ func (discovery *DiscoveryV1) DeleteEnvironment(deleteEnvironmentOptions *Delete
 	}
 
 	response, err = discovery.Service.Request(request, new(DeleteEnvironmentResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteEnvironmentResponse)
@@ -413,6 +425,7 @@ func (discovery *DiscoveryV1) ListFields(listFieldsOptions *ListFieldsOptions) (
 	}
 
 	response, err = discovery.Service.Request(request, new(ListCollectionFieldsResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListCollectionFieldsResponse)
@@ -496,6 +509,7 @@ func (discovery *DiscoveryV1) CreateConfiguration(createConfigurationOptions *Cr
 	}
 
 	response, err = discovery.Service.Request(request, new(Configuration))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Configuration)
@@ -550,6 +564,7 @@ func (discovery *DiscoveryV1) ListConfigurations(listConfigurationsOptions *List
 	}
 
 	response, err = discovery.Service.Request(request, new(ListConfigurationsResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListConfigurationsResponse)
@@ -599,6 +614,7 @@ func (discovery *DiscoveryV1) GetConfiguration(getConfigurationOptions *GetConfi
 	}
 
 	response, err = discovery.Service.Request(request, new(Configuration))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Configuration)
@@ -680,6 +696,7 @@ func (discovery *DiscoveryV1) UpdateConfiguration(updateConfigurationOptions *Up
 	}
 
 	response, err = discovery.Service.Request(request, new(Configuration))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Configuration)
@@ -733,6 +750,7 @@ func (discovery *DiscoveryV1) DeleteConfiguration(deleteConfigurationOptions *De
 	}
 
 	response, err = discovery.Service.Request(request, new(DeleteConfigurationResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteConfigurationResponse)
@@ -801,6 +819,7 @@ func (discovery *DiscoveryV1) CreateCollection(createCollectionOptions *CreateCo
 	}
 
 	response, err = discovery.Service.Request(request, new(Collection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -855,6 +874,7 @@ func (discovery *DiscoveryV1) ListCollections(listCollectionsOptions *ListCollec
 	}
 
 	response, err = discovery.Service.Request(request, new(ListCollectionsResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListCollectionsResponse)
@@ -904,6 +924,7 @@ func (discovery *DiscoveryV1) GetCollection(getCollectionOptions *GetCollectionO
 	}
 
 	response, err = discovery.Service.Request(request, new(Collection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -969,6 +990,7 @@ func (discovery *DiscoveryV1) UpdateCollection(updateCollectionOptions *UpdateCo
 	}
 
 	response, err = discovery.Service.Request(request, new(Collection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -1018,6 +1040,7 @@ func (discovery *DiscoveryV1) DeleteCollection(deleteCollectionOptions *DeleteCo
 	}
 
 	response, err = discovery.Service.Request(request, new(DeleteCollectionResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteCollectionResponse)
@@ -1068,6 +1091,7 @@ func (discovery *DiscoveryV1) ListCollectionFields(listCollectionFieldsOptions *
 	}
 
 	response, err = discovery.Service.Request(request, new(ListCollectionFieldsResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListCollectionFieldsResponse)
@@ -1119,6 +1143,7 @@ func (discovery *DiscoveryV1) ListExpansions(listExpansionsOptions *ListExpansio
 	}
 
 	response, err = discovery.Service.Request(request, new(Expansions))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Expansions)
@@ -1180,6 +1205,7 @@ func (discovery *DiscoveryV1) CreateExpansions(createExpansionsOptions *CreateEx
 	}
 
 	response, err = discovery.Service.Request(request, new(Expansions))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Expansions)
@@ -1230,6 +1256,7 @@ func (discovery *DiscoveryV1) DeleteExpansions(deleteExpansionsOptions *DeleteEx
 	}
 
 	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1273,6 +1300,7 @@ func (discovery *DiscoveryV1) GetTokenizationDictionaryStatus(getTokenizationDic
 	}
 
 	response, err = discovery.Service.Request(request, new(TokenDictStatusResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TokenDictStatusResponse)
@@ -1333,6 +1361,7 @@ func (discovery *DiscoveryV1) CreateTokenizationDictionary(createTokenizationDic
 	}
 
 	response, err = discovery.Service.Request(request, new(TokenDictStatusResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TokenDictStatusResponse)
@@ -1382,6 +1411,7 @@ func (discovery *DiscoveryV1) DeleteTokenizationDictionary(deleteTokenizationDic
 	}
 
 	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1425,6 +1455,7 @@ func (discovery *DiscoveryV1) GetStopwordListStatus(getStopwordListStatusOptions
 	}
 
 	response, err = discovery.Service.Request(request, new(TokenDictStatusResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TokenDictStatusResponse)
@@ -1478,6 +1509,7 @@ func (discovery *DiscoveryV1) CreateStopwordList(createStopwordListOptions *Crea
 	}
 
 	response, err = discovery.Service.Request(request, new(TokenDictStatusResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TokenDictStatusResponse)
@@ -1528,6 +1560,7 @@ func (discovery *DiscoveryV1) DeleteStopwordList(deleteStopwordListOptions *Dele
 	}
 
 	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1604,6 +1637,7 @@ func (discovery *DiscoveryV1) AddDocument(addDocumentOptions *AddDocumentOptions
 	}
 
 	response, err = discovery.Service.Request(request, new(DocumentAccepted))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentAccepted)
@@ -1656,6 +1690,7 @@ func (discovery *DiscoveryV1) GetDocumentStatus(getDocumentStatusOptions *GetDoc
 	}
 
 	response, err = discovery.Service.Request(request, new(DocumentStatus))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentStatus)
@@ -1722,6 +1757,7 @@ func (discovery *DiscoveryV1) UpdateDocument(updateDocumentOptions *UpdateDocume
 	}
 
 	response, err = discovery.Service.Request(request, new(DocumentAccepted))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentAccepted)
@@ -1773,6 +1809,7 @@ func (discovery *DiscoveryV1) DeleteDocument(deleteDocumentOptions *DeleteDocume
 	}
 
 	response, err = discovery.Service.Request(request, new(DeleteDocumentResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteDocumentResponse)
@@ -1894,6 +1931,7 @@ func (discovery *DiscoveryV1) Query(queryOptions *QueryOptions) (result *QueryRe
 	}
 
 	response, err = discovery.Service.Request(request, new(QueryResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryResponse)
@@ -1999,6 +2037,7 @@ func (discovery *DiscoveryV1) QueryNotices(queryNoticesOptions *QueryNoticesOpti
 	}
 
 	response, err = discovery.Service.Request(request, new(QueryNoticesResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryNoticesResponse)
@@ -2120,6 +2159,7 @@ func (discovery *DiscoveryV1) FederatedQuery(federatedQueryOptions *FederatedQue
 	}
 
 	response, err = discovery.Service.Request(request, new(QueryResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryResponse)
@@ -2214,6 +2254,7 @@ func (discovery *DiscoveryV1) FederatedQueryNotices(federatedQueryNoticesOptions
 	}
 
 	response, err = discovery.Service.Request(request, new(QueryNoticesResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryNoticesResponse)
@@ -2273,6 +2314,7 @@ func (discovery *DiscoveryV1) GetAutocompletion(getAutocompletionOptions *GetAut
 	}
 
 	response, err = discovery.Service.Request(request, new(Completions))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Completions)
@@ -2323,6 +2365,7 @@ func (discovery *DiscoveryV1) ListTrainingData(listTrainingDataOptions *ListTrai
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingDataSet))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingDataSet)
@@ -2389,6 +2432,7 @@ func (discovery *DiscoveryV1) AddTrainingData(addTrainingDataOptions *AddTrainin
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -2438,6 +2482,7 @@ func (discovery *DiscoveryV1) DeleteAllTrainingData(deleteAllTrainingDataOptions
 	}
 
 	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2481,6 +2526,7 @@ func (discovery *DiscoveryV1) GetTrainingData(getTrainingDataOptions *GetTrainin
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -2530,6 +2576,7 @@ func (discovery *DiscoveryV1) DeleteTrainingData(deleteTrainingDataOptions *Dele
 	}
 
 	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2573,6 +2620,7 @@ func (discovery *DiscoveryV1) ListTrainingExamples(listTrainingExamplesOptions *
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingExampleList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingExampleList)
@@ -2639,6 +2687,7 @@ func (discovery *DiscoveryV1) CreateTrainingExample(createTrainingExampleOptions
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingExample))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingExample)
@@ -2688,6 +2737,7 @@ func (discovery *DiscoveryV1) DeleteTrainingExample(deleteTrainingExampleOptions
 	}
 
 	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2744,6 +2794,7 @@ func (discovery *DiscoveryV1) UpdateTrainingExample(updateTrainingExampleOptions
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingExample))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingExample)
@@ -2794,6 +2845,7 @@ func (discovery *DiscoveryV1) GetTrainingExample(getTrainingExampleOptions *GetT
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingExample))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingExample)
@@ -2849,6 +2901,7 @@ func (discovery *DiscoveryV1) DeleteUserData(deleteUserDataOptions *DeleteUserDa
 	}
 
 	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2906,6 +2959,7 @@ func (discovery *DiscoveryV1) CreateEvent(createEventOptions *CreateEventOptions
 	}
 
 	response, err = discovery.Service.Request(request, new(CreateEventResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CreateEventResponse)
@@ -2969,6 +3023,7 @@ func (discovery *DiscoveryV1) QueryLog(queryLogOptions *QueryLogOptions) (result
 	}
 
 	response, err = discovery.Service.Request(request, new(LogQueryResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LogQueryResponse)
@@ -3025,6 +3080,7 @@ func (discovery *DiscoveryV1) GetMetricsQuery(getMetricsQueryOptions *GetMetrics
 	}
 
 	response, err = discovery.Service.Request(request, new(MetricResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricResponse)
@@ -3083,6 +3139,7 @@ func (discovery *DiscoveryV1) GetMetricsQueryEvent(getMetricsQueryEventOptions *
 	}
 
 	response, err = discovery.Service.Request(request, new(MetricResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricResponse)
@@ -3140,6 +3197,7 @@ func (discovery *DiscoveryV1) GetMetricsQueryNoResults(getMetricsQueryNoResultsO
 	}
 
 	response, err = discovery.Service.Request(request, new(MetricResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricResponse)
@@ -3198,6 +3256,7 @@ func (discovery *DiscoveryV1) GetMetricsEventRate(getMetricsEventRateOptions *Ge
 	}
 
 	response, err = discovery.Service.Request(request, new(MetricResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricResponse)
@@ -3250,6 +3309,7 @@ func (discovery *DiscoveryV1) GetMetricsQueryTokenEvent(getMetricsQueryTokenEven
 	}
 
 	response, err = discovery.Service.Request(request, new(MetricTokenResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricTokenResponse)
@@ -3302,6 +3362,7 @@ func (discovery *DiscoveryV1) ListCredentials(listCredentialsOptions *ListCreden
 	}
 
 	response, err = discovery.Service.Request(request, new(CredentialsList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CredentialsList)
@@ -3371,6 +3432,7 @@ func (discovery *DiscoveryV1) CreateCredentials(createCredentialsOptions *Create
 	}
 
 	response, err = discovery.Service.Request(request, new(Credentials))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Credentials)
@@ -3424,6 +3486,7 @@ func (discovery *DiscoveryV1) GetCredentials(getCredentialsOptions *GetCredentia
 	}
 
 	response, err = discovery.Service.Request(request, new(Credentials))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Credentials)
@@ -3492,6 +3555,7 @@ func (discovery *DiscoveryV1) UpdateCredentials(updateCredentialsOptions *Update
 	}
 
 	response, err = discovery.Service.Request(request, new(Credentials))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Credentials)
@@ -3542,6 +3606,7 @@ func (discovery *DiscoveryV1) DeleteCredentials(deleteCredentialsOptions *Delete
 	}
 
 	response, err = discovery.Service.Request(request, new(DeleteCredentials))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteCredentials)
@@ -3592,6 +3657,7 @@ func (discovery *DiscoveryV1) ListGateways(listGatewaysOptions *ListGatewaysOpti
 	}
 
 	response, err = discovery.Service.Request(request, new(GatewayList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*GatewayList)
@@ -3652,6 +3718,7 @@ func (discovery *DiscoveryV1) CreateGateway(createGatewayOptions *CreateGatewayO
 	}
 
 	response, err = discovery.Service.Request(request, new(Gateway))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Gateway)
@@ -3702,6 +3769,7 @@ func (discovery *DiscoveryV1) GetGateway(getGatewayOptions *GetGatewayOptions) (
 	}
 
 	response, err = discovery.Service.Request(request, new(Gateway))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Gateway)
@@ -3752,6 +3820,7 @@ func (discovery *DiscoveryV1) DeleteGateway(deleteGatewayOptions *DeleteGatewayO
 	}
 
 	response, err = discovery.Service.Request(request, new(GatewayDelete))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*GatewayDelete)
@@ -7996,6 +8065,9 @@ type QueryAggregation struct {
 
 	// Aggregations returned by Discovery.
 	Aggregations []QueryAggregation `json:"aggregations,omitempty"`
+
+	// The JSON of the aggregation, from which the typed aggregations are parsed.
+	raw json.RawMessage
 }
 
 // QueryLogOptions : The QueryLog options.
@@ -0,0 +1,283 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+const (
+	DEFAULT_INGESTION_CONCURRENCY = 4
+	DEFAULT_INGESTION_MAX_RETRIES = 2
+	DEFAULT_INGESTION_RETRY_DELAY = time.Second
+)
+
+// IngestionDocument : A document to be added to a collection by IngestDocuments
+type IngestionDocument struct {
+
+	// The name of the document, which is sent as its filename and identifies it in the results.
+	Name string
+
+	// Opens the content of the document. It is called again for each retry.
+	Open func() (io.ReadCloser, error)
+
+	// The content type of the document. If not set, the service detects it.
+	ContentType *string
+
+	// The metadata of the document, as a JSON object string.
+	Metadata *string
+
+	// The ID of an existing document to replace. If not set, a new document is added.
+	DocumentID *string
+}
+
+// NewFileIngestionDocument : Instantiate an IngestionDocument for a file, which is named by its base name
+func NewFileIngestionDocument(fileName string) IngestionDocument {
+	return IngestionDocument{
+		Name: filepath.Base(fileName),
+		Open: func() (io.ReadCloser, error) {
+			return os.Open(fileName)
+		},
+	}
+}
+
+// IngestionOptions : Options that control how documents are ingested
+type IngestionOptions struct {
+
+	// The number of documents that are uploaded at the same time. Defaults to 4.
+	Concurrency int
+
+	// The number of times a failed upload is retried. Only transport failures and responses with status code 429 or
+	// 5xx are retried. Defaults to 2. Set it to common.NO_RETRIES to disable retries.
+	MaxRetries int
+
+	// The delay before the first retry of a document. The delay doubles for each further retry. A response with status
+	// code 429 and a Retry-After header is retried after the delay that the service asks for. Defaults to one second.
+	RetryDelay time.Duration
+
+	// The minimum interval between two upload requests across all workers. Zero means no rate limit.
+	MinRequestInterval time.Duration
+
+	// Whether to wait until each document has been processed before reporting it.
+	WaitForProcessing bool
+
+	// How the processing of each document is awaited, if WaitForProcessing is set. Can be nil.
+	WaitForDocumentOptions *WaitForDocumentOptions
+}
+
+// NewIngestionOptions : Instantiate IngestionOptions with the default values
+func (discovery *DiscoveryV1) NewIngestionOptions() *IngestionOptions {
+	return &IngestionOptions{
+		Concurrency: DEFAULT_INGESTION_CONCURRENCY,
+		MaxRetries:  DEFAULT_INGESTION_MAX_RETRIES,
+		RetryDelay:  DEFAULT_INGESTION_RETRY_DELAY,
+	}
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (options *IngestionOptions) SetConcurrency(concurrency int) *IngestionOptions {
+	options.Concurrency = concurrency
+	return options
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (options *IngestionOptions) SetMaxRetries(maxRetries int) *IngestionOptions {
+	options.MaxRetries = maxRetries
+	return options
+}
+
+// SetRetryDelay : Allow user to set RetryDelay
+func (options *IngestionOptions) SetRetryDelay(retryDelay time.Duration) *IngestionOptions {
+	options.RetryDelay = retryDelay
+	return options
+}
+
+// SetMinRequestInterval : Allow user to set MinRequestInterval
+func (options *IngestionOptions) SetMinRequestInterval(minRequestInterval time.Duration) *IngestionOptions {
+	options.MinRequestInterval = minRequestInterval
+	return options
+}
+
+// SetWaitForProcessing : Allow user to set WaitForProcessing
+func (options *IngestionOptions) SetWaitForProcessing(waitForProcessing bool) *IngestionOptions {
+	options.WaitForProcessing = waitForProcessing
+	return options
+}
+
+// SetWaitForDocumentOptions : Allow user to set WaitForDocumentOptions
+func (options *IngestionOptions) SetWaitForDocumentOptions(waitForDocumentOptions *WaitForDocumentOptions) *IngestionOptions {
+	options.WaitForDocumentOptions = waitForDocumentOptions
+	return options
+}
+
+// IngestionResult : The outcome of the ingestion of one document
+type IngestionResult struct {
+
+	// The name of the document.
+	Name string
+
+	// The ID of the document, if it was accepted.
+	DocumentID *string
+
+	// The status of the document after it was processed, if WaitForProcessing is set and the upload succeeded.
+	Status *DocumentStatus
+
+	// The number of upload requests that were sent for the document.
+	Attempts int
+
+	// The error of the last attempt, or of the processing of the document, if the ingestion failed.
+	Err error
+}
+
+// IngestionReport : A summary of the ingestion of documents
+type IngestionReport struct {
+
+	// The documents that were ingested, in the order of their names.
+	Succeeded []IngestionResult
+
+	// The documents that could not be ingested, in the order of their names.
+	Failed []IngestionResult
+}
+
+// IngestDocuments : Add documents to a collection concurrently
+// Sends one result for each document on the returned channel as soon as the document has been ingested or has failed,
+// and closes the channel when every document has been processed. Cancelling the context stops the remaining
+// documents, which are reported with the error of the context. Pass the channel to CollectIngestionReport for a
+// summary.
+func (discovery *DiscoveryV1) IngestDocuments(ctx context.Context, environmentID string, collectionID string, documents []IngestionDocument, options *IngestionOptions) <-chan IngestionResult {
+	options = ingestionOptionsWithDefaults(options)
+
+	results := make(chan IngestionResult)
+	retryOptions := common.RetryOptions{
+		MaxRetries: options.MaxRetries,
+		RetryDelay: options.RetryDelay,
+		Limiter:    common.NewIntervalRateLimiter(options.MinRequestInterval),
+	}
+	common.RunConcurrently(len(documents), options.Concurrency, func(i int) {
+		results <- discovery.ingestDocument(ctx, environmentID, collectionID, documents[i], options, retryOptions)
+	}, func() {
+		close(results)
+	})
+
+	return results
+}
+
+// IngestFiles : Add files to a collection concurrently. See IngestDocuments for how results are reported.
+func (discovery *DiscoveryV1) IngestFiles(ctx context.Context, environmentID string, collectionID string, fileNames []string, options *IngestionOptions) <-chan IngestionResult {
+	documents := make([]IngestionDocument, len(fileNames))
+	for i, fileName := range fileNames {
+		documents[i] = NewFileIngestionDocument(fileName)
+	}
+	return discovery.IngestDocuments(ctx, environmentID, collectionID, documents, options)
+}
+
+// CollectIngestionReport : Waits for every result of an ingestion and summarizes them
+func CollectIngestionReport(results <-chan IngestionResult) *IngestionReport {
+	report := &IngestionReport{}
+	for result := range results {
+		if result.Err != nil {
+			report.Failed = append(report.Failed, result)
+		} else {
+			report.Succeeded = append(report.Succeeded, result)
+		}
+	}
+	sort.Slice(report.Succeeded, func(i, j int) bool { return report.Succeeded[i].Name < report.Succeeded[j].Name })
+	sort.Slice(report.Failed, func(i, j int) bool { return report.Failed[i].Name < report.Failed[j].Name })
+	return report
+}
+
+// ingestDocument : Uploads one document, retrying transient failures, and waits for it to be processed if requested
+func (discovery *DiscoveryV1) ingestDocument(ctx context.Context, environmentID string, collectionID string, document IngestionDocument, options *IngestionOptions, retryOptions common.RetryOptions) IngestionResult {
+	result := IngestionResult{Name: document.Name}
+	result.Attempts, result.Err = common.Retry(ctx, retryOptions, func() (err error) {
+		result.DocumentID, err = discovery.uploadDocument(environmentID, collectionID, document)
+		return
+	})
+	if result.Err != nil {
+		return result
+	}
+
+	if options.WaitForProcessing && result.DocumentID != nil {
+		getDocumentStatusOptions := discovery.NewGetDocumentStatusOptions(environmentID, collectionID, *result.DocumentID)
+		result.Status, result.Err = discovery.WaitForDocumentWithContext(ctx, getDocumentStatusOptions, options.WaitForDocumentOptions)
+	}
+	return result
+}
+
+// uploadDocument : Sends one request that adds or replaces the document, and returns the ID of the document
+func (discovery *DiscoveryV1) uploadDocument(environmentID string, collectionID string, document IngestionDocument) (*string, error) {
+	file, err := document.Open()
+	if err != nil {
+		return nil, &common.NonRetryableError{Err: err}
+	}
+	defer file.Close()
+
+	var accepted *DocumentAccepted
+	if document.DocumentID == nil {
+		addDocumentOptions := discovery.NewAddDocumentOptions(environmentID, collectionID)
+		addDocumentOptions.File = file
+		addDocumentOptions.Filename = core.StringPtr(document.Name)
+		addDocumentOptions.FileContentType = document.ContentType
+		addDocumentOptions.Metadata = document.Metadata
+		accepted, _, err = discovery.AddDocument(addDocumentOptions)
+	} else {
+		updateDocumentOptions := discovery.NewUpdateDocumentOptions(environmentID, collectionID, *document.DocumentID)
+		updateDocumentOptions.File = file
+		updateDocumentOptions.Filename = core.StringPtr(document.Name)
+		updateDocumentOptions.FileContentType = document.ContentType
+		updateDocumentOptions.Metadata = document.Metadata
+		accepted, _, err = discovery.UpdateDocument(updateDocumentOptions)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return accepted.DocumentID, nil
+}
+
+func ingestionOptionsWithDefaults(options *IngestionOptions) *IngestionOptions {
+	withDefaults := &IngestionOptions{
+		Concurrency: DEFAULT_INGESTION_CONCURRENCY,
+		MaxRetries:  DEFAULT_INGESTION_MAX_RETRIES,
+		RetryDelay:  DEFAULT_INGESTION_RETRY_DELAY,
+	}
+	if options == nil {
+		return withDefaults
+	}
+	if options.Concurrency > 0 {
+		withDefaults.Concurrency = options.Concurrency
+	}
+	if options.MaxRetries > 0 {
+		withDefaults.MaxRetries = options.MaxRetries
+	} else if options.MaxRetries < 0 {
+		withDefaults.MaxRetries = 0
+	}
+	if options.RetryDelay > 0 {
+		withDefaults.RetryDelay = options.RetryDelay
+	}
+	withDefaults.MinRequestInterval = options.MinRequestInterval
+	withDefaults.WaitForProcessing = options.WaitForProcessing
+	withDefaults.WaitForDocumentOptions = options.WaitForDocumentOptions
+	return withDefaults
+}
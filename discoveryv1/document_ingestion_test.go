@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/common"
+	"github.com/edwindvinas/go-sdk/discoveryv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DocumentIngestion`, func() {
+	Describe(`IngestDocuments(ctx context.Context, environmentID string, collectionID string, documents []IngestionDocument, options *IngestionOptions)`, func() {
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		environmentID := "exampleString"
+		collectionID := "exampleString"
+		documentsPath := "/v1/environments/" + environmentID + "/collections/" + collectionID + "/documents"
+		newDocument := func(name string, content string) discoveryv1.IngestionDocument {
+			return discoveryv1.IngestionDocument{
+				Name: name,
+				Open: func() (io.ReadCloser, error) {
+					return ioutil.NopCloser(strings.NewReader(content)), nil
+				},
+			}
+		}
+		Context(`Successfully - Ingest documents`, func() {
+			var mutex sync.Mutex
+			uploads := make(map[string]int)
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == "GET" {
+					documentID := strings.TrimPrefix(req.URL.Path, documentsPath+"/")
+					fmt.Fprintf(res, `{"document_id": "%s", "status": "available", "status_description": "", "notices": []}`, documentID)
+					return
+				}
+
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.ParseMultipartForm(1024)).To(Succeed())
+				_, header, err := req.FormFile("file")
+				Expect(err).To(BeNil())
+				mutex.Lock()
+				uploads[header.Filename]++
+				attempt := uploads[header.Filename]
+				mutex.Unlock()
+
+				switch {
+				case header.Filename == "busy.json" && attempt == 1:
+					res.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprint(res, `{"code": 503, "error": "Service unavailable"}`)
+				case header.Filename == "invalid.json":
+					res.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(res, `{"code": 400, "error": "Invalid document"}`)
+				case req.URL.Path == documentsPath:
+					res.WriteHeader(http.StatusAccepted)
+					fmt.Fprintf(res, `{"document_id": "id-%s", "status": "processing"}`, header.Filename)
+				default:
+					Expect(req.URL.Path).To(Equal(documentsPath + "/existing"))
+					res.WriteHeader(http.StatusAccepted)
+					fmt.Fprint(res, `{"document_id": "existing", "status": "processing"}`)
+				}
+			}))
+			It(`Succeed to call IngestDocuments`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv1.NewDiscoveryV1(&discoveryv1.DiscoveryV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				replacement := newDocument("replacement.json", `{"title": "replacement"}`)
+				replacement.DocumentID = core.StringPtr("existing")
+				documents := []discoveryv1.IngestionDocument{
+					newDocument("first.json", `{"title": "first"}`),
+					newDocument("busy.json", `{"title": "busy"}`),
+					newDocument("invalid.json", `{"title": "invalid"}`),
+					replacement,
+				}
+				ingestionOptions := testService.NewIngestionOptions().
+					SetConcurrency(2).
+					SetRetryDelay(time.Millisecond).
+					SetWaitForProcessing(true).
+					SetWaitForDocumentOptions(testService.NewWaitForDocumentOptions().SetPollInterval(time.Millisecond))
+				results := testService.IngestDocuments(context.Background(), environmentID, collectionID, documents, ingestionOptions)
+				report := discoveryv1.CollectIngestionReport(results)
+
+				Expect(report.Succeeded).To(HaveLen(3))
+				Expect(report.Succeeded[0].Name).To(Equal("busy.json"))
+				Expect(report.Succeeded[0].Attempts).To(Equal(2))
+				Expect(*report.Succeeded[1].DocumentID).To(Equal("id-first.json"))
+				Expect(*report.Succeeded[1].Status.Status).To(Equal(discoveryv1.DocumentStatus_Status_Available))
+				Expect(*report.Succeeded[2].DocumentID).To(Equal("existing"))
+
+				Expect(report.Failed).To(HaveLen(1))
+				Expect(report.Failed[0].Name).To(Equal("invalid.json"))
+				Expect(report.Failed[0].Attempts).To(Equal(1))
+			})
+		})
+		Context(`Unsuccessfully - Ingest documents`, func() {
+			It(`Fail to call IngestFiles`, func() {
+				testService, testServiceErr := discoveryv1.NewDiscoveryV1(&discoveryv1.DiscoveryV1Options{
+					URL:     "http://localhost",
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				results := testService.IngestFiles(context.Background(), environmentID, collectionID, []string{"does-not-exist.json"}, nil)
+				report := discoveryv1.CollectIngestionReport(results)
+				Expect(report.Succeeded).To(BeEmpty())
+				Expect(report.Failed).To(HaveLen(1))
+				Expect(report.Failed[0].Attempts).To(Equal(1))
+			})
+			It(`Fail to call IngestDocuments while the service is unavailable`, func() {
+				testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprint(res, `{"code": 503, "error": "Service unavailable"}`)
+				}))
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv1.NewDiscoveryV1(&discoveryv1.DiscoveryV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				documents := []discoveryv1.IngestionDocument{newDocument("first.json", `{"title": "first"}`)}
+
+				// Zero retries means the default number of retries.
+				ingestionOptions := testService.NewIngestionOptions().
+					SetMaxRetries(0).
+					SetRetryDelay(time.Millisecond)
+				report := discoveryv1.CollectIngestionReport(testService.IngestDocuments(context.Background(), environmentID, collectionID, documents, ingestionOptions))
+				Expect(report.Failed).To(HaveLen(1))
+				Expect(report.Failed[0].Attempts).To(Equal(discoveryv1.DEFAULT_INGESTION_MAX_RETRIES + 1))
+
+				ingestionOptions.SetMaxRetries(common.NO_RETRIES)
+				report = discoveryv1.CollectIngestionReport(testService.IngestDocuments(context.Background(), environmentID, collectionID, documents, ingestionOptions))
+				Expect(report.Failed).To(HaveLen(1))
+				Expect(report.Failed[0].Attempts).To(Equal(1))
+			})
+		})
+	})
+})
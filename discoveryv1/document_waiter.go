@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// DEFAULT_DOCUMENT_POLL_INTERVAL is the interval at which WaitForDocument checks the status of a document by default.
+const DEFAULT_DOCUMENT_POLL_INTERVAL = 2 * time.Second
+
+// WaitForDocumentOptions : Options that control how the ingestion of a document is awaited
+type WaitForDocumentOptions struct {
+
+	// The interval at which the status of the document is checked. Defaults to DEFAULT_DOCUMENT_POLL_INTERVAL.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the document to be processed. Zero means no limit.
+	Timeout time.Duration
+}
+
+// NewWaitForDocumentOptions : Instantiate WaitForDocumentOptions
+func (discovery *DiscoveryV1) NewWaitForDocumentOptions() *WaitForDocumentOptions {
+	return &WaitForDocumentOptions{
+		PollInterval: DEFAULT_DOCUMENT_POLL_INTERVAL,
+	}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (options *WaitForDocumentOptions) SetPollInterval(pollInterval time.Duration) *WaitForDocumentOptions {
+	options.PollInterval = pollInterval
+	return options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (options *WaitForDocumentOptions) SetTimeout(timeout time.Duration) *WaitForDocumentOptions {
+	options.Timeout = timeout
+	return options
+}
+
+// WaitForDocument : Waits until a document that was added or updated has been processed, and returns its status.
+// The document is processed when its status is `available` or `available with notices`. An error is returned if its
+// status is `failed` or the wait times out. The waitForDocumentOptions can be nil.
+func (discovery *DiscoveryV1) WaitForDocument(getDocumentStatusOptions *GetDocumentStatusOptions, waitForDocumentOptions *WaitForDocumentOptions) (*DocumentStatus, error) {
+	return discovery.WaitForDocumentWithContext(context.Background(), getDocumentStatusOptions, waitForDocumentOptions)
+}
+
+// WaitForDocumentWithContext is an alternate form of the WaitForDocument method which supports a Context parameter
+func (discovery *DiscoveryV1) WaitForDocumentWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions, waitForDocumentOptions *WaitForDocumentOptions) (*DocumentStatus, error) {
+	if waitForDocumentOptions == nil {
+		waitForDocumentOptions = discovery.NewWaitForDocumentOptions()
+	}
+	pollInterval := waitForDocumentOptions.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DEFAULT_DOCUMENT_POLL_INTERVAL
+	}
+
+	var status *DocumentStatus
+	var state string
+	err := common.Poll(ctx, pollInterval, waitForDocumentOptions.Timeout, func() (bool, error) {
+		var err error
+		status, _, err = discovery.GetDocumentStatus(getDocumentStatusOptions)
+		if err != nil {
+			return false, err
+		}
+
+		state = ""
+		if status.Status != nil {
+			state = *status.Status
+		}
+		switch state {
+		case DocumentStatus_Status_Available, DocumentStatus_Status_AvailableWithNotices:
+			return true, nil
+		case DocumentStatus_Status_Failed:
+			return true, fmt.Errorf("Document %s failed: %s", *getDocumentStatusOptions.DocumentID, documentNotices(status))
+		}
+		return false, nil
+	})
+	if err == common.ErrPollTimeout {
+		err = fmt.Errorf("Timed out waiting for document %s, which is %s", *getDocumentStatusOptions.DocumentID, state)
+	}
+	return status, err
+}
+
+// documentNotices : Returns the description and notices of a document status as one message
+func documentNotices(status *DocumentStatus) string {
+	var messages []string
+	if status.StatusDescription != nil && *status.StatusDescription != "" {
+		messages = append(messages, *status.StatusDescription)
+	}
+	for _, notice := range status.Notices {
+		if notice.Description != nil {
+			messages = append(messages, *notice.Description)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
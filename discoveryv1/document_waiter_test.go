@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/discoveryv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DocumentWaiter`, func() {
+	Describe(`WaitForDocument(getDocumentStatusOptions *GetDocumentStatusOptions, waitForDocumentOptions *WaitForDocumentOptions)`, func() {
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		environmentID := "exampleString"
+		collectionID := "exampleString"
+		documentID := "exampleString"
+		newTestService := func(url string) *discoveryv1.DiscoveryV1 {
+			testService, testServiceErr := discoveryv1.NewDiscoveryV1(&discoveryv1.DiscoveryV1Options{
+				URL:     url,
+				Version: version,
+				Authenticator: &core.BearerTokenAuthenticator{
+					BearerToken: bearerToken,
+				},
+			})
+			Expect(testServiceErr).To(BeNil())
+			return testService
+		}
+		Context(`Successfully - Wait for a document`, func() {
+			checks := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal("/v1/environments/" + environmentID + "/collections/" + collectionID + "/documents/" + documentID))
+				Expect(req.Method).To(Equal("GET"))
+				checks++
+				status := discoveryv1.DocumentStatus_Status_Processing
+				if checks == 3 {
+					status = discoveryv1.DocumentStatus_Status_Available
+				}
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"document_id": "%s", "status": "%s", "status_description": "", "notices": []}`, documentID, status)
+			}))
+			It(`Succeed to call WaitForDocument`, func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				getDocumentStatusOptions := testService.NewGetDocumentStatusOptions(environmentID, collectionID, documentID)
+				waitForDocumentOptions := testService.NewWaitForDocumentOptions().SetPollInterval(time.Millisecond)
+				status, err := testService.WaitForDocument(getDocumentStatusOptions, waitForDocumentOptions)
+				Expect(err).To(BeNil())
+				Expect(*status.Status).To(Equal(discoveryv1.DocumentStatus_Status_Available))
+				Expect(checks).To(Equal(3))
+			})
+		})
+		Context(`Unsuccessfully - Wait for a document`, func() {
+			failed := false
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				if failed {
+					fmt.Fprintf(res, `{"document_id": "%s", "status": "failed", "status_description": "Document failed", "notices": [{"description": "Could not convert"}]}`, documentID)
+					return
+				}
+				fmt.Fprintf(res, `{"document_id": "%s", "status": "pending", "status_description": "", "notices": []}`, documentID)
+			}))
+			It(`Fail to call WaitForDocument`, func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				getDocumentStatusOptions := testService.NewGetDocumentStatusOptions(environmentID, collectionID, documentID)
+				waitForDocumentOptions := testService.NewWaitForDocumentOptions().
+					SetPollInterval(time.Millisecond).
+					SetTimeout(20 * time.Millisecond)
+				status, err := testService.WaitForDocument(getDocumentStatusOptions, waitForDocumentOptions)
+				Expect(err).ToNot(BeNil())
+				Expect(*status.Status).To(Equal(discoveryv1.DocumentStatus_Status_Pending))
+
+				failed = true
+				status, err = testService.WaitForDocument(getDocumentStatusOptions, waitForDocumentOptions)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("Could not convert"))
+				Expect(*status.Status).To(Equal(discoveryv1.DocumentStatus_Status_Failed))
+			})
+			It(`Fail to call WaitForDocumentWithContext without a status`, func() {
+				testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					res.Header().Set("Content-type", "application/json")
+					fmt.Fprintf(res, `{"document_id": "%s", "notices": []}`, documentID)
+				}))
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+				defer cancel()
+				getDocumentStatusOptions := testService.NewGetDocumentStatusOptions(environmentID, collectionID, documentID)
+				waitForDocumentOptions := testService.NewWaitForDocumentOptions().SetPollInterval(time.Millisecond)
+				status, err := testService.WaitForDocumentWithContext(ctx, getDocumentStatusOptions, waitForDocumentOptions)
+				Expect(err).To(Equal(context.DeadlineExceeded))
+				Expect(status.Status).To(BeNil())
+			})
+		})
+	})
+})
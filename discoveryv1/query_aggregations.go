@@ -0,0 +1,265 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Constants associated with the QueryAggregation.Type property.
+// The type of aggregation command used.
+const (
+	QueryAggregation_Type_Term        = "term"
+	QueryAggregation_Type_Filter      = "filter"
+	QueryAggregation_Type_Histogram   = "histogram"
+	QueryAggregation_Type_Timeslice   = "timeslice"
+	QueryAggregation_Type_Nested      = "nested"
+	QueryAggregation_Type_TopHits     = "top_hits"
+	QueryAggregation_Type_Max         = "max"
+	QueryAggregation_Type_Min         = "min"
+	QueryAggregation_Type_Average     = "average"
+	QueryAggregation_Type_Sum         = "sum"
+	QueryAggregation_Type_UniqueCount = "unique_count"
+)
+
+// TermAggregation : The results of a `term` aggregation, one for each of the most frequent values of a field.
+type TermAggregation struct {
+	Term
+
+	// Number of matching results.
+	MatchingResults *int64 `json:"matching_results,omitempty"`
+
+	// The values of the field, each with its number of matching results.
+	Results []AggregationResult `json:"results,omitempty"`
+}
+
+// FilterAggregation : The results of a `filter` aggregation, which narrows the results of its aggregations.
+type FilterAggregation struct {
+	Filter
+
+	// Number of matching results.
+	MatchingResults *int64 `json:"matching_results,omitempty"`
+
+	// Aggregations of the filtered results.
+	Aggregations []QueryAggregation `json:"aggregations,omitempty"`
+}
+
+// HistogramAggregation : The results of a `histogram` aggregation, one for each interval of a numeric field.
+type HistogramAggregation struct {
+	Histogram
+
+	// Number of matching results.
+	MatchingResults *int64 `json:"matching_results,omitempty"`
+
+	// The intervals of the field.
+	Results []HistogramResult `json:"results,omitempty"`
+}
+
+// HistogramResult : An interval of a histogram aggregation.
+type HistogramResult struct {
+
+	// The lower bound of the interval.
+	Key *float64 `json:"key,omitempty"`
+
+	// Number of matching results.
+	MatchingResults *int64 `json:"matching_results,omitempty"`
+
+	// Aggregations returned in the case of chained aggregations.
+	Aggregations []QueryAggregation `json:"aggregations,omitempty"`
+}
+
+// TimesliceAggregation : The results of a `timeslice` aggregation, one for each interval of a date field.
+type TimesliceAggregation struct {
+	Timeslice
+
+	// Number of matching results.
+	MatchingResults *int64 `json:"matching_results,omitempty"`
+
+	// The intervals of the field.
+	Results []TimesliceResult `json:"results,omitempty"`
+}
+
+// TimesliceResult : An interval of a timeslice aggregation.
+type TimesliceResult struct {
+
+	// The start of the interval, in milliseconds since the epoch.
+	Key *int64 `json:"key,omitempty"`
+
+	// The start of the interval, as a date and time.
+	KeyAsString *string `json:"key_as_string,omitempty"`
+
+	// Number of matching results.
+	MatchingResults *int64 `json:"matching_results,omitempty"`
+
+	// The rate of events in the interval, for aggregations of query events.
+	EventRate *float64 `json:"event_rate,omitempty"`
+
+	// Aggregations returned in the case of chained aggregations.
+	Aggregations []QueryAggregation `json:"aggregations,omitempty"`
+}
+
+// NestedAggregation : The results of a `nested` aggregation, whose aggregations are restricted to an area of the
+// results.
+type NestedAggregation struct {
+	Nested
+
+	// Number of matching results.
+	MatchingResults *int64 `json:"matching_results,omitempty"`
+
+	// Aggregations of the area of the results.
+	Aggregations []QueryAggregation `json:"aggregations,omitempty"`
+}
+
+// CalculationAggregation : The result of a `max`, `min`, `average`, `sum`, or `unique_count` aggregation.
+type CalculationAggregation struct {
+	Calculation
+
+	// The type of the calculation, such as QueryAggregation_Type_Average.
+	Type *string `json:"type,omitempty"`
+}
+
+// TopHitsAggregation : The results of a `top_hits` aggregation.
+type TopHitsAggregation struct {
+	TopHits
+}
+
+// UnmarshalJSON : Decodes an aggregation and keeps its JSON for the typed aggregations
+func (aggregation *QueryAggregation) UnmarshalJSON(data []byte) error {
+	type queryAggregation QueryAggregation
+	var decoded struct {
+		queryAggregation
+		Results json.RawMessage `json:"results,omitempty"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*aggregation = QueryAggregation(decoded.queryAggregation)
+	aggregation.raw = append(json.RawMessage(nil), data...)
+
+	// The keys of histogram and timeslice results are numbers, which are kept as their JSON text
+	if len(decoded.Results) > 0 {
+		var results []struct {
+			AggregationResult
+			Key json.RawMessage `json:"key,omitempty"`
+		}
+		if err := json.Unmarshal(decoded.Results, &results); err != nil {
+			return err
+		}
+		aggregation.Results = make([]AggregationResult, len(results))
+		for i, result := range results {
+			aggregation.Results[i] = result.AggregationResult
+			if len(result.Key) > 0 && string(result.Key) != "null" {
+				var key string
+				if err := json.Unmarshal(result.Key, &key); err != nil {
+					key = string(result.Key)
+				}
+				aggregation.Results[i].Key = &key
+			}
+		}
+	}
+	return nil
+}
+
+// AsTerm : Returns the results of a `term` aggregation
+func (aggregation *QueryAggregation) AsTerm() (*TermAggregation, error) {
+	term := new(TermAggregation)
+	return term, aggregation.decode(term, QueryAggregation_Type_Term, QueryAggregation_Type_Term)
+}
+
+// AsFilter : Returns the results of a `filter` aggregation
+func (aggregation *QueryAggregation) AsFilter() (*FilterAggregation, error) {
+	filter := new(FilterAggregation)
+	return filter, aggregation.decode(filter, QueryAggregation_Type_Filter, QueryAggregation_Type_Filter)
+}
+
+// AsHistogram : Returns the results of a `histogram` aggregation
+func (aggregation *QueryAggregation) AsHistogram() (*HistogramAggregation, error) {
+	histogram := new(HistogramAggregation)
+	return histogram, aggregation.decode(histogram, QueryAggregation_Type_Histogram, QueryAggregation_Type_Histogram)
+}
+
+// AsTimeslice : Returns the results of a `timeslice` aggregation
+func (aggregation *QueryAggregation) AsTimeslice() (*TimesliceAggregation, error) {
+	timeslice := new(TimesliceAggregation)
+	return timeslice, aggregation.decode(timeslice, QueryAggregation_Type_Timeslice, QueryAggregation_Type_Timeslice)
+}
+
+// AsNested : Returns the results of a `nested` aggregation
+func (aggregation *QueryAggregation) AsNested() (*NestedAggregation, error) {
+	nested := new(NestedAggregation)
+	return nested, aggregation.decode(nested, QueryAggregation_Type_Nested, QueryAggregation_Type_Nested)
+}
+
+// AsCalculation : Returns the result of a `max`, `min`, `average`, `sum`, or `unique_count` aggregation
+func (aggregation *QueryAggregation) AsCalculation() (*CalculationAggregation, error) {
+	calculation := new(CalculationAggregation)
+	return calculation, aggregation.decode(calculation, "calculation", QueryAggregation_Type_Max, QueryAggregation_Type_Min,
+		QueryAggregation_Type_Average, QueryAggregation_Type_Sum, QueryAggregation_Type_UniqueCount)
+}
+
+// AsTopHits : Returns the results of a `top_hits` aggregation
+func (aggregation *QueryAggregation) AsTopHits() (*TopHitsAggregation, error) {
+	topHits := new(TopHitsAggregation)
+	return topHits, aggregation.decode(topHits, QueryAggregation_Type_TopHits, QueryAggregation_Type_TopHits)
+}
+
+// Typed : Returns the typed aggregation for the type of the aggregation, such as *TermAggregation for a `term`
+// aggregation, to be used in a type switch
+func (aggregation *QueryAggregation) Typed() (interface{}, error) {
+	aggregationType := ""
+	if aggregation.Type != nil {
+		aggregationType = *aggregation.Type
+	}
+	switch aggregationType {
+	case QueryAggregation_Type_Term:
+		return aggregation.AsTerm()
+	case QueryAggregation_Type_Filter:
+		return aggregation.AsFilter()
+	case QueryAggregation_Type_Histogram:
+		return aggregation.AsHistogram()
+	case QueryAggregation_Type_Timeslice:
+		return aggregation.AsTimeslice()
+	case QueryAggregation_Type_Nested:
+		return aggregation.AsNested()
+	case QueryAggregation_Type_TopHits:
+		return aggregation.AsTopHits()
+	case QueryAggregation_Type_Max, QueryAggregation_Type_Min, QueryAggregation_Type_Average, QueryAggregation_Type_Sum, QueryAggregation_Type_UniqueCount:
+		return aggregation.AsCalculation()
+	}
+	return nil, fmt.Errorf("aggregation type '%s' is not supported", aggregationType)
+}
+
+// decode : Decodes the JSON of the aggregation into a typed aggregation, if it has one of the types of the typed
+// aggregation
+func (aggregation *QueryAggregation) decode(typed interface{}, name string, types ...string) error {
+	aggregationType := ""
+	if aggregation.Type != nil {
+		aggregationType = *aggregation.Type
+	}
+	matched := false
+	for _, expected := range types {
+		matched = matched || aggregationType == expected
+	}
+	if !matched {
+		return fmt.Errorf("aggregation of type '%s' is not a %s aggregation", aggregationType, name)
+	}
+	if aggregation.raw == nil {
+		return fmt.Errorf("aggregation of type '%s' was not decoded from JSON", aggregationType)
+	}
+	return json.Unmarshal(aggregation.raw, typed)
+}
@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/discoveryv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`QueryAggregations`, func() {
+	Describe(`Query(queryOptions *QueryOptions) with aggregations`, func() {
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		environmentID := "exampleString"
+		collectionID := "exampleString"
+		Context(`Successfully - Parse typed aggregations`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"matching_results": 10, "aggregations": [
+					{"type": "term", "field": "enriched_text.sentiment.document.label", "count": 2, "results": [
+						{"key": "positive", "matching_results": 6, "aggregations": [{"type": "average", "field": "price", "value": 12.5}]}]},
+					{"type": "histogram", "field": "price", "interval": 10, "results": [{"key": 0, "matching_results": 3}, {"key": 10, "matching_results": 1}]},
+					{"type": "timeslice", "field": "publication_date", "interval": "1d", "results": [
+						{"key": 1546300800000, "key_as_string": "2019-01-01T00:00:00.000Z", "matching_results": 4}]},
+					{"type": "nested", "path": "enriched_text.entities", "matching_results": 9, "aggregations": [
+						{"type": "filter", "match": "enriched_text.entities.type::Person", "matching_results": 2}]},
+					{"type": "top_hits", "size": 1, "hits": {"matching_results": 10, "hits": [{"id": "doc1"}]}}
+				]}`)
+			}))
+			It(`Succeed to parse typed aggregations`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv1.NewDiscoveryV1(&discoveryv1.DiscoveryV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				queryOptions := testService.NewQueryOptions(environmentID, collectionID).
+					SetAggregation("term(enriched_text.sentiment.document.label).average(price)")
+				result, _, operationErr := testService.Query(queryOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(result.Aggregations).To(HaveLen(5))
+
+				term, err := result.Aggregations[0].AsTerm()
+				Expect(err).To(BeNil())
+				Expect(*term.Field).To(Equal("enriched_text.sentiment.document.label"))
+				Expect(*term.Results[0].Key).To(Equal("positive"))
+				average, err := term.Results[0].Aggregations[0].AsCalculation()
+				Expect(err).To(BeNil())
+				Expect(*average.Value).To(Equal(12.5))
+
+				histogram, err := result.Aggregations[1].AsHistogram()
+				Expect(err).To(BeNil())
+				Expect(*histogram.Interval).To(Equal(int64(10)))
+				Expect(*histogram.Results[1].Key).To(Equal(10.0))
+				Expect(*result.Aggregations[1].Results[1].Key).To(Equal("10"))
+
+				timeslice, err := result.Aggregations[2].AsTimeslice()
+				Expect(err).To(BeNil())
+				Expect(*timeslice.Interval).To(Equal("1d"))
+				Expect(*timeslice.Results[0].Key).To(Equal(int64(1546300800000)))
+
+				typed, err := result.Aggregations[3].Typed()
+				Expect(err).To(BeNil())
+				nested, ok := typed.(*discoveryv1.NestedAggregation)
+				Expect(ok).To(BeTrue())
+				filter, err := nested.Aggregations[0].AsFilter()
+				Expect(err).To(BeNil())
+				Expect(*filter.Match).To(Equal("enriched_text.entities.type::Person"))
+
+				topHits, err := result.Aggregations[4].AsTopHits()
+				Expect(err).To(BeNil())
+				Expect(topHits.Hits.Hits).To(HaveLen(1))
+
+				_, err = result.Aggregations[0].AsHistogram()
+				Expect(err).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,402 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// queryFieldPattern matches the names of fields, whose levels are separated by dots.
+var queryFieldPattern = regexp.MustCompile(`^[\p{L}\p{N}_\-@$]+(\.[\p{L}\p{N}_\-@$]+)*$`)
+
+// queryValueSpecialCharacters are the characters of the Discovery Query Language that are quoted in values.
+const queryValueSpecialCharacters = " \t\r\n,|:!()[]\"~*^<>\\"
+
+// QueryExpression : A query or filter in the Discovery Query Language
+// Expressions are combined with And and Or, which add parentheses where the operators would otherwise mix. Values are
+// quoted where they contain operators of the language. The first invalid field name is returned by Build.
+type QueryExpression struct {
+	text     string
+	operator string
+	err      error
+}
+
+// Contains : Returns an expression that matches documents whose field contains the value, `field:value`
+func Contains(field string, value string) QueryExpression {
+	return fieldExpression(field, ":", quoteQueryValue(value))
+}
+
+// NotContains : Returns an expression that matches documents whose field does not contain the value, `field:!value`
+func NotContains(field string, value string) QueryExpression {
+	return fieldExpression(field, ":!", quoteQueryValue(value))
+}
+
+// Matches : Returns an expression that matches documents whose field is exactly the value, `field::value`
+func Matches(field string, value string) QueryExpression {
+	return fieldExpression(field, "::", quoteQueryValue(value))
+}
+
+// NotMatches : Returns an expression that matches documents whose field is not exactly the value, `field::!value`
+func NotMatches(field string, value string) QueryExpression {
+	return fieldExpression(field, "::!", quoteQueryValue(value))
+}
+
+// Exists : Returns an expression that matches documents that have the field, `field:*`
+func Exists(field string) QueryExpression {
+	return fieldExpression(field, ":", "*")
+}
+
+// GreaterThan : Returns an expression that matches documents whose field is greater than the value, `field>value`
+// The value is a number or a string, such as a date.
+func GreaterThan(field string, value interface{}) QueryExpression {
+	return fieldExpression(field, ">", formatQueryValue(value))
+}
+
+// GreaterThanOrEqual : Returns an expression that matches documents whose field is greater than or equal to the
+// value, `field>=value`
+func GreaterThanOrEqual(field string, value interface{}) QueryExpression {
+	return fieldExpression(field, ">=", formatQueryValue(value))
+}
+
+// LessThan : Returns an expression that matches documents whose field is less than the value, `field<value`
+func LessThan(field string, value interface{}) QueryExpression {
+	return fieldExpression(field, "<", formatQueryValue(value))
+}
+
+// LessThanOrEqual : Returns an expression that matches documents whose field is less than or equal to the value,
+// `field<=value`
+func LessThanOrEqual(field string, value interface{}) QueryExpression {
+	return fieldExpression(field, "<=", formatQueryValue(value))
+}
+
+// NestedQuery : Returns an expression that matches documents with an element of an array field that matches the
+// expression, `field:(expression)`. The fields of the expression are relative to the array field.
+func NestedQuery(field string, expression QueryExpression) QueryExpression {
+	nested := fieldExpression(field, ":", "("+expression.text+")")
+	if nested.err == nil {
+		nested.err = expression.err
+	}
+	return nested
+}
+
+// QueryText : Returns an expression of Discovery Query Language text, which is used as it is
+func QueryText(text string) QueryExpression {
+	return QueryExpression{text: text}
+}
+
+// And : Returns an expression that matches documents that match all of the expressions, `a,b`
+func And(expressions ...QueryExpression) QueryExpression {
+	return combineExpressions(",", expressions)
+}
+
+// Or : Returns an expression that matches documents that match any of the expressions, `a|b`
+func Or(expressions ...QueryExpression) QueryExpression {
+	return combineExpressions("|", expressions)
+}
+
+// Boost : Returns the expression with its relevance multiplied by the factor, `expression^factor`. Boosts only apply
+// to queries, not to filters.
+func (expression QueryExpression) Boost(factor float64) QueryExpression {
+	boosted := QueryExpression{err: expression.err}
+	if expression.operator != "" {
+		boosted.text = "(" + expression.text + ")"
+	} else {
+		boosted.text = expression.text
+	}
+	boosted.text += "^" + strconv.FormatFloat(factor, 'f', -1, 64)
+	return boosted
+}
+
+// String : Returns the text of the expression
+func (expression QueryExpression) String() string {
+	return expression.text
+}
+
+// Build : Returns the text of the expression, or the first error of its field names
+func (expression QueryExpression) Build() (string, error) {
+	if expression.err != nil {
+		return "", expression.err
+	}
+	return expression.text, nil
+}
+
+func fieldExpression(field string, operator string, value string) QueryExpression {
+	expression := QueryExpression{text: field + operator + value}
+	if !queryFieldPattern.MatchString(field) {
+		expression.err = fmt.Errorf("field name '%s' is not valid in the Discovery Query Language", field)
+	}
+	return expression
+}
+
+func combineExpressions(operator string, expressions []QueryExpression) QueryExpression {
+	var combined QueryExpression
+	operands := make([]QueryExpression, 0, len(expressions))
+	for _, expression := range expressions {
+		if combined.err == nil {
+			combined.err = expression.err
+		}
+		if expression.text != "" {
+			operands = append(operands, expression)
+		}
+	}
+
+	// A single operand is kept as it is, so that it is only parenthesized where it is combined
+	if len(operands) == 1 {
+		operands[0].err = combined.err
+		return operands[0]
+	}
+
+	parts := make([]string, len(operands))
+	for i, operand := range operands {
+		if operand.operator != "" && operand.operator != operator {
+			parts[i] = "(" + operand.text + ")"
+		} else {
+			parts[i] = operand.text
+		}
+	}
+	combined.text = strings.Join(parts, operator)
+	if len(operands) > 1 {
+		combined.operator = operator
+	}
+	return combined
+}
+
+// quoteQueryValue : Returns a value as a phrase if it contains operators of the Discovery Query Language
+func quoteQueryValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, queryValueSpecialCharacters) {
+		return value
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func formatQueryValue(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return quoteQueryValue(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(value), 'f', -1, 32)
+	}
+	return quoteQueryValue(fmt.Sprint(value))
+}
+
+// AggregationExpression : An aggregation in the Discovery Query Language
+type AggregationExpression struct {
+	text string
+	err  error
+}
+
+// AggregateTerm : Returns a `term` aggregation of the most frequent values of a field. A count of 0 uses the default
+// number of values.
+func AggregateTerm(field string, count int64) AggregationExpression {
+	if count > 0 {
+		return fieldAggregation("term", field, "count:"+strconv.FormatInt(count, 10))
+	}
+	return fieldAggregation("term", field)
+}
+
+// AggregateFilter : Returns a `filter` aggregation, whose aggregations only include the results that match the
+// expression
+func AggregateFilter(expression QueryExpression) AggregationExpression {
+	return AggregationExpression{text: "filter(" + expression.text + ")", err: expression.err}
+}
+
+// AggregateNested : Returns a `nested` aggregation, whose aggregations are restricted to an array field
+func AggregateNested(path string) AggregationExpression {
+	return fieldAggregation("nested", path)
+}
+
+// AggregateHistogram : Returns a `histogram` aggregation of a numeric field in intervals of a size
+func AggregateHistogram(field string, interval int64) AggregationExpression {
+	return fieldAggregation("histogram", field, "interval:"+strconv.FormatInt(interval, 10))
+}
+
+// AggregateTimeslice : Returns a `timeslice` aggregation of a date field in intervals, such as `1day` or `2weeks`
+func AggregateTimeslice(field string, interval string, anomaly bool) AggregationExpression {
+	if anomaly {
+		return fieldAggregation("timeslice", field, interval, "anomaly:true")
+	}
+	return fieldAggregation("timeslice", field, interval)
+}
+
+// AggregateTopHits : Returns a `top_hits` aggregation of the most relevant results
+func AggregateTopHits(size int64) AggregationExpression {
+	return AggregationExpression{text: "top_hits(" + strconv.FormatInt(size, 10) + ")"}
+}
+
+// AggregateUniqueCount : Returns a `unique_count` aggregation of the number of values of a field
+func AggregateUniqueCount(field string) AggregationExpression {
+	return fieldAggregation("unique_count", field)
+}
+
+// AggregateMax : Returns a `max` aggregation of a numeric field
+func AggregateMax(field string) AggregationExpression {
+	return fieldAggregation("max", field)
+}
+
+// AggregateMin : Returns a `min` aggregation of a numeric field
+func AggregateMin(field string) AggregationExpression {
+	return fieldAggregation("min", field)
+}
+
+// AggregateAverage : Returns an `average` aggregation of a numeric field
+func AggregateAverage(field string) AggregationExpression {
+	return fieldAggregation("average", field)
+}
+
+// AggregateSum : Returns a `sum` aggregation of a numeric field
+func AggregateSum(field string) AggregationExpression {
+	return fieldAggregation("sum", field)
+}
+
+// Then : Returns the aggregation with the aggregations applied to each of its results, `a.b` or `a.[b,c]`
+func (aggregation AggregationExpression) Then(aggregations ...AggregationExpression) AggregationExpression {
+	chained := aggregation
+	switch len(aggregations) {
+	case 0:
+		return chained
+	case 1:
+		chained.text += "." + aggregations[0].text
+	default:
+		chained.text += ".[" + joinAggregations(aggregations) + "]"
+	}
+	for _, next := range aggregations {
+		if chained.err == nil {
+			chained.err = next.err
+		}
+	}
+	return chained
+}
+
+// String : Returns the text of the aggregation
+func (aggregation AggregationExpression) String() string {
+	return aggregation.text
+}
+
+// Build : Returns the text of the aggregation, or the first error of its field names
+func (aggregation AggregationExpression) Build() (string, error) {
+	if aggregation.err != nil {
+		return "", aggregation.err
+	}
+	return aggregation.text, nil
+}
+
+func fieldAggregation(name string, field string, parameters ...string) AggregationExpression {
+	aggregation := AggregationExpression{text: name + "(" + strings.Join(append([]string{field}, parameters...), ",") + ")"}
+	if !queryFieldPattern.MatchString(field) {
+		aggregation.err = fmt.Errorf("field name '%s' is not valid in the Discovery Query Language", field)
+	}
+	return aggregation
+}
+
+func joinAggregations(aggregations []AggregationExpression) string {
+	texts := make([]string, len(aggregations))
+	for i, aggregation := range aggregations {
+		texts[i] = aggregation.text
+	}
+	return strings.Join(texts, ",")
+}
+
+// QueryBuilder : Composes the filter, query, and aggregations of a query
+type QueryBuilder struct {
+	filter       *QueryExpression
+	query        *QueryExpression
+	aggregations []AggregationExpression
+}
+
+// NewQueryBuilder : Instantiate QueryBuilder
+func NewQueryBuilder() *QueryBuilder {
+	return &QueryBuilder{}
+}
+
+// Filter : Sets the filter, which excludes the documents that do not match it without affecting relevance
+func (builder *QueryBuilder) Filter(expression QueryExpression) *QueryBuilder {
+	builder.filter = &expression
+	return builder
+}
+
+// Query : Sets the query, which ranks the documents by relevance
+func (builder *QueryBuilder) Query(expression QueryExpression) *QueryBuilder {
+	builder.query = &expression
+	return builder
+}
+
+// Aggregate : Adds aggregations of the results
+func (builder *QueryBuilder) Aggregate(aggregations ...AggregationExpression) *QueryBuilder {
+	builder.aggregations = append(builder.aggregations, aggregations...)
+	return builder
+}
+
+// Build : Returns the filter, query, and aggregation parameters, which are empty if they were not set, or the first
+// error of their field names
+func (builder *QueryBuilder) Build() (filter string, query string, aggregation string, err error) {
+	if builder.filter != nil {
+		if filter, err = builder.filter.Build(); err != nil {
+			return
+		}
+	}
+	if builder.query != nil {
+		if query, err = builder.query.Build(); err != nil {
+			return
+		}
+	}
+	for _, next := range builder.aggregations {
+		if next.err != nil {
+			err = next.err
+			return
+		}
+	}
+	aggregation = joinAggregations(builder.aggregations)
+	return
+}
+
+// Apply : Sets the Filter, Query, and Aggregation of query options to the parameters that were set
+func (builder *QueryBuilder) Apply(queryOptions *QueryOptions) (*QueryOptions, error) {
+	filter, query, aggregation, err := builder.Build()
+	if err != nil {
+		return queryOptions, err
+	}
+	queryOptions.Filter = optionalQueryParameter(filter, queryOptions.Filter)
+	queryOptions.Query = optionalQueryParameter(query, queryOptions.Query)
+	queryOptions.Aggregation = optionalQueryParameter(aggregation, queryOptions.Aggregation)
+	return queryOptions, nil
+}
+
+// ApplyFederated : Sets the Filter, Query, and Aggregation of federated query options to the parameters that were set
+func (builder *QueryBuilder) ApplyFederated(federatedQueryOptions *FederatedQueryOptions) (*FederatedQueryOptions, error) {
+	filter, query, aggregation, err := builder.Build()
+	if err != nil {
+		return federatedQueryOptions, err
+	}
+	federatedQueryOptions.Filter = optionalQueryParameter(filter, federatedQueryOptions.Filter)
+	federatedQueryOptions.Query = optionalQueryParameter(query, federatedQueryOptions.Query)
+	federatedQueryOptions.Aggregation = optionalQueryParameter(aggregation, federatedQueryOptions.Aggregation)
+	return federatedQueryOptions, nil
+}
+
+func optionalQueryParameter(value string, current *string) *string {
+	if value == "" {
+		return current
+	}
+	return core.StringPtr(value)
+}
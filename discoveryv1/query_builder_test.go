@@ -0,0 +1,112 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/discoveryv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`QueryBuilder`, func() {
+	Describe(`Render expressions`, func() {
+		It(`Succeed to render query expressions`, func() {
+			expression := discoveryv1.And(
+				discoveryv1.Contains("enriched_text.entities.text", "IBM Watson"),
+				discoveryv1.Or(discoveryv1.Matches("type", "Person"), discoveryv1.GreaterThan("price", 10.5)),
+				discoveryv1.Exists("title"),
+			)
+			Expect(expression.Build()).To(Equal(`enriched_text.entities.text:"IBM Watson",(type::Person|price>10.5),title:*`))
+
+			expression = discoveryv1.Or(
+				discoveryv1.And(discoveryv1.LessThanOrEqual("rating", 3), discoveryv1.NotContains("text", "a:b")),
+				discoveryv1.QueryText("title:news"),
+			)
+			Expect(expression.String()).To(Equal(`(rating<=3,text:!"a:b")|title:news`))
+
+			expression = discoveryv1.NestedQuery("enriched_text.entities",
+				discoveryv1.And(discoveryv1.Matches("type", "Person"), discoveryv1.Contains("text", `say "hi"`))).Boost(2)
+			Expect(expression.String()).To(Equal(`enriched_text.entities:(type::Person,text:"say \"hi\"")^2`))
+		})
+		It(`Succeed to render aggregations`, func() {
+			aggregation := discoveryv1.AggregateNested("enriched_text.entities").Then(
+				discoveryv1.AggregateFilter(discoveryv1.Matches("enriched_text.entities.type", "Company")).Then(
+					discoveryv1.AggregateTerm("enriched_text.entities.text", 10),
+					discoveryv1.AggregateTopHits(1),
+				),
+			)
+			Expect(aggregation.Build()).To(Equal(`nested(enriched_text.entities).filter(enriched_text.entities.type::Company).[term(enriched_text.entities.text,count:10),top_hits(1)]`))
+			Expect(discoveryv1.AggregateTimeslice("publication_date", "1day", true).String()).To(Equal(`timeslice(publication_date,1day,anomaly:true)`))
+		})
+		It(`Fail to render an invalid field name`, func() {
+			_, err := discoveryv1.And(discoveryv1.Exists("title"), discoveryv1.Contains("bad field", "x")).Build()
+			Expect(err).ToNot(BeNil())
+
+			_, _, _, err = discoveryv1.NewQueryBuilder().Aggregate(discoveryv1.AggregateMax("")).Build()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`Apply(queryOptions *QueryOptions)`, func() {
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		environmentID := "exampleString"
+		collectionID := "exampleString"
+		Context(`Successfully - Query with a built query`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["filter"]).To(Equal(`type::Person`))
+				Expect(body["query"]).To(Equal(`text:"IBM Watson"`))
+				Expect(body["aggregation"]).To(Equal(`term(type),max(price)`))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"matching_results": 0}`)
+			}))
+			It(`Succeed to call Query`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv1.NewDiscoveryV1(&discoveryv1.DiscoveryV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				queryOptions, err := discoveryv1.NewQueryBuilder().
+					Filter(discoveryv1.Matches("type", "Person")).
+					Query(discoveryv1.Contains("text", "IBM Watson")).
+					Aggregate(discoveryv1.AggregateTerm("type", 0), discoveryv1.AggregateMax("price")).
+					Apply(testService.NewQueryOptions(environmentID, collectionID))
+				Expect(err).To(BeNil())
+
+				result, _, operationErr := testService.Query(queryOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(*result.MatchingResults).To(Equal(int64(0)))
+			})
+		})
+	})
+})
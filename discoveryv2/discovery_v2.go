@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 	"strings"
 )
 
@@ -43,6 +44,7 @@ type DiscoveryV2Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewDiscoveryV2 : Instantiate DiscoveryV2
@@ -53,7 +55,7 @@ func NewDiscoveryV2(options *DiscoveryV2Options) (service *DiscoveryV2, err erro
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("discovery")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("discovery")
 		if err != nil {
 			return
 		}
@@ -64,6 +66,10 @@ func NewDiscoveryV2(options *DiscoveryV2Options) (service *DiscoveryV2, err erro
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &DiscoveryV2{
 		Service: baseService,
 		Version: options.Version,
@@ -121,6 +127,7 @@ func (discovery *DiscoveryV2) ListCollections(listCollectionsOptions *ListCollec
 	}
 
 	response, err = discovery.Service.Request(request, new(ListCollectionsResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListCollectionsResponse)
@@ -132,6 +139,238 @@ func (discovery *DiscoveryV2) ListCollections(listCollectionsOptions *ListCollec
 	return
 }
 
+// CreateCollection : Create a collection
+// Create a new collection in the specified project.
+func (discovery *DiscoveryV2) CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createCollectionOptions, "createCollectionOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createCollectionOptions, "createCollectionOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects", "collections"}
+	pathParameters := []string{*createCollectionOptions.ProjectID}
+
+	builder := core.NewRequestBuilder(core.POST)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range createCollectionOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "CreateCollection")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	body := make(map[string]interface{})
+	if createCollectionOptions.Name != nil {
+		body["name"] = createCollectionOptions.Name
+	}
+	if createCollectionOptions.Description != nil {
+		body["description"] = createCollectionOptions.Description
+	}
+	if createCollectionOptions.Language != nil {
+		body["language"] = createCollectionOptions.Language
+	}
+	if createCollectionOptions.Enrichments != nil {
+		body["enrichments"] = createCollectionOptions.Enrichments
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(CollectionDetails))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*CollectionDetails)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// GetCollection : Get collection
+// Get details about the specified collection.
+func (discovery *DiscoveryV2) GetCollection(getCollectionOptions *GetCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getCollectionOptions, "getCollectionOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(getCollectionOptions, "getCollectionOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects", "collections"}
+	pathParameters := []string{*getCollectionOptions.ProjectID, *getCollectionOptions.CollectionID}
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range getCollectionOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "GetCollection")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(CollectionDetails))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*CollectionDetails)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// UpdateCollection : Update a collection
+// Updates the specified collection's name, description, and enrichments.
+func (discovery *DiscoveryV2) UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateCollectionOptions, "updateCollectionOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(updateCollectionOptions, "updateCollectionOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects", "collections"}
+	pathParameters := []string{*updateCollectionOptions.ProjectID, *updateCollectionOptions.CollectionID}
+
+	builder := core.NewRequestBuilder(core.POST)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range updateCollectionOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "UpdateCollection")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	body := make(map[string]interface{})
+	if updateCollectionOptions.Name != nil {
+		body["name"] = updateCollectionOptions.Name
+	}
+	if updateCollectionOptions.Description != nil {
+		body["description"] = updateCollectionOptions.Description
+	}
+	if updateCollectionOptions.Enrichments != nil {
+		body["enrichments"] = updateCollectionOptions.Enrichments
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(CollectionDetails))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*CollectionDetails)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// DeleteCollection : Delete a collection
+// Deletes the specified collection from the project. All documents stored in the specified collection and not shared
+// is also deleted.
+func (discovery *DiscoveryV2) DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(deleteCollectionOptions, "deleteCollectionOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(deleteCollectionOptions, "deleteCollectionOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects", "collections"}
+	pathParameters := []string{*deleteCollectionOptions.ProjectID, *deleteCollectionOptions.CollectionID}
+
+	builder := core.NewRequestBuilder(core.DELETE)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range deleteCollectionOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "DeleteCollection")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddQuery("version", discovery.Version)
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
+
+	return
+}
+
 // Query : Query a project
 // By using this method, you can construct queries. For details, see the [Discovery
 // documentation](https://cloud.ibm.com/docs/services/discovery-data?topic=discovery-data-query-concepts).
@@ -221,6 +460,7 @@ func (discovery *DiscoveryV2) Query(queryOptions *QueryOptions) (result *QueryRe
 	}
 
 	response, err = discovery.Service.Request(request, new(QueryResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryResponse)
@@ -282,6 +522,7 @@ func (discovery *DiscoveryV2) GetAutocompletion(getAutocompletionOptions *GetAut
 	}
 
 	response, err = discovery.Service.Request(request, new(Completions))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Completions)
@@ -349,6 +590,7 @@ func (discovery *DiscoveryV2) QueryNotices(queryNoticesOptions *QueryNoticesOpti
 	}
 
 	response, err = discovery.Service.Request(request, new(QueryNoticesResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryNoticesResponse)
@@ -403,6 +645,7 @@ func (discovery *DiscoveryV2) ListFields(listFieldsOptions *ListFieldsOptions) (
 	}
 
 	response, err = discovery.Service.Request(request, new(ListFieldsResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListFieldsResponse)
@@ -453,6 +696,7 @@ func (discovery *DiscoveryV2) GetComponentSettings(getComponentSettingsOptions *
 	}
 
 	response, err = discovery.Service.Request(request, new(ComponentSettingsResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ComponentSettingsResponse)
@@ -543,6 +787,7 @@ func (discovery *DiscoveryV2) AddDocument(addDocumentOptions *AddDocumentOptions
 	}
 
 	response, err = discovery.Service.Request(request, new(DocumentAccepted))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentAccepted)
@@ -618,6 +863,7 @@ func (discovery *DiscoveryV2) UpdateDocument(updateDocumentOptions *UpdateDocume
 	}
 
 	response, err = discovery.Service.Request(request, new(DocumentAccepted))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentAccepted)
@@ -675,6 +921,7 @@ func (discovery *DiscoveryV2) DeleteDocument(deleteDocumentOptions *DeleteDocume
 	}
 
 	response, err = discovery.Service.Request(request, new(DeleteDocumentResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteDocumentResponse)
@@ -725,6 +972,7 @@ func (discovery *DiscoveryV2) ListTrainingQueries(listTrainingQueriesOptions *Li
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingQuerySet))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuerySet)
@@ -774,6 +1022,7 @@ func (discovery *DiscoveryV2) DeleteTrainingQueries(deleteTrainingQueriesOptions
 	}
 
 	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -833,6 +1082,7 @@ func (discovery *DiscoveryV2) CreateTrainingQuery(createTrainingQueryOptions *Cr
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -883,6 +1133,7 @@ func (discovery *DiscoveryV2) GetTrainingQuery(getTrainingQueryOptions *GetTrain
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -949,6 +1200,7 @@ func (discovery *DiscoveryV2) UpdateTrainingQuery(updateTrainingQueryOptions *Up
 	}
 
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -960,59 +1212,599 @@ func (discovery *DiscoveryV2) UpdateTrainingQuery(updateTrainingQueryOptions *Up
 	return
 }
 
-// AddDocumentOptions : The AddDocument options.
-type AddDocumentOptions struct {
+// ListEnrichments : List Enrichments
+// List the enrichments available to this project.
+func (discovery *DiscoveryV2) ListEnrichments(listEnrichmentsOptions *ListEnrichmentsOptions) (result *Enrichments, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(listEnrichmentsOptions, "listEnrichmentsOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(listEnrichmentsOptions, "listEnrichmentsOptions")
+	if err != nil {
+		return
+	}
 
-	// The ID of the project. This information can be found from the deploy page of the Discovery administrative tooling.
-	ProjectID *string `json:"project_id" validate:"required"`
+	pathSegments := []string{"v2/projects", "enrichments"}
+	pathParameters := []string{*listEnrichmentsOptions.ProjectID}
 
-	// The ID of the collection.
-	CollectionID *string `json:"collection_id" validate:"required"`
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
 
-	// The content of the document to ingest. The maximum supported file size when adding a file to a collection is 50
-	// megabytes, the maximum supported file size when testing a confiruration is 1 megabyte. Files larger than the
-	// supported size are rejected.
-	File io.ReadCloser `json:"file,omitempty"`
+	for headerName, headerValue := range listEnrichmentsOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
 
-	// The filename for file.
-	Filename *string `json:"filename,omitempty"`
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "ListEnrichments")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
 
-	// The content type of file.
-	FileContentType *string `json:"file_content_type,omitempty"`
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", discovery.Version)
 
-	// The maximum supported metadata file size is 1 MB. Metadata parts larger than 1 MB are rejected. Example:  ``` {
-	//   "Creator": "Johnny Appleseed",
-	//   "Subject": "Apples"
-	// } ```.
-	Metadata *string `json:"metadata,omitempty"`
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
 
-	// When `true`, the uploaded document is added to the collection even if the data for that collection is shared with
-	// other collections.
-	XWatsonDiscoveryForce *bool `json:"X-Watson-Discovery-Force,omitempty"`
+	response, err = discovery.Service.Request(request, new(Enrichments))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*Enrichments)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
 
-	// Allows users to set headers to be GDPR compliant
-	Headers map[string]string
+	return
 }
 
-// NewAddDocumentOptions : Instantiate AddDocumentOptions
-func (discovery *DiscoveryV2) NewAddDocumentOptions(projectID string, collectionID string) *AddDocumentOptions {
-	return &AddDocumentOptions{
-		ProjectID:    core.StringPtr(projectID),
-		CollectionID: core.StringPtr(collectionID),
+// CreateEnrichment : Create an enrichment
+// Create an enrichment for use with the specified project.
+func (discovery *DiscoveryV2) CreateEnrichment(createEnrichmentOptions *CreateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createEnrichmentOptions, "createEnrichmentOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createEnrichmentOptions, "createEnrichmentOptions")
+	if err != nil {
+		return
 	}
-}
 
-// SetProjectID : Allow user to set ProjectID
-func (options *AddDocumentOptions) SetProjectID(projectID string) *AddDocumentOptions {
-	options.ProjectID = core.StringPtr(projectID)
-	return options
-}
+	pathSegments := []string{"v2/projects", "enrichments"}
+	pathParameters := []string{*createEnrichmentOptions.ProjectID}
 
-// SetCollectionID : Allow user to set CollectionID
-func (options *AddDocumentOptions) SetCollectionID(collectionID string) *AddDocumentOptions {
-	options.CollectionID = core.StringPtr(collectionID)
-	return options
-}
+	builder := core.NewRequestBuilder(core.POST)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range createEnrichmentOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "CreateEnrichment")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	builder.AddFormData("enrichment", "", "application/json", createEnrichmentOptions.Enrichment)
+	if createEnrichmentOptions.File != nil {
+		builder.AddFormData("file", "filename",
+			"application/octet-stream", createEnrichmentOptions.File)
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(Enrichment))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*Enrichment)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// GetEnrichment : Get enrichment
+// Get details about a specific enrichment.
+func (discovery *DiscoveryV2) GetEnrichment(getEnrichmentOptions *GetEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getEnrichmentOptions, "getEnrichmentOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(getEnrichmentOptions, "getEnrichmentOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects", "enrichments"}
+	pathParameters := []string{*getEnrichmentOptions.ProjectID, *getEnrichmentOptions.EnrichmentID}
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range getEnrichmentOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "GetEnrichment")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(Enrichment))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*Enrichment)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// UpdateEnrichment : Update an enrichment
+// Updates an existing enrichment's name and description.
+func (discovery *DiscoveryV2) UpdateEnrichment(updateEnrichmentOptions *UpdateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateEnrichmentOptions, "updateEnrichmentOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(updateEnrichmentOptions, "updateEnrichmentOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects", "enrichments"}
+	pathParameters := []string{*updateEnrichmentOptions.ProjectID, *updateEnrichmentOptions.EnrichmentID}
+
+	builder := core.NewRequestBuilder(core.POST)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range updateEnrichmentOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "UpdateEnrichment")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	body := make(map[string]interface{})
+	if updateEnrichmentOptions.Name != nil {
+		body["name"] = updateEnrichmentOptions.Name
+	}
+	if updateEnrichmentOptions.Description != nil {
+		body["description"] = updateEnrichmentOptions.Description
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(Enrichment))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*Enrichment)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// DeleteEnrichment : Delete an enrichment
+// Deletes an existing enrichment from the specified project.
+//
+// **Note:** Only enrichments that have been manually created can be deleted.
+func (discovery *DiscoveryV2) DeleteEnrichment(deleteEnrichmentOptions *DeleteEnrichmentOptions) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(deleteEnrichmentOptions, "deleteEnrichmentOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(deleteEnrichmentOptions, "deleteEnrichmentOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects", "enrichments"}
+	pathParameters := []string{*deleteEnrichmentOptions.ProjectID, *deleteEnrichmentOptions.EnrichmentID}
+
+	builder := core.NewRequestBuilder(core.DELETE)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range deleteEnrichmentOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "DeleteEnrichment")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddQuery("version", discovery.Version)
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
+
+	return
+}
+
+// ListProjects : List projects
+// Lists existing projects for this instance.
+func (discovery *DiscoveryV2) ListProjects(listProjectsOptions *ListProjectsOptions) (result *ListProjectsResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateStruct(listProjectsOptions, "listProjectsOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects"}
+	pathParameters := []string{}
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range listProjectsOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "ListProjects")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(ListProjectsResponse))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*ListProjectsResponse)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// CreateProject : Create a Project
+// Create a new project for this instance.
+func (discovery *DiscoveryV2) CreateProject(createProjectOptions *CreateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createProjectOptions, "createProjectOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createProjectOptions, "createProjectOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects"}
+	pathParameters := []string{}
+
+	builder := core.NewRequestBuilder(core.POST)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range createProjectOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "CreateProject")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	body := make(map[string]interface{})
+	if createProjectOptions.Name != nil {
+		body["name"] = createProjectOptions.Name
+	}
+	if createProjectOptions.Type != nil {
+		body["type"] = createProjectOptions.Type
+	}
+	if createProjectOptions.DefaultQueryParameters != nil {
+		body["default_query_parameters"] = createProjectOptions.DefaultQueryParameters
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(ProjectDetails))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*ProjectDetails)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// GetProject : Get project
+// Get details on the specified project.
+func (discovery *DiscoveryV2) GetProject(getProjectOptions *GetProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getProjectOptions, "getProjectOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(getProjectOptions, "getProjectOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects"}
+	pathParameters := []string{*getProjectOptions.ProjectID}
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range getProjectOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "GetProject")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(ProjectDetails))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*ProjectDetails)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// UpdateProject : Update a project
+// Update the specified project's name.
+func (discovery *DiscoveryV2) UpdateProject(updateProjectOptions *UpdateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateProjectOptions, "updateProjectOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(updateProjectOptions, "updateProjectOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects"}
+	pathParameters := []string{*updateProjectOptions.ProjectID}
+
+	builder := core.NewRequestBuilder(core.POST)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range updateProjectOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "UpdateProject")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	builder.AddQuery("version", discovery.Version)
+
+	body := make(map[string]interface{})
+	if updateProjectOptions.Name != nil {
+		body["name"] = updateProjectOptions.Name
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, new(ProjectDetails))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*ProjectDetails)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		}
+	}
+
+	return
+}
+
+// DeleteProject : Delete a project
+// Deletes the specified project.
+//
+// **Important:** Deleting a project deletes everything that is part of the specified project, including all
+// collections.
+func (discovery *DiscoveryV2) DeleteProject(deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(deleteProjectOptions, "deleteProjectOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(deleteProjectOptions, "deleteProjectOptions")
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v2/projects"}
+	pathParameters := []string{*deleteProjectOptions.ProjectID}
+
+	builder := core.NewRequestBuilder(core.DELETE)
+	_, err = builder.ConstructHTTPURL(discovery.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range deleteProjectOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("discovery", "V2", "DeleteProject")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddQuery("version", discovery.Version)
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	response, err = discovery.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
+
+	return
+}
+
+// AddDocumentOptions : The AddDocument options.
+type AddDocumentOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The ID of the collection.
+	CollectionID *string `json:"collection_id" validate:"required"`
+
+	// The content of the document to ingest. The maximum supported file size when adding a file to a collection is 50
+	// megabytes, the maximum supported file size when testing a confiruration is 1 megabyte. Files larger than the
+	// supported size are rejected.
+	File io.ReadCloser `json:"file,omitempty"`
+
+	// The filename for file.
+	Filename *string `json:"filename,omitempty"`
+
+	// The content type of file.
+	FileContentType *string `json:"file_content_type,omitempty"`
+
+	// The maximum supported metadata file size is 1 MB. Metadata parts larger than 1 MB are rejected. Example:  ``` {
+	//   "Creator": "Johnny Appleseed",
+	//   "Subject": "Apples"
+	// } ```.
+	Metadata *string `json:"metadata,omitempty"`
+
+	// When `true`, the uploaded document is added to the collection even if the data for that collection is shared with
+	// other collections.
+	XWatsonDiscoveryForce *bool `json:"X-Watson-Discovery-Force,omitempty"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewAddDocumentOptions : Instantiate AddDocumentOptions
+func (discovery *DiscoveryV2) NewAddDocumentOptions(projectID string, collectionID string) *AddDocumentOptions {
+	return &AddDocumentOptions{
+		ProjectID:    core.StringPtr(projectID),
+		CollectionID: core.StringPtr(collectionID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *AddDocumentOptions) SetProjectID(projectID string) *AddDocumentOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetCollectionID : Allow user to set CollectionID
+func (options *AddDocumentOptions) SetCollectionID(collectionID string) *AddDocumentOptions {
+	options.CollectionID = core.StringPtr(collectionID)
+	return options
+}
 
 // SetFile : Allow user to set File
 func (options *AddDocumentOptions) SetFile(file io.ReadCloser) *AddDocumentOptions {
@@ -1032,110 +1824,340 @@ func (options *AddDocumentOptions) SetFileContentType(fileContentType string) *A
 	return options
 }
 
-// SetMetadata : Allow user to set Metadata
-func (options *AddDocumentOptions) SetMetadata(metadata string) *AddDocumentOptions {
-	options.Metadata = core.StringPtr(metadata)
+// SetMetadata : Allow user to set Metadata
+func (options *AddDocumentOptions) SetMetadata(metadata string) *AddDocumentOptions {
+	options.Metadata = core.StringPtr(metadata)
+	return options
+}
+
+// SetXWatsonDiscoveryForce : Allow user to set XWatsonDiscoveryForce
+func (options *AddDocumentOptions) SetXWatsonDiscoveryForce(xWatsonDiscoveryForce bool) *AddDocumentOptions {
+	options.XWatsonDiscoveryForce = core.BoolPtr(xWatsonDiscoveryForce)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *AddDocumentOptions) SetHeaders(param map[string]string) *AddDocumentOptions {
+	options.Headers = param
+	return options
+}
+
+// Collection : A collection for storing documents.
+type Collection struct {
+
+	// The unique identifier of the collection.
+	CollectionID *string `json:"collection_id,omitempty"`
+
+	// The name of the collection.
+	Name *string `json:"name,omitempty"`
+}
+
+// CollectionDetails : A collection for storing documents.
+type CollectionDetails struct {
+
+	// The unique identifier of the collection.
+	CollectionID *string `json:"collection_id,omitempty"`
+
+	// The name of the collection.
+	Name *string `json:"name,omitempty"`
+
+	// A description of the collection.
+	Description *string `json:"description,omitempty"`
+
+	// The date that the collection was created.
+	Created *strfmt.DateTime `json:"created,omitempty"`
+
+	// The language of the collection.
+	Language *string `json:"language,omitempty"`
+
+	// An array of enrichments that are applied to this collection.
+	Enrichments []CollectionEnrichment `json:"enrichments,omitempty"`
+}
+
+// CollectionEnrichment : An object describing an Enrichment for a collection.
+type CollectionEnrichment struct {
+
+	// The unique identifier of this enrichment.
+	EnrichmentID *string `json:"enrichment_id,omitempty"`
+
+	// An array of field names that the enrichment is applied to.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Completions : An object containing an array of autocompletion suggestions.
+type Completions struct {
+
+	// Array of autcomplete suggestion based on the provided prefix.
+	Completions []string `json:"completions,omitempty"`
+}
+
+// ComponentSettingsAggregation : Display settings for aggregations.
+type ComponentSettingsAggregation struct {
+
+	// Identifier used to map aggregation settings to aggregation configuration.
+	Name *string `json:"name,omitempty"`
+
+	// User-friendly alias for the aggregation.
+	Label *string `json:"label,omitempty"`
+
+	// Whether users is allowed to select more than one of the aggregation terms.
+	MultipleSelectionsAllowed *bool `json:"multiple_selections_allowed,omitempty"`
+
+	// Type of visualization to use when rendering the aggregation.
+	VisualizationType *string `json:"visualization_type,omitempty"`
+}
+
+// Constants associated with the ComponentSettingsAggregation.VisualizationType property.
+// Type of visualization to use when rendering the aggregation.
+const (
+	ComponentSettingsAggregation_VisualizationType_Auto       = "auto"
+	ComponentSettingsAggregation_VisualizationType_FacetTable = "facet_table"
+	ComponentSettingsAggregation_VisualizationType_Map        = "map"
+	ComponentSettingsAggregation_VisualizationType_WordCloud  = "word_cloud"
+)
+
+// ComponentSettingsFieldsShown : Fields shown in the results section of the UI.
+type ComponentSettingsFieldsShown struct {
+
+	// Body label.
+	Body *ComponentSettingsFieldsShownBody `json:"body,omitempty"`
+
+	// Title label.
+	Title *ComponentSettingsFieldsShownTitle `json:"title,omitempty"`
+}
+
+// ComponentSettingsFieldsShownBody : Body label.
+type ComponentSettingsFieldsShownBody struct {
+
+	// Use the whole passage as the body.
+	UsePassage *bool `json:"use_passage,omitempty"`
+
+	// Use a specific field as the title.
+	Field *string `json:"field,omitempty"`
+}
+
+// ComponentSettingsFieldsShownTitle : Title label.
+type ComponentSettingsFieldsShownTitle struct {
+
+	// Use a specific field as the title.
+	Field *string `json:"field,omitempty"`
+}
+
+// ComponentSettingsResponse : A response containing the default component settings.
+type ComponentSettingsResponse struct {
+
+	// Fields shown in the results section of the UI.
+	FieldsShown *ComponentSettingsFieldsShown `json:"fields_shown,omitempty"`
+
+	// Whether or not autocomplete is enabled.
+	Autocomplete *bool `json:"autocomplete,omitempty"`
+
+	// Whether or not structured search is enabled.
+	StructuredSearch *bool `json:"structured_search,omitempty"`
+
+	// Number or results shown per page.
+	ResultsPerPage *int64 `json:"results_per_page,omitempty"`
+
+	// a list of component setting aggregations.
+	Aggregations []ComponentSettingsAggregation `json:"aggregations,omitempty"`
+}
+
+// CreateCollectionOptions : The CreateCollection options.
+type CreateCollectionOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The name of the collection.
+	Name *string `json:"name,omitempty"`
+
+	// A description of the collection.
+	Description *string `json:"description,omitempty"`
+
+	// The language of the collection.
+	Language *string `json:"language,omitempty"`
+
+	// An array of enrichments that are applied to this collection.
+	Enrichments []CollectionEnrichment `json:"enrichments,omitempty"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewCreateCollectionOptions : Instantiate CreateCollectionOptions
+func (discovery *DiscoveryV2) NewCreateCollectionOptions(projectID string) *CreateCollectionOptions {
+	return &CreateCollectionOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *CreateCollectionOptions) SetProjectID(projectID string) *CreateCollectionOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetName : Allow user to set Name
+func (options *CreateCollectionOptions) SetName(name string) *CreateCollectionOptions {
+	options.Name = core.StringPtr(name)
+	return options
+}
+
+// SetDescription : Allow user to set Description
+func (options *CreateCollectionOptions) SetDescription(description string) *CreateCollectionOptions {
+	options.Description = core.StringPtr(description)
+	return options
+}
+
+// SetLanguage : Allow user to set Language
+func (options *CreateCollectionOptions) SetLanguage(language string) *CreateCollectionOptions {
+	options.Language = core.StringPtr(language)
 	return options
 }
 
-// SetXWatsonDiscoveryForce : Allow user to set XWatsonDiscoveryForce
-func (options *AddDocumentOptions) SetXWatsonDiscoveryForce(xWatsonDiscoveryForce bool) *AddDocumentOptions {
-	options.XWatsonDiscoveryForce = core.BoolPtr(xWatsonDiscoveryForce)
+// SetEnrichments : Allow user to set Enrichments
+func (options *CreateCollectionOptions) SetEnrichments(enrichments []CollectionEnrichment) *CreateCollectionOptions {
+	options.Enrichments = enrichments
 	return options
 }
 
 // SetHeaders : Allow user to set Headers
-func (options *AddDocumentOptions) SetHeaders(param map[string]string) *AddDocumentOptions {
+func (options *CreateCollectionOptions) SetHeaders(param map[string]string) *CreateCollectionOptions {
 	options.Headers = param
 	return options
 }
 
-// Collection : A collection for storing documents.
-type Collection struct {
-
-	// The unique identifier of the collection.
-	CollectionID *string `json:"collection_id,omitempty"`
+// CreateEnrichment : Information about a specific enrichment.
+type CreateEnrichment struct {
 
-	// The name of the collection.
+	// The human readable name for this enrichment.
 	Name *string `json:"name,omitempty"`
-}
 
-// Completions : An object containing an array of autocompletion suggestions.
-type Completions struct {
+	// The description of this enrichment.
+	Description *string `json:"description,omitempty"`
 
-	// Array of autcomplete suggestion based on the provided prefix.
-	Completions []string `json:"completions,omitempty"`
+	// The type of this enrichment.
+	Type *string `json:"type,omitempty"`
+
+	// An object containing options for the current enrichment.
+	Options *EnrichmentOptions `json:"options,omitempty"`
 }
 
-// ComponentSettingsAggregation : Display settings for aggregations.
-type ComponentSettingsAggregation struct {
+// Constants associated with the CreateEnrichment.Type property.
+// The type of this enrichment.
+const (
+	CreateEnrichment_Type_Dictionary                 = "dictionary"
+	CreateEnrichment_Type_RegularExpression          = "regular_expression"
+	CreateEnrichment_Type_UimaAnnotator              = "uima_annotator"
+	CreateEnrichment_Type_RuleBased                  = "rule_based"
+	CreateEnrichment_Type_WatsonKnowledgeStudioModel = "watson_knowledge_studio_model"
+)
 
-	// Identifier used to map aggregation settings to aggregation configuration.
-	Name *string `json:"name,omitempty"`
+// CreateEnrichmentOptions : The CreateEnrichment options.
+type CreateEnrichmentOptions struct {
 
-	// User-friendly alias for the aggregation.
-	Label *string `json:"label,omitempty"`
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
 
-	// Whether users is allowed to select more than one of the aggregation terms.
-	MultipleSelectionsAllowed *bool `json:"multiple_selections_allowed,omitempty"`
+	// The enrichment to create.
+	Enrichment *CreateEnrichment `json:"enrichment" validate:"required"`
 
-	// Type of visualization to use when rendering the aggregation.
-	VisualizationType *string `json:"visualization_type,omitempty"`
+	// The enrichment file to upload.
+	File io.ReadCloser `json:"file,omitempty"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
 }
 
-// Constants associated with the ComponentSettingsAggregation.VisualizationType property.
-// Type of visualization to use when rendering the aggregation.
-const (
-	ComponentSettingsAggregation_VisualizationType_Auto       = "auto"
-	ComponentSettingsAggregation_VisualizationType_FacetTable = "facet_table"
-	ComponentSettingsAggregation_VisualizationType_Map        = "map"
-	ComponentSettingsAggregation_VisualizationType_WordCloud  = "word_cloud"
-)
+// NewCreateEnrichmentOptions : Instantiate CreateEnrichmentOptions
+func (discovery *DiscoveryV2) NewCreateEnrichmentOptions(projectID string, enrichment *CreateEnrichment) *CreateEnrichmentOptions {
+	return &CreateEnrichmentOptions{
+		ProjectID:  core.StringPtr(projectID),
+		Enrichment: enrichment,
+	}
+}
 
-// ComponentSettingsFieldsShown : Fields shown in the results section of the UI.
-type ComponentSettingsFieldsShown struct {
+// SetProjectID : Allow user to set ProjectID
+func (options *CreateEnrichmentOptions) SetProjectID(projectID string) *CreateEnrichmentOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
 
-	// Body label.
-	Body *ComponentSettingsFieldsShownBody `json:"body,omitempty"`
+// SetEnrichment : Allow user to set Enrichment
+func (options *CreateEnrichmentOptions) SetEnrichment(enrichment *CreateEnrichment) *CreateEnrichmentOptions {
+	options.Enrichment = enrichment
+	return options
+}
 
-	// Title label.
-	Title *ComponentSettingsFieldsShownTitle `json:"title,omitempty"`
+// SetFile : Allow user to set File
+func (options *CreateEnrichmentOptions) SetFile(file io.ReadCloser) *CreateEnrichmentOptions {
+	options.File = file
+	return options
 }
 
-// ComponentSettingsFieldsShownBody : Body label.
-type ComponentSettingsFieldsShownBody struct {
+// SetHeaders : Allow user to set Headers
+func (options *CreateEnrichmentOptions) SetHeaders(param map[string]string) *CreateEnrichmentOptions {
+	options.Headers = param
+	return options
+}
 
-	// Use the whole passage as the body.
-	UsePassage *bool `json:"use_passage,omitempty"`
+// CreateProjectOptions : The CreateProject options.
+type CreateProjectOptions struct {
 
-	// Use a specific field as the title.
-	Field *string `json:"field,omitempty"`
-}
+	// The human readable name of this project.
+	Name *string `json:"name" validate:"required"`
 
-// ComponentSettingsFieldsShownTitle : Title label.
-type ComponentSettingsFieldsShownTitle struct {
+	// The project type of this project.
+	Type *string `json:"type" validate:"required"`
 
-	// Use a specific field as the title.
-	Field *string `json:"field,omitempty"`
+	// Default query parameters for this project.
+	DefaultQueryParameters *DefaultQueryParams `json:"default_query_parameters,omitempty"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
 }
 
-// ComponentSettingsResponse : A response containing the default component settings.
-type ComponentSettingsResponse struct {
+// Constants associated with the CreateProjectOptions.Type property.
+// The project type of this project.
+const (
+	CreateProjectOptions_Type_AnswerRetrieval   = "answer_retrieval"
+	CreateProjectOptions_Type_ContentMining     = "content_mining"
+	CreateProjectOptions_Type_DocumentRetrieval = "document_retrieval"
+	CreateProjectOptions_Type_Other             = "other"
+)
 
-	// Fields shown in the results section of the UI.
-	FieldsShown *ComponentSettingsFieldsShown `json:"fields_shown,omitempty"`
+// NewCreateProjectOptions : Instantiate CreateProjectOptions
+func (discovery *DiscoveryV2) NewCreateProjectOptions(name string, typeVar string) *CreateProjectOptions {
+	return &CreateProjectOptions{
+		Name: core.StringPtr(name),
+		Type: core.StringPtr(typeVar),
+	}
+}
 
-	// Whether or not autocomplete is enabled.
-	Autocomplete *bool `json:"autocomplete,omitempty"`
+// SetName : Allow user to set Name
+func (options *CreateProjectOptions) SetName(name string) *CreateProjectOptions {
+	options.Name = core.StringPtr(name)
+	return options
+}
 
-	// Whether or not structured search is enabled.
-	StructuredSearch *bool `json:"structured_search,omitempty"`
+// SetType : Allow user to set Type
+func (options *CreateProjectOptions) SetType(typeVar string) *CreateProjectOptions {
+	options.Type = core.StringPtr(typeVar)
+	return options
+}
 
-	// Number or results shown per page.
-	ResultsPerPage *int64 `json:"results_per_page,omitempty"`
+// SetDefaultQueryParameters : Allow user to set DefaultQueryParameters
+func (options *CreateProjectOptions) SetDefaultQueryParameters(defaultQueryParameters *DefaultQueryParams) *CreateProjectOptions {
+	options.DefaultQueryParameters = defaultQueryParameters
+	return options
+}
 
-	// a list of component setting aggregations.
-	Aggregations []ComponentSettingsAggregation `json:"aggregations,omitempty"`
+// SetHeaders : Allow user to set Headers
+func (options *CreateProjectOptions) SetHeaders(param map[string]string) *CreateProjectOptions {
+	options.Headers = param
+	return options
 }
 
 // CreateTrainingQueryOptions : The CreateTrainingQuery options.
@@ -1194,6 +2216,126 @@ func (options *CreateTrainingQueryOptions) SetHeaders(param map[string]string) *
 	return options
 }
 
+// DefaultQueryParams : Default query parameters for this project.
+type DefaultQueryParams struct {
+
+	// An array of collection identifiers to query. If empty or omitted all collections in the project are queried.
+	CollectionIds []string `json:"collection_ids,omitempty"`
+
+	// Default settings configuration for passage search options.
+	Passages *DefaultQueryParamsPassages `json:"passages,omitempty"`
+
+	// Default project query settings for table results.
+	TableResults *DefaultQueryParamsTableResults `json:"table_results,omitempty"`
+
+	// A string representing the default aggregation query for the project.
+	Aggregation *string `json:"aggregation,omitempty"`
+
+	// Object containing suggested refinement settings.
+	SuggestedRefinements *DefaultQueryParamsSuggestedRefinements `json:"suggested_refinements,omitempty"`
+
+	// When `true`, spelling suggestions for the query are returned by default.
+	SpellingSuggestions *bool `json:"spelling_suggestions,omitempty"`
+
+	// When `true`, highlights for the query are returned by default.
+	Highlight *bool `json:"highlight,omitempty"`
+
+	// The number of document results returned by default.
+	Count *int64 `json:"count,omitempty"`
+
+	// A comma separated list of document fields to sort results by default.
+	Sort *string `json:"sort,omitempty"`
+
+	// An array of field names to return in document results if present by default.
+	Return []string `json:"return,omitempty"`
+}
+
+// DefaultQueryParamsPassages : Default settings configuration for passage search options.
+type DefaultQueryParamsPassages struct {
+
+	// When `true`, a passage search is performed by default.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The number of passages to return.
+	Count *int64 `json:"count,omitempty"`
+
+	// An array of field names to perform the passage search on.
+	Fields []string `json:"fields,omitempty"`
+
+	// The approximate number of characters that each returned passage will contain.
+	Characters *int64 `json:"characters,omitempty"`
+
+	// When `true` the number of passages that can be returned from a single document is restricted to the
+	// *max_per_document* value.
+	PerDocument *bool `json:"per_document,omitempty"`
+
+	// The default maximum number of passages that can be taken from a single document as the result of a passage query.
+	MaxPerDocument *int64 `json:"max_per_document,omitempty"`
+}
+
+// DefaultQueryParamsSuggestedRefinements : Object containing suggested refinement settings.
+type DefaultQueryParamsSuggestedRefinements struct {
+
+	// When `true`, suggested refinements for the query are returned by default.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The number of suggested refinements to return by default.
+	Count *int64 `json:"count,omitempty"`
+}
+
+// DefaultQueryParamsTableResults : Default project query settings for table results.
+type DefaultQueryParamsTableResults struct {
+
+	// When `true`, table results for the query are returned by default.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The number of table results to return by default.
+	Count *int64 `json:"count,omitempty"`
+
+	// The number of table results to include in each result document.
+	PerDocument *int64 `json:"per_document,omitempty"`
+}
+
+// DeleteCollectionOptions : The DeleteCollection options.
+type DeleteCollectionOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The ID of the collection.
+	CollectionID *string `json:"collection_id" validate:"required"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewDeleteCollectionOptions : Instantiate DeleteCollectionOptions
+func (discovery *DiscoveryV2) NewDeleteCollectionOptions(projectID string, collectionID string) *DeleteCollectionOptions {
+	return &DeleteCollectionOptions{
+		ProjectID:    core.StringPtr(projectID),
+		CollectionID: core.StringPtr(collectionID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *DeleteCollectionOptions) SetProjectID(projectID string) *DeleteCollectionOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetCollectionID : Allow user to set CollectionID
+func (options *DeleteCollectionOptions) SetCollectionID(collectionID string) *DeleteCollectionOptions {
+	options.CollectionID = core.StringPtr(collectionID)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteCollectionOptions) SetHeaders(param map[string]string) *DeleteCollectionOptions {
+	options.Headers = param
+	return options
+}
+
 // DeleteDocumentOptions : The DeleteDocument options.
 type DeleteDocumentOptions struct {
 
@@ -1269,6 +2411,76 @@ const (
 	DeleteDocumentResponse_Status_Deleted = "deleted"
 )
 
+// DeleteEnrichmentOptions : The DeleteEnrichment options.
+type DeleteEnrichmentOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The ID of the enrichment.
+	EnrichmentID *string `json:"enrichment_id" validate:"required"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewDeleteEnrichmentOptions : Instantiate DeleteEnrichmentOptions
+func (discovery *DiscoveryV2) NewDeleteEnrichmentOptions(projectID string, enrichmentID string) *DeleteEnrichmentOptions {
+	return &DeleteEnrichmentOptions{
+		ProjectID:    core.StringPtr(projectID),
+		EnrichmentID: core.StringPtr(enrichmentID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *DeleteEnrichmentOptions) SetProjectID(projectID string) *DeleteEnrichmentOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetEnrichmentID : Allow user to set EnrichmentID
+func (options *DeleteEnrichmentOptions) SetEnrichmentID(enrichmentID string) *DeleteEnrichmentOptions {
+	options.EnrichmentID = core.StringPtr(enrichmentID)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteEnrichmentOptions) SetHeaders(param map[string]string) *DeleteEnrichmentOptions {
+	options.Headers = param
+	return options
+}
+
+// DeleteProjectOptions : The DeleteProject options.
+type DeleteProjectOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewDeleteProjectOptions : Instantiate DeleteProjectOptions
+func (discovery *DiscoveryV2) NewDeleteProjectOptions(projectID string) *DeleteProjectOptions {
+	return &DeleteProjectOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *DeleteProjectOptions) SetProjectID(projectID string) *DeleteProjectOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteProjectOptions) SetHeaders(param map[string]string) *DeleteProjectOptions {
+	options.Headers = param
+	return options
+}
+
 // DeleteTrainingQueriesOptions : The DeleteTrainingQueries options.
 type DeleteTrainingQueriesOptions struct {
 
@@ -1326,9 +2538,67 @@ type DocumentAttribute struct {
 	// The text associated with the attribute.
 	Text *string `json:"text,omitempty"`
 
-	// The numeric location of the identified element in the document, represented with two integers labeled `begin` and
-	// `end`.
-	Location *TableElementLocation `json:"location,omitempty"`
+	// The numeric location of the identified element in the document, represented with two integers labeled `begin` and
+	// `end`.
+	Location *TableElementLocation `json:"location,omitempty"`
+}
+
+// Enrichment : Information about a specific enrichment.
+type Enrichment struct {
+
+	// The unique identifier of this enrichment.
+	EnrichmentID *string `json:"enrichment_id,omitempty"`
+
+	// The human readable name for this enrichment.
+	Name *string `json:"name,omitempty"`
+
+	// The description of this enrichment.
+	Description *string `json:"description,omitempty"`
+
+	// The type of this enrichment.
+	Type *string `json:"type,omitempty"`
+
+	// An object containing options for the current enrichment.
+	Options *EnrichmentOptions `json:"options,omitempty"`
+}
+
+// Constants associated with the Enrichment.Type property.
+// The type of this enrichment.
+const (
+	Enrichment_Type_PartOfSpeech                 = "part_of_speech"
+	Enrichment_Type_SentimentOfPhrase            = "sentiment_of_phrase"
+	Enrichment_Type_NaturalLanguageUnderstanding = "natural_language_understanding"
+	Enrichment_Type_Dictionary                   = "dictionary"
+	Enrichment_Type_RegularExpression            = "regular_expression"
+	Enrichment_Type_UimaAnnotator                = "uima_annotator"
+	Enrichment_Type_RuleBased                    = "rule_based"
+	Enrichment_Type_WatsonKnowledgeStudioModel   = "watson_knowledge_studio_model"
+)
+
+// EnrichmentOptions : An object containing options for the current enrichment.
+type EnrichmentOptions struct {
+
+	// An array of supported languages for this enrichment.
+	Languages []string `json:"languages,omitempty"`
+
+	// The type of entity. Required when creating `dictionary` and `regular_expression` **type** enrichment. Not valid
+	// when creating any other type of enrichment.
+	EntityType *string `json:"entity_type,omitempty"`
+
+	// The regular expression to apply for this enrichment. Required only when the **type** of enrichment being created
+	// is a `regular_expression`. Not valid when creating any other type of enrichment.
+	RegularExpression *string `json:"regular_expression,omitempty"`
+
+	// The name of the result document field that this enrichment creates. Required only when the enrichment **type** is
+	// `rule_based`. Not valid when creating any other type of enrichment.
+	ResultField *string `json:"result_field,omitempty"`
+}
+
+// Enrichments : An object containing an array of enrichment definitions.
+type Enrichments struct {
+
+	// An array of enrichment definitions.
+	Enrichments []Enrichment `json:"enrichments,omitempty"`
 }
 
 // Field : Object containing field details.
@@ -1428,6 +2698,46 @@ func (options *GetAutocompletionOptions) SetHeaders(param map[string]string) *Ge
 	return options
 }
 
+// GetCollectionOptions : The GetCollection options.
+type GetCollectionOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The ID of the collection.
+	CollectionID *string `json:"collection_id" validate:"required"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewGetCollectionOptions : Instantiate GetCollectionOptions
+func (discovery *DiscoveryV2) NewGetCollectionOptions(projectID string, collectionID string) *GetCollectionOptions {
+	return &GetCollectionOptions{
+		ProjectID:    core.StringPtr(projectID),
+		CollectionID: core.StringPtr(collectionID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *GetCollectionOptions) SetProjectID(projectID string) *GetCollectionOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetCollectionID : Allow user to set CollectionID
+func (options *GetCollectionOptions) SetCollectionID(collectionID string) *GetCollectionOptions {
+	options.CollectionID = core.StringPtr(collectionID)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetCollectionOptions) SetHeaders(param map[string]string) *GetCollectionOptions {
+	options.Headers = param
+	return options
+}
+
 // GetComponentSettingsOptions : The GetComponentSettings options.
 type GetComponentSettingsOptions struct {
 
@@ -1457,6 +2767,76 @@ func (options *GetComponentSettingsOptions) SetHeaders(param map[string]string)
 	return options
 }
 
+// GetEnrichmentOptions : The GetEnrichment options.
+type GetEnrichmentOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The ID of the enrichment.
+	EnrichmentID *string `json:"enrichment_id" validate:"required"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewGetEnrichmentOptions : Instantiate GetEnrichmentOptions
+func (discovery *DiscoveryV2) NewGetEnrichmentOptions(projectID string, enrichmentID string) *GetEnrichmentOptions {
+	return &GetEnrichmentOptions{
+		ProjectID:    core.StringPtr(projectID),
+		EnrichmentID: core.StringPtr(enrichmentID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *GetEnrichmentOptions) SetProjectID(projectID string) *GetEnrichmentOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetEnrichmentID : Allow user to set EnrichmentID
+func (options *GetEnrichmentOptions) SetEnrichmentID(enrichmentID string) *GetEnrichmentOptions {
+	options.EnrichmentID = core.StringPtr(enrichmentID)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetEnrichmentOptions) SetHeaders(param map[string]string) *GetEnrichmentOptions {
+	options.Headers = param
+	return options
+}
+
+// GetProjectOptions : The GetProject options.
+type GetProjectOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewGetProjectOptions : Instantiate GetProjectOptions
+func (discovery *DiscoveryV2) NewGetProjectOptions(projectID string) *GetProjectOptions {
+	return &GetProjectOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *GetProjectOptions) SetProjectID(projectID string) *GetProjectOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetProjectOptions) SetHeaders(param map[string]string) *GetProjectOptions {
+	options.Headers = param
+	return options
+}
+
 // GetTrainingQueryOptions : The GetTrainingQuery options.
 type GetTrainingQueryOptions struct {
 
@@ -1532,6 +2912,36 @@ type ListCollectionsResponse struct {
 	Collections []Collection `json:"collections,omitempty"`
 }
 
+// ListEnrichmentsOptions : The ListEnrichments options.
+type ListEnrichmentsOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewListEnrichmentsOptions : Instantiate ListEnrichmentsOptions
+func (discovery *DiscoveryV2) NewListEnrichmentsOptions(projectID string) *ListEnrichmentsOptions {
+	return &ListEnrichmentsOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *ListEnrichmentsOptions) SetProjectID(projectID string) *ListEnrichmentsOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListEnrichmentsOptions) SetHeaders(param map[string]string) *ListEnrichmentsOptions {
+	options.Headers = param
+	return options
+}
+
 // ListFieldsOptions : The ListFields options.
 type ListFieldsOptions struct {
 
@@ -1586,6 +2996,31 @@ type ListFieldsResponse struct {
 	Fields []Field `json:"fields,omitempty"`
 }
 
+// ListProjectsOptions : The ListProjects options.
+type ListProjectsOptions struct {
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewListProjectsOptions : Instantiate ListProjectsOptions
+func (discovery *DiscoveryV2) NewListProjectsOptions() *ListProjectsOptions {
+	return &ListProjectsOptions{}
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListProjectsOptions) SetHeaders(param map[string]string) *ListProjectsOptions {
+	options.Headers = param
+	return options
+}
+
+// ListProjectsResponse : A list of projects in this instance.
+type ListProjectsResponse struct {
+
+	// An array of project details.
+	Projects []ProjectListDetails `json:"projects,omitempty"`
+}
+
 // ListTrainingQueriesOptions : The ListTrainingQueries options.
 type ListTrainingQueriesOptions struct {
 
@@ -1657,6 +3092,96 @@ const (
 	Notice_Severity_Warning = "warning"
 )
 
+// ProjectDetails : Detailed information about the specified project.
+type ProjectDetails struct {
+
+	// The unique identifier of this project.
+	ProjectID *string `json:"project_id,omitempty"`
+
+	// The human readable name of this project.
+	Name *string `json:"name,omitempty"`
+
+	// The project type of this project.
+	Type *string `json:"type,omitempty"`
+
+	// Relevancy training status information for this project.
+	RelevancyTrainingStatus *ProjectListDetailsRelevancyTrainingStatus `json:"relevancy_training_status,omitempty"`
+
+	// The number of collections configured in this project.
+	CollectionCount *int64 `json:"collection_count,omitempty"`
+
+	// Default query parameters for this project.
+	DefaultQueryParameters *DefaultQueryParams `json:"default_query_parameters,omitempty"`
+}
+
+// Constants associated with the ProjectDetails.Type property.
+// The project type of this project.
+const (
+	ProjectDetails_Type_AnswerRetrieval   = "answer_retrieval"
+	ProjectDetails_Type_ContentMining     = "content_mining"
+	ProjectDetails_Type_DocumentRetrieval = "document_retrieval"
+	ProjectDetails_Type_Other             = "other"
+)
+
+// ProjectListDetails : Details about a specific project.
+type ProjectListDetails struct {
+
+	// The unique identifier of this project.
+	ProjectID *string `json:"project_id,omitempty"`
+
+	// The human readable name of this project.
+	Name *string `json:"name,omitempty"`
+
+	// The project type of this project.
+	Type *string `json:"type,omitempty"`
+
+	// Relevancy training status information for this project.
+	RelevancyTrainingStatus *ProjectListDetailsRelevancyTrainingStatus `json:"relevancy_training_status,omitempty"`
+
+	// The number of collections configured in this project.
+	CollectionCount *int64 `json:"collection_count,omitempty"`
+}
+
+// Constants associated with the ProjectListDetails.Type property.
+// The project type of this project.
+const (
+	ProjectListDetails_Type_AnswerRetrieval   = "answer_retrieval"
+	ProjectListDetails_Type_ContentMining     = "content_mining"
+	ProjectListDetails_Type_DocumentRetrieval = "document_retrieval"
+	ProjectListDetails_Type_Other             = "other"
+)
+
+// ProjectListDetailsRelevancyTrainingStatus : Relevancy training status information for this project.
+type ProjectListDetailsRelevancyTrainingStatus struct {
+
+	// When the training data was updated.
+	DataUpdated *string `json:"data_updated,omitempty"`
+
+	// The total number of examples.
+	TotalExamples *int64 `json:"total_examples,omitempty"`
+
+	// When `true`, sufficient label diversity is present to allow training for this project.
+	SufficientLabelDiversity *bool `json:"sufficient_label_diversity,omitempty"`
+
+	// When `true`, the relevancy training is in processing.
+	Processing *bool `json:"processing,omitempty"`
+
+	// When `true`, the minimum number of examples required to train has been met.
+	MinimumExamplesAdded *bool `json:"minimum_examples_added,omitempty"`
+
+	// The time that the most recent successful training occurred.
+	SuccessfullyTrained *string `json:"successfully_trained,omitempty"`
+
+	// When `true`, relevancy training is available when querying collections in the project.
+	Available *bool `json:"available,omitempty"`
+
+	// The number of notices generated during the relevancy training.
+	Notices *int64 `json:"notices,omitempty"`
+
+	// When `true`, the minimum number of queries required to train has been met.
+	MinimumQueriesAdded *bool `json:"minimum_queries_added,omitempty"`
+}
+
 // QueryAggregation : An abstract aggregation type produced by Discovery to analyze the input provided.
 type QueryAggregation struct {
 
@@ -2594,6 +4119,73 @@ type TrainingQuerySet struct {
 	Queries []TrainingQuery `json:"queries,omitempty"`
 }
 
+// UpdateCollectionOptions : The UpdateCollection options.
+type UpdateCollectionOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The ID of the collection.
+	CollectionID *string `json:"collection_id" validate:"required"`
+
+	// The name of the collection.
+	Name *string `json:"name,omitempty"`
+
+	// A description of the collection.
+	Description *string `json:"description,omitempty"`
+
+	// An array of enrichments that are applied to this collection.
+	Enrichments []CollectionEnrichment `json:"enrichments,omitempty"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewUpdateCollectionOptions : Instantiate UpdateCollectionOptions
+func (discovery *DiscoveryV2) NewUpdateCollectionOptions(projectID string, collectionID string) *UpdateCollectionOptions {
+	return &UpdateCollectionOptions{
+		ProjectID:    core.StringPtr(projectID),
+		CollectionID: core.StringPtr(collectionID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *UpdateCollectionOptions) SetProjectID(projectID string) *UpdateCollectionOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetCollectionID : Allow user to set CollectionID
+func (options *UpdateCollectionOptions) SetCollectionID(collectionID string) *UpdateCollectionOptions {
+	options.CollectionID = core.StringPtr(collectionID)
+	return options
+}
+
+// SetName : Allow user to set Name
+func (options *UpdateCollectionOptions) SetName(name string) *UpdateCollectionOptions {
+	options.Name = core.StringPtr(name)
+	return options
+}
+
+// SetDescription : Allow user to set Description
+func (options *UpdateCollectionOptions) SetDescription(description string) *UpdateCollectionOptions {
+	options.Description = core.StringPtr(description)
+	return options
+}
+
+// SetEnrichments : Allow user to set Enrichments
+func (options *UpdateCollectionOptions) SetEnrichments(enrichments []CollectionEnrichment) *UpdateCollectionOptions {
+	options.Enrichments = enrichments
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateCollectionOptions) SetHeaders(param map[string]string) *UpdateCollectionOptions {
+	options.Headers = param
+	return options
+}
+
 // UpdateDocumentOptions : The UpdateDocument options.
 type UpdateDocumentOptions struct {
 
@@ -2694,6 +4286,104 @@ func (options *UpdateDocumentOptions) SetHeaders(param map[string]string) *Updat
 	return options
 }
 
+// UpdateEnrichmentOptions : The UpdateEnrichment options.
+type UpdateEnrichmentOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The ID of the enrichment.
+	EnrichmentID *string `json:"enrichment_id" validate:"required"`
+
+	// A new name for the enrichment.
+	Name *string `json:"name" validate:"required"`
+
+	// A new description for the enrichment.
+	Description *string `json:"description,omitempty"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewUpdateEnrichmentOptions : Instantiate UpdateEnrichmentOptions
+func (discovery *DiscoveryV2) NewUpdateEnrichmentOptions(projectID string, enrichmentID string, name string) *UpdateEnrichmentOptions {
+	return &UpdateEnrichmentOptions{
+		ProjectID:    core.StringPtr(projectID),
+		EnrichmentID: core.StringPtr(enrichmentID),
+		Name:         core.StringPtr(name),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *UpdateEnrichmentOptions) SetProjectID(projectID string) *UpdateEnrichmentOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetEnrichmentID : Allow user to set EnrichmentID
+func (options *UpdateEnrichmentOptions) SetEnrichmentID(enrichmentID string) *UpdateEnrichmentOptions {
+	options.EnrichmentID = core.StringPtr(enrichmentID)
+	return options
+}
+
+// SetName : Allow user to set Name
+func (options *UpdateEnrichmentOptions) SetName(name string) *UpdateEnrichmentOptions {
+	options.Name = core.StringPtr(name)
+	return options
+}
+
+// SetDescription : Allow user to set Description
+func (options *UpdateEnrichmentOptions) SetDescription(description string) *UpdateEnrichmentOptions {
+	options.Description = core.StringPtr(description)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateEnrichmentOptions) SetHeaders(param map[string]string) *UpdateEnrichmentOptions {
+	options.Headers = param
+	return options
+}
+
+// UpdateProjectOptions : The UpdateProject options.
+type UpdateProjectOptions struct {
+
+	// The ID of the project. This information can be found from the deploy page of the Discovery administrative
+	// tooling.
+	ProjectID *string `json:"project_id" validate:"required"`
+
+	// The new name to give this project.
+	Name *string `json:"name,omitempty"`
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewUpdateProjectOptions : Instantiate UpdateProjectOptions
+func (discovery *DiscoveryV2) NewUpdateProjectOptions(projectID string) *UpdateProjectOptions {
+	return &UpdateProjectOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (options *UpdateProjectOptions) SetProjectID(projectID string) *UpdateProjectOptions {
+	options.ProjectID = core.StringPtr(projectID)
+	return options
+}
+
+// SetName : Allow user to set Name
+func (options *UpdateProjectOptions) SetName(name string) *UpdateProjectOptions {
+	options.Name = core.StringPtr(name)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateProjectOptions) SetHeaders(param map[string]string) *UpdateProjectOptions {
+	options.Headers = param
+	return options
+}
+
 // UpdateTrainingQueryOptions : The UpdateTrainingQuery options.
 type UpdateTrainingQueryOptions struct {
 
@@ -76,6 +76,192 @@ var _ = Describe(`DiscoveryV2`, func() {
 			})
 		})
 	})
+	Describe(`CreateCollection(createCollectionOptions *CreateCollectionOptions)`, func() {
+		createCollectionPath := "/v2/projects/{project_id}/collections"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		createCollectionPath = strings.Replace(createCollectionPath, "{project_id}", projectID, 1)
+		Context(`Successfully - Create a collection`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(createCollectionPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call CreateCollection`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.CreateCollection(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				createCollectionOptions := testService.NewCreateCollectionOptions(projectID)
+				result, response, operationErr = testService.CreateCollection(createCollectionOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`GetCollection(getCollectionOptions *GetCollectionOptions)`, func() {
+		getCollectionPath := "/v2/projects/{project_id}/collections/{collection_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		collectionID := "exampleString"
+		getCollectionPath = strings.Replace(getCollectionPath, "{project_id}", projectID, 1)
+		getCollectionPath = strings.Replace(getCollectionPath, "{collection_id}", collectionID, 1)
+		Context(`Successfully - Get collection`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(getCollectionPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call GetCollection`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.GetCollection(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				getCollectionOptions := testService.NewGetCollectionOptions(projectID, collectionID)
+				result, response, operationErr = testService.GetCollection(getCollectionOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`UpdateCollection(updateCollectionOptions *UpdateCollectionOptions)`, func() {
+		updateCollectionPath := "/v2/projects/{project_id}/collections/{collection_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		collectionID := "exampleString"
+		updateCollectionPath = strings.Replace(updateCollectionPath, "{project_id}", projectID, 1)
+		updateCollectionPath = strings.Replace(updateCollectionPath, "{collection_id}", collectionID, 1)
+		Context(`Successfully - Update a collection`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(updateCollectionPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call UpdateCollection`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.UpdateCollection(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				updateCollectionOptions := testService.NewUpdateCollectionOptions(projectID, collectionID)
+				result, response, operationErr = testService.UpdateCollection(updateCollectionOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions)`, func() {
+		deleteCollectionPath := "/v2/projects/{project_id}/collections/{collection_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		collectionID := "exampleString"
+		deleteCollectionPath = strings.Replace(deleteCollectionPath, "{project_id}", projectID, 1)
+		deleteCollectionPath = strings.Replace(deleteCollectionPath, "{collection_id}", collectionID, 1)
+		Context(`Successfully - Delete a collection`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(deleteCollectionPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("DELETE"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+			}))
+			It(`Succeed to call DeleteCollection`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				response, operationErr := testService.DeleteCollection(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				deleteCollectionOptions := testService.NewDeleteCollectionOptions(projectID, collectionID)
+				response, operationErr = testService.DeleteCollection(deleteCollectionOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+			})
+		})
+	})
 	Describe(`Query(queryOptions *QueryOptions)`, func() {
 		queryPath := "/v2/projects/{project_id}/query"
 		version := "exampleString"
@@ -701,4 +887,462 @@ var _ = Describe(`DiscoveryV2`, func() {
 			})
 		})
 	})
+	Describe(`ListEnrichments(listEnrichmentsOptions *ListEnrichmentsOptions)`, func() {
+		listEnrichmentsPath := "/v2/projects/{project_id}/enrichments"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		listEnrichmentsPath = strings.Replace(listEnrichmentsPath, "{project_id}", projectID, 1)
+		Context(`Successfully - List Enrichments`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(listEnrichmentsPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call ListEnrichments`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.ListEnrichments(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				listEnrichmentsOptions := testService.NewListEnrichmentsOptions(projectID)
+				result, response, operationErr = testService.ListEnrichments(listEnrichmentsOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`CreateEnrichment(createEnrichmentOptions *CreateEnrichmentOptions)`, func() {
+		createEnrichmentPath := "/v2/projects/{project_id}/enrichments"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		enrichment := &discoveryv2.CreateEnrichment{}
+		createEnrichmentPath = strings.Replace(createEnrichmentPath, "{project_id}", projectID, 1)
+		Context(`Successfully - Create an enrichment`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(createEnrichmentPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call CreateEnrichment`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.CreateEnrichment(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				createEnrichmentOptions := testService.NewCreateEnrichmentOptions(projectID, enrichment)
+				result, response, operationErr = testService.CreateEnrichment(createEnrichmentOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`GetEnrichment(getEnrichmentOptions *GetEnrichmentOptions)`, func() {
+		getEnrichmentPath := "/v2/projects/{project_id}/enrichments/{enrichment_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		enrichmentID := "exampleString"
+		getEnrichmentPath = strings.Replace(getEnrichmentPath, "{project_id}", projectID, 1)
+		getEnrichmentPath = strings.Replace(getEnrichmentPath, "{enrichment_id}", enrichmentID, 1)
+		Context(`Successfully - Get enrichment`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(getEnrichmentPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call GetEnrichment`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.GetEnrichment(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				getEnrichmentOptions := testService.NewGetEnrichmentOptions(projectID, enrichmentID)
+				result, response, operationErr = testService.GetEnrichment(getEnrichmentOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`UpdateEnrichment(updateEnrichmentOptions *UpdateEnrichmentOptions)`, func() {
+		updateEnrichmentPath := "/v2/projects/{project_id}/enrichments/{enrichment_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		enrichmentID := "exampleString"
+		name := "exampleString"
+		updateEnrichmentPath = strings.Replace(updateEnrichmentPath, "{project_id}", projectID, 1)
+		updateEnrichmentPath = strings.Replace(updateEnrichmentPath, "{enrichment_id}", enrichmentID, 1)
+		Context(`Successfully - Update an enrichment`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(updateEnrichmentPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call UpdateEnrichment`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.UpdateEnrichment(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				updateEnrichmentOptions := testService.NewUpdateEnrichmentOptions(projectID, enrichmentID, name)
+				result, response, operationErr = testService.UpdateEnrichment(updateEnrichmentOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`DeleteEnrichment(deleteEnrichmentOptions *DeleteEnrichmentOptions)`, func() {
+		deleteEnrichmentPath := "/v2/projects/{project_id}/enrichments/{enrichment_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		enrichmentID := "exampleString"
+		deleteEnrichmentPath = strings.Replace(deleteEnrichmentPath, "{project_id}", projectID, 1)
+		deleteEnrichmentPath = strings.Replace(deleteEnrichmentPath, "{enrichment_id}", enrichmentID, 1)
+		Context(`Successfully - Delete an enrichment`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(deleteEnrichmentPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("DELETE"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+			}))
+			It(`Succeed to call DeleteEnrichment`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				response, operationErr := testService.DeleteEnrichment(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				deleteEnrichmentOptions := testService.NewDeleteEnrichmentOptions(projectID, enrichmentID)
+				response, operationErr = testService.DeleteEnrichment(deleteEnrichmentOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`ListProjects(listProjectsOptions *ListProjectsOptions)`, func() {
+		listProjectsPath := "/v2/projects"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		Context(`Successfully - List projects`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(listProjectsPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call ListProjects`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.ListProjects(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				listProjectsOptions := testService.NewListProjectsOptions()
+				result, response, operationErr = testService.ListProjects(listProjectsOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`CreateProject(createProjectOptions *CreateProjectOptions)`, func() {
+		createProjectPath := "/v2/projects"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		name := "exampleString"
+		typeVar := "exampleString"
+		Context(`Successfully - Create a Project`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(createProjectPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call CreateProject`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.CreateProject(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				createProjectOptions := testService.NewCreateProjectOptions(name, typeVar)
+				result, response, operationErr = testService.CreateProject(createProjectOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`GetProject(getProjectOptions *GetProjectOptions)`, func() {
+		getProjectPath := "/v2/projects/{project_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		getProjectPath = strings.Replace(getProjectPath, "{project_id}", projectID, 1)
+		Context(`Successfully - Get project`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(getProjectPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call GetProject`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.GetProject(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				getProjectOptions := testService.NewGetProjectOptions(projectID)
+				result, response, operationErr = testService.GetProject(getProjectOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`UpdateProject(updateProjectOptions *UpdateProjectOptions)`, func() {
+		updateProjectPath := "/v2/projects/{project_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		updateProjectPath = strings.Replace(updateProjectPath, "{project_id}", projectID, 1)
+		Context(`Successfully - Update a project`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(updateProjectPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call UpdateProject`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				result, response, operationErr := testService.UpdateProject(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				updateProjectOptions := testService.NewUpdateProjectOptions(projectID)
+				result, response, operationErr = testService.UpdateProject(updateProjectOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+	Describe(`DeleteProject(deleteProjectOptions *DeleteProjectOptions)`, func() {
+		deleteProjectPath := "/v2/projects/{project_id}"
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		projectID := "exampleString"
+		deleteProjectPath = strings.Replace(deleteProjectPath, "{project_id}", projectID, 1)
+		Context(`Successfully - Delete a project`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(deleteProjectPath))
+				Expect(req.URL.Query()["version"]).To(Equal([]string{version}))
+				Expect(req.Method).To(Equal("DELETE"))
+				Expect(req.Header["Authorization"]).ToNot(BeNil())
+				Expect(req.Header["Authorization"][0]).To(Equal("Bearer " + bearerToken))
+			}))
+			It(`Succeed to call DeleteProject`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := discoveryv2.NewDiscoveryV2(&discoveryv2.DiscoveryV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				// Pass empty options
+				response, operationErr := testService.DeleteProject(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				deleteProjectOptions := testService.NewDeleteProjectOptions(projectID)
+				response, operationErr = testService.DeleteProject(deleteProjectOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+			})
+		})
+	})
 })
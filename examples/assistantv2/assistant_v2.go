@@ -41,7 +41,7 @@ func main() {
 	// 	/* MESSAGE */
 
 	// Call the assistant Message method
-	_, response, responseErr := service.
+	messageResult, _, responseErr := service.
 		Message(&assistantv2.MessageOptions{
 			AssistantID: core.StringPtr(assistantID),
 			SessionID:   sessionID,
@@ -62,7 +62,7 @@ func main() {
 		panic(responseErr)
 	}
 
-	core.PrettyPrint(response.GetResult(), "Message")
+	core.PrettyPrint(messageResult, "Message")
 
 	// 	/* DELETE SESSION */
 
@@ -63,7 +63,7 @@ func main() {
 		SetFile(file).
 		SetMetadata("{\"Creator\": \"Johnny Appleseed\", \"Subject\": \"Apples\" }")
 
-	_, response, responseErr = service.AddDocument(addDocumentOptions)
+	addDocumentResult, _, responseErr := service.AddDocument(addDocumentOptions)
 
 	if responseErr != nil {
 		panic(responseErr)
@@ -71,7 +71,7 @@ func main() {
 
 	defer file.Close()
 
-	core.PrettyPrint(response.GetResult(), "Add document: ")
+	core.PrettyPrint(addDocumentResult, "Add document: ")
 
 	/* QUERY */
 
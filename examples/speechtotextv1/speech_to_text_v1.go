@@ -56,7 +56,8 @@ func main() {
 	// Example using websockets!
 	audio, _ = os.Open(pwd + "/../../resources/audio_example.mp3")
 
-	// callbook can have `OnOpen`, `onData`, `OnClose` and `onError` functions
+	// callbook can have `OnOpen`, `onData`, `OnClose` and `onError` functions, and
+	// optionally `OnListening` and `OnInterimResult` when it implements RecognizeCallback
 	callback := myCallBack{}
 
 	recognizeUsingWebsocketOptions := service.
@@ -71,7 +72,9 @@ func main() {
 	service.RecognizeUsingWebsocket(recognizeUsingWebsocketOptions, callback)
 }
 
-type myCallBack struct{}
+type myCallBack struct {
+	speechtotextv1.BaseRecognizeCallback
+}
 
 func (cb myCallBack) OnOpen() {
 	fmt.Println("Handshake successful")
@@ -88,6 +91,10 @@ func (cb myCallBack) OnData(resp *core.DetailedResponse) {
 	core.PrettyPrint(speechResults, "Recognized audio: ")
 }
 
+func (cb myCallBack) OnInterimResult(results *speechtotextv1.SpeechRecognitionResults) {
+	core.PrettyPrint(results, "Interim results: ")
+}
+
 func (cb myCallBack) OnError(err error) {
 	panic(err)
 }
@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languagetranslatorv3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// DEFAULT_DOCUMENT_POLL_INTERVAL is the interval at which WaitForDocument checks the status of a document by default.
+const DEFAULT_DOCUMENT_POLL_INTERVAL = 2 * time.Second
+
+// WaitForDocumentOptions : Options that control how the translation of a document is awaited
+type WaitForDocumentOptions struct {
+
+	// The interval at which the status of the document is checked. Defaults to DEFAULT_DOCUMENT_POLL_INTERVAL.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the document to be translated. Zero means no limit.
+	Timeout time.Duration
+}
+
+// NewWaitForDocumentOptions : Instantiate WaitForDocumentOptions
+func (languageTranslator *LanguageTranslatorV3) NewWaitForDocumentOptions() *WaitForDocumentOptions {
+	return &WaitForDocumentOptions{
+		PollInterval: DEFAULT_DOCUMENT_POLL_INTERVAL,
+	}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (options *WaitForDocumentOptions) SetPollInterval(pollInterval time.Duration) *WaitForDocumentOptions {
+	options.PollInterval = pollInterval
+	return options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (options *WaitForDocumentOptions) SetTimeout(timeout time.Duration) *WaitForDocumentOptions {
+	options.Timeout = timeout
+	return options
+}
+
+// WaitForDocument : Waits until a document that was submitted has been translated, and returns its status.
+// The document is translated when its status is `available`. An error is returned if its status is `failed` or the
+// wait times out. The waitForDocumentOptions can be nil.
+func (languageTranslator *LanguageTranslatorV3) WaitForDocument(getDocumentStatusOptions *GetDocumentStatusOptions, waitForDocumentOptions *WaitForDocumentOptions) (*DocumentStatus, error) {
+	return languageTranslator.WaitForDocumentWithContext(context.Background(), getDocumentStatusOptions, waitForDocumentOptions)
+}
+
+// WaitForDocumentWithContext is an alternate form of the WaitForDocument method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) WaitForDocumentWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions, waitForDocumentOptions *WaitForDocumentOptions) (*DocumentStatus, error) {
+	if waitForDocumentOptions == nil {
+		waitForDocumentOptions = languageTranslator.NewWaitForDocumentOptions()
+	}
+	pollInterval := waitForDocumentOptions.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DEFAULT_DOCUMENT_POLL_INTERVAL
+	}
+
+	var status *DocumentStatus
+	var state string
+	err := common.Poll(ctx, pollInterval, waitForDocumentOptions.Timeout, func() (bool, error) {
+		var err error
+		status, _, err = languageTranslator.GetDocumentStatus(getDocumentStatusOptions)
+		if err != nil {
+			return false, err
+		}
+
+		state = ""
+		if status.Status != nil {
+			state = *status.Status
+		}
+		switch state {
+		case DocumentStatus_Status_Available:
+			return true, nil
+		case DocumentStatus_Status_Failed:
+			return true, fmt.Errorf("Translation of document %s failed", *getDocumentStatusOptions.DocumentID)
+		}
+		return false, nil
+	})
+	if err == common.ErrPollTimeout {
+		err = fmt.Errorf("Timed out waiting for document %s, which is %s", *getDocumentStatusOptions.DocumentID, state)
+	}
+	return status, err
+}
+
+// TranslateDocumentToWriter : Translates a document and streams the translated document to a writer
+// Submits the document, waits until it has been translated, and copies the translated document to the writer as it is
+// downloaded. The translated document is requested in the FileContentType of the submitted document, if it is set. The
+// document is kept by the service and can be removed with DeleteDocument. The waitForDocumentOptions can be nil.
+func (languageTranslator *LanguageTranslatorV3) TranslateDocumentToWriter(translateDocumentOptions *TranslateDocumentOptions, waitForDocumentOptions *WaitForDocumentOptions, writer io.Writer) (status *DocumentStatus, written int64, err error) {
+	status, _, err = languageTranslator.TranslateDocument(translateDocumentOptions)
+	if err != nil {
+		return
+	}
+
+	getDocumentStatusOptions := languageTranslator.NewGetDocumentStatusOptions(*status.DocumentID).
+		SetHeaders(translateDocumentOptions.Headers)
+	status, err = languageTranslator.WaitForDocument(getDocumentStatusOptions, waitForDocumentOptions)
+	if err != nil {
+		return
+	}
+
+	getTranslatedDocumentOptions := languageTranslator.NewGetTranslatedDocumentOptions(*status.DocumentID).
+		SetHeaders(translateDocumentOptions.Headers)
+	getTranslatedDocumentOptions.Accept = translateDocumentOptions.FileContentType
+	translated, _, err := languageTranslator.GetTranslatedDocument(getTranslatedDocumentOptions)
+	if err != nil {
+		return
+	}
+	defer translated.Close()
+
+	written, err = io.Copy(writer, translated)
+	return
+}
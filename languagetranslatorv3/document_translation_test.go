@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languagetranslatorv3_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/languagetranslatorv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DocumentTranslation`, func() {
+	Describe(`TranslateDocumentToWriter(translateDocumentOptions *TranslateDocumentOptions, waitForDocumentOptions *WaitForDocumentOptions, writer io.Writer)`, func() {
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		documentStatus := `{"document_id": "doc1", "filename": "transcript.txt", "status": "%s", "model_id": "en-de",
+			"source": "en", "target": "de", "created": "2019-01-01T00:00:00Z"}`
+		newTestService := func(url string) *languagetranslatorv3.LanguageTranslatorV3 {
+			testService, testServiceErr := languagetranslatorv3.NewLanguageTranslatorV3(&languagetranslatorv3.LanguageTranslatorV3Options{
+				URL:     url,
+				Version: version,
+				Authenticator: &core.BearerTokenAuthenticator{
+					BearerToken: bearerToken,
+				},
+			})
+			Expect(testServiceErr).To(BeNil())
+			return testService
+		}
+		Context(`Successfully - Translate a document`, func() {
+			polls := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				switch req.URL.Path {
+				case "/v3/documents":
+					Expect(req.Method).To(Equal("POST"))
+					Expect(req.ParseMultipartForm(1024)).To(Succeed())
+					Expect(req.FormValue("model_id")).To(Equal("en-de"))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(http.StatusAccepted)
+					fmt.Fprintf(res, documentStatus, "processing")
+				case "/v3/documents/doc1":
+					polls++
+					status := "processing"
+					if polls == 2 {
+						status = "available"
+					}
+					res.Header().Set("Content-type", "application/json")
+					fmt.Fprintf(res, documentStatus, status)
+				default:
+					Expect(req.URL.Path).To(Equal("/v3/documents/doc1/translated_document"))
+					Expect(req.Header.Get("Accept")).To(Equal("text/plain"))
+					res.Header().Set("Content-type", "text/plain")
+					fmt.Fprint(res, "Hallo Welt")
+				}
+			}))
+			It(`Succeed to call TranslateDocumentToWriter`, func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				translateDocumentOptions := testService.NewTranslateDocumentOptions(ioutil.NopCloser(strings.NewReader("Hello world")), "transcript.txt").
+					SetFileContentType("text/plain").
+					SetModelID("en-de")
+				waitForDocumentOptions := testService.NewWaitForDocumentOptions().SetPollInterval(time.Millisecond)
+				var translated bytes.Buffer
+				status, written, err := testService.TranslateDocumentToWriter(translateDocumentOptions, waitForDocumentOptions, &translated)
+				Expect(err).To(BeNil())
+				Expect(*status.Status).To(Equal(languagetranslatorv3.DocumentStatus_Status_Available))
+				Expect(written).To(Equal(int64(len("Hallo Welt"))))
+				Expect(translated.String()).To(Equal("Hallo Welt"))
+				Expect(polls).To(Equal(2))
+			})
+		})
+		Context(`Unsuccessfully - Translate a document`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).ToNot(HaveSuffix("/translated_document"))
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == "POST" {
+					res.WriteHeader(http.StatusAccepted)
+					fmt.Fprintf(res, documentStatus, "processing")
+					return
+				}
+				fmt.Fprintf(res, documentStatus, "failed")
+			}))
+			It(`Fail to call TranslateDocumentToWriter`, func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				translateDocumentOptions := testService.NewTranslateDocumentOptions(ioutil.NopCloser(strings.NewReader("Hello world")), "transcript.txt").
+					SetTarget("de")
+				var translated bytes.Buffer
+				status, written, err := testService.TranslateDocumentToWriter(translateDocumentOptions, nil, &translated)
+				Expect(err).ToNot(BeNil())
+				Expect(*status.Status).To(Equal(languagetranslatorv3.DocumentStatus_Status_Failed))
+				Expect(written).To(BeZero())
+			})
+		})
+	})
+	Describe(`WaitForDocumentWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions, waitForDocumentOptions *WaitForDocumentOptions)`, func() {
+		Context(`Unsuccessfully - Wait for a document without a status`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"document_id": "doc1", "filename": "transcript.txt"}`)
+			}))
+			It(`Fail to call WaitForDocumentWithContext`, func() {
+				defer testServer.Close()
+				testService, testServiceErr := languagetranslatorv3.NewLanguageTranslatorV3(&languagetranslatorv3.LanguageTranslatorV3Options{
+					URL:     testServer.URL,
+					Version: "exampleString",
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: "0ui9876453",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				getDocumentStatusOptions := testService.NewGetDocumentStatusOptions("doc1")
+				waitForDocumentOptions := testService.NewWaitForDocumentOptions().
+					SetPollInterval(time.Millisecond).
+					SetTimeout(20 * time.Millisecond)
+				status, err := testService.WaitForDocumentWithContext(context.Background(), getDocumentStatusOptions, waitForDocumentOptions)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("Timed out waiting for document doc1"))
+				Expect(status.Status).To(BeNil())
+			})
+		})
+	})
+})
@@ -18,11 +18,13 @@
 package languagetranslatorv3
 
 import (
+	"context"
 	"fmt"
 	"github.com/edwindvinas/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 )
 
 // LanguageTranslatorV3 : IBM Watson&trade; Language Translator translates text from one language to another. The
@@ -44,6 +46,7 @@ type LanguageTranslatorV3Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewLanguageTranslatorV3 : Instantiate LanguageTranslatorV3
@@ -58,7 +61,7 @@ func NewLanguageTranslatorV3(options *LanguageTranslatorV3Options) (service *Lan
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("language_translator")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("language_translator")
 		if err != nil {
 			return
 		}
@@ -69,6 +72,10 @@ func NewLanguageTranslatorV3(options *LanguageTranslatorV3Options) (service *Lan
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &LanguageTranslatorV3{
 		Service: baseService,
 		Version: options.Version,
@@ -90,6 +97,11 @@ func (languageTranslator *LanguageTranslatorV3) DisableSSLVerification() {
 // Translate : Translate
 // Translates the input text from the source language to the target language.
 func (languageTranslator *LanguageTranslatorV3) Translate(translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error) {
+	return languageTranslator.TranslateWithContext(context.Background(), translateOptions)
+}
+
+// TranslateWithContext is an alternate form of the Translate method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) TranslateWithContext(ctx context.Context, translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(translateOptions, "translateOptions cannot be nil")
 	if err != nil {
 		return
@@ -143,8 +155,10 @@ func (languageTranslator *LanguageTranslatorV3) Translate(translateOptions *Tran
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = languageTranslator.Service.Request(request, new(TranslationResult))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TranslationResult)
@@ -192,6 +206,7 @@ func (languageTranslator *LanguageTranslatorV3) ListIdentifiableLanguages(listId
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(IdentifiableLanguages))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*IdentifiableLanguages)
@@ -248,6 +263,7 @@ func (languageTranslator *LanguageTranslatorV3) Identify(identifyOptions *Identi
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(IdentifiedLanguages))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*IdentifiedLanguages)
@@ -304,6 +320,7 @@ func (languageTranslator *LanguageTranslatorV3) ListModels(listModelsOptions *Li
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(TranslationModels))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TranslationModels)
@@ -369,11 +386,19 @@ func (languageTranslator *LanguageTranslatorV3) CreateModel(createModelOptions *
 	builder.AddQuery("version", languageTranslator.Version)
 
 	if createModelOptions.ForcedGlossary != nil {
-		builder.AddFormData("forced_glossary", "filename",
+		forcedGlossaryFilename := "filename"
+		if createModelOptions.ForcedGlossaryFilename != nil {
+			forcedGlossaryFilename = *createModelOptions.ForcedGlossaryFilename
+		}
+		builder.AddFormData("forced_glossary", forcedGlossaryFilename,
 			"application/octet-stream", createModelOptions.ForcedGlossary)
 	}
 	if createModelOptions.ParallelCorpus != nil {
-		builder.AddFormData("parallel_corpus", "filename",
+		parallelCorpusFilename := "filename"
+		if createModelOptions.ParallelCorpusFilename != nil {
+			parallelCorpusFilename = *createModelOptions.ParallelCorpusFilename
+		}
+		builder.AddFormData("parallel_corpus", parallelCorpusFilename,
 			"application/octet-stream", createModelOptions.ParallelCorpus)
 	}
 
@@ -383,6 +408,7 @@ func (languageTranslator *LanguageTranslatorV3) CreateModel(createModelOptions *
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(TranslationModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TranslationModel)
@@ -433,6 +459,7 @@ func (languageTranslator *LanguageTranslatorV3) DeleteModel(deleteModelOptions *
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(DeleteModelResult))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteModelResult)
@@ -484,6 +511,7 @@ func (languageTranslator *LanguageTranslatorV3) GetModel(getModelOptions *GetMod
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(TranslationModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TranslationModel)
@@ -530,6 +558,7 @@ func (languageTranslator *LanguageTranslatorV3) ListDocuments(listDocumentsOptio
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(DocumentList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentList)
@@ -596,6 +625,7 @@ func (languageTranslator *LanguageTranslatorV3) TranslateDocument(translateDocum
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(DocumentStatus))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentStatus)
@@ -646,6 +676,7 @@ func (languageTranslator *LanguageTranslatorV3) GetDocumentStatus(getDocumentSta
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(DocumentStatus))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentStatus)
@@ -695,6 +726,7 @@ func (languageTranslator *LanguageTranslatorV3) DeleteDocument(deleteDocumentOpt
 	}
 
 	response, err = languageTranslator.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -741,6 +773,7 @@ func (languageTranslator *LanguageTranslatorV3) GetTranslatedDocument(getTransla
 	}
 
 	response, err = languageTranslator.Service.Request(request, new(io.ReadCloser))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
@@ -770,6 +803,13 @@ type CreateModelOptions struct {
 	// sentences to train successfully.
 	ParallelCorpus io.ReadCloser `json:"parallel_corpus,omitempty"`
 
+	// The filename for forcedGlossary. The service detects the format of the glossary, such as TMX, XLSX, CSV, or TSV,
+	// from the extension of the filename.
+	ForcedGlossaryFilename *string `json:"forced_glossary_filename,omitempty"`
+
+	// The filename for parallelCorpus. The service detects the format of the corpus from the extension of the filename.
+	ParallelCorpusFilename *string `json:"parallel_corpus_filename,omitempty"`
+
 	// An optional model name that you can use to identify the model. Valid characters are letters, numbers, dashes,
 	// underscores, spaces and apostrophes. The maximum length is 32 characters.
 	Name *string `json:"name,omitempty"`
@@ -803,6 +843,18 @@ func (options *CreateModelOptions) SetParallelCorpus(parallelCorpus io.ReadClose
 	return options
 }
 
+// SetForcedGlossaryFilename : Allow user to set ForcedGlossaryFilename
+func (options *CreateModelOptions) SetForcedGlossaryFilename(forcedGlossaryFilename string) *CreateModelOptions {
+	options.ForcedGlossaryFilename = core.StringPtr(forcedGlossaryFilename)
+	return options
+}
+
+// SetParallelCorpusFilename : Allow user to set ParallelCorpusFilename
+func (options *CreateModelOptions) SetParallelCorpusFilename(parallelCorpusFilename string) *CreateModelOptions {
+	options.ParallelCorpusFilename = core.StringPtr(parallelCorpusFilename)
+	return options
+}
+
 // SetName : Allow user to set Name
 func (options *CreateModelOptions) SetName(name string) *CreateModelOptions {
 	options.Name = core.StringPtr(name)
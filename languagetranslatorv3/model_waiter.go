@@ -0,0 +1,101 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languagetranslatorv3
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// DEFAULT_MODEL_POLL_INTERVAL is the interval at which WaitForModel checks the status of a model by default.
+const DEFAULT_MODEL_POLL_INTERVAL = 10 * time.Second
+
+// WaitForModelOptions : Options that control how the training of a custom model is awaited
+type WaitForModelOptions struct {
+
+	// The interval at which the status of the model is checked. Defaults to DEFAULT_MODEL_POLL_INTERVAL.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the model to be trained. Zero means no limit.
+	Timeout time.Duration
+}
+
+// NewWaitForModelOptions : Instantiate WaitForModelOptions
+func (languageTranslator *LanguageTranslatorV3) NewWaitForModelOptions() *WaitForModelOptions {
+	return &WaitForModelOptions{
+		PollInterval: DEFAULT_MODEL_POLL_INTERVAL,
+	}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (options *WaitForModelOptions) SetPollInterval(pollInterval time.Duration) *WaitForModelOptions {
+	options.PollInterval = pollInterval
+	return options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (options *WaitForModelOptions) SetTimeout(timeout time.Duration) *WaitForModelOptions {
+	options.Timeout = timeout
+	return options
+}
+
+// WaitForModel : Waits until a custom model that was created has been trained, and returns it.
+// The model is trained when its status is `available`. An error is returned if its status is `error` or `deleted`, or
+// the wait times out. The waitForModelOptions can be nil.
+func (languageTranslator *LanguageTranslatorV3) WaitForModel(getModelOptions *GetModelOptions, waitForModelOptions *WaitForModelOptions) (*TranslationModel, error) {
+	return languageTranslator.WaitForModelWithContext(context.Background(), getModelOptions, waitForModelOptions)
+}
+
+// WaitForModelWithContext is an alternate form of the WaitForModel method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) WaitForModelWithContext(ctx context.Context, getModelOptions *GetModelOptions, waitForModelOptions *WaitForModelOptions) (*TranslationModel, error) {
+	if waitForModelOptions == nil {
+		waitForModelOptions = languageTranslator.NewWaitForModelOptions()
+	}
+	pollInterval := waitForModelOptions.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DEFAULT_MODEL_POLL_INTERVAL
+	}
+
+	var model *TranslationModel
+	var status string
+	err := common.Poll(ctx, pollInterval, waitForModelOptions.Timeout, func() (bool, error) {
+		var err error
+		model, _, err = languageTranslator.GetModel(getModelOptions)
+		if err != nil {
+			return false, err
+		}
+
+		status = ""
+		if model.Status != nil {
+			status = *model.Status
+		}
+		switch status {
+		case TranslationModel_Status_Available:
+			return true, nil
+		case TranslationModel_Status_Error, TranslationModel_Status_Deleted:
+			return true, fmt.Errorf("Model %s could not be trained, its status is %s", *getModelOptions.ModelID, status)
+		}
+		return false, nil
+	})
+	if err == common.ErrPollTimeout {
+		err = fmt.Errorf("Timed out waiting for model %s, which is %s", *getModelOptions.ModelID, status)
+	}
+	return model, err
+}
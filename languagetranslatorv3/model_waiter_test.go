@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languagetranslatorv3_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/languagetranslatorv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ModelWaiter`, func() {
+	Describe(`WaitForModel(getModelOptions *GetModelOptions, waitForModelOptions *WaitForModelOptions)`, func() {
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		newTestService := func(url string) *languagetranslatorv3.LanguageTranslatorV3 {
+			testService, testServiceErr := languagetranslatorv3.NewLanguageTranslatorV3(&languagetranslatorv3.LanguageTranslatorV3Options{
+				URL:     url,
+				Version: version,
+				Authenticator: &core.BearerTokenAuthenticator{
+					BearerToken: bearerToken,
+				},
+			})
+			Expect(testServiceErr).To(BeNil())
+			return testService
+		}
+		Context(`Successfully - Create a model with a glossary and wait for it`, func() {
+			polls := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == "POST" {
+					Expect(req.URL.Path).To(Equal("/v3/models"))
+					Expect(req.ParseMultipartForm(1024)).To(Succeed())
+					_, header, err := req.FormFile("forced_glossary")
+					Expect(err).To(BeNil())
+					Expect(header.Filename).To(Equal("glossary.csv"))
+					fmt.Fprint(res, `{"model_id": "custom", "base_model_id": "en-de", "status": "dispatching"}`)
+					return
+				}
+
+				Expect(req.URL.Path).To(Equal("/v3/models/custom"))
+				polls++
+				status := "training"
+				if polls == 3 {
+					status = "available"
+				}
+				fmt.Fprintf(res, `{"model_id": "custom", "base_model_id": "en-de", "status": "%s"}`, status)
+			}))
+			It(`Succeed to call WaitForModel`, func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				createModelOptions := testService.NewCreateModelOptions("en-de").
+					SetForcedGlossary(ioutil.NopCloser(strings.NewReader("hello,hallo"))).
+					SetForcedGlossaryFilename("glossary.csv")
+				model, _, err := testService.CreateModel(createModelOptions)
+				Expect(err).To(BeNil())
+
+				waitForModelOptions := testService.NewWaitForModelOptions().SetPollInterval(time.Millisecond)
+				model, err = testService.WaitForModel(testService.NewGetModelOptions(*model.ModelID), waitForModelOptions)
+				Expect(err).To(BeNil())
+				Expect(*model.Status).To(Equal(languagetranslatorv3.TranslationModel_Status_Available))
+				Expect(polls).To(Equal(3))
+			})
+		})
+		Context(`Unsuccessfully - Wait for a model`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				status := "training"
+				if strings.HasSuffix(req.URL.Path, "/failed") {
+					status = "error"
+				}
+				fmt.Fprintf(res, `{"model_id": "%s", "status": "%s"}`, strings.TrimPrefix(req.URL.Path, "/v3/models/"), status)
+			}))
+			It(`Fail to call WaitForModel`, func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				model, err := testService.WaitForModel(testService.NewGetModelOptions("failed"), nil)
+				Expect(err).ToNot(BeNil())
+				Expect(*model.Status).To(Equal(languagetranslatorv3.TranslationModel_Status_Error))
+
+				waitForModelOptions := testService.NewWaitForModelOptions().
+					SetPollInterval(time.Millisecond).
+					SetTimeout(10 * time.Millisecond)
+				model, err = testService.WaitForModel(testService.NewGetModelOptions("training"), waitForModelOptions)
+				Expect(err).ToNot(BeNil())
+				Expect(*model.Status).To(Equal(languagetranslatorv3.TranslationModel_Status_Training))
+			})
+		})
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 )
 
 // NaturalLanguageClassifierV1 : IBM Watson&trade; Natural Language Classifier uses machine learning algorithms to
@@ -41,6 +42,7 @@ const defaultServiceURL = "https://gateway.watsonplatform.net/natural-language-c
 type NaturalLanguageClassifierV1Options struct {
 	URL           string
 	Authenticator core.Authenticator
+	HTTPClient    *http.Client
 }
 
 // NewNaturalLanguageClassifierV1 : Instantiate NaturalLanguageClassifierV1
@@ -55,7 +57,7 @@ func NewNaturalLanguageClassifierV1(options *NaturalLanguageClassifierV1Options)
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("natural_language_classifier")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("natural_language_classifier")
 		if err != nil {
 			return
 		}
@@ -66,6 +68,10 @@ func NewNaturalLanguageClassifierV1(options *NaturalLanguageClassifierV1Options)
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &NaturalLanguageClassifierV1{
 		Service: baseService,
 	}
@@ -132,6 +138,7 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) Classify(classifyO
 	}
 
 	response, err = naturalLanguageClassifier.Service.Request(request, new(Classification))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classification)
@@ -194,6 +201,7 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ClassifyCollection
 	}
 
 	response, err = naturalLanguageClassifier.Service.Request(request, new(ClassificationCollection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ClassificationCollection)
@@ -248,6 +256,7 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) CreateClassifier(c
 	}
 
 	response, err = naturalLanguageClassifier.Service.Request(request, new(Classifier))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -293,6 +302,7 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ListClassifiers(li
 	}
 
 	response, err = naturalLanguageClassifier.Service.Request(request, new(ClassifierList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ClassifierList)
@@ -342,6 +352,7 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) GetClassifier(getC
 	}
 
 	response, err = naturalLanguageClassifier.Service.Request(request, new(Classifier))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -390,6 +401,7 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) DeleteClassifier(d
 	}
 
 	response, err = naturalLanguageClassifier.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
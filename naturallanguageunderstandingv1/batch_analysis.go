@@ -0,0 +1,212 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package naturallanguageunderstandingv1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+const (
+	DEFAULT_BATCH_CONCURRENCY = 4
+	DEFAULT_BATCH_MAX_RETRIES = 2
+	DEFAULT_BATCH_RETRY_DELAY = time.Second
+)
+
+// AnalysisInput : A text of a batch, which is identified by its ID in the results
+type AnalysisInput struct {
+
+	// The ID of the text, which must be unique within the batch.
+	ID string
+
+	// The plain text to analyze.
+	Text string
+}
+
+// BatchAnalysisOptions : Options that control how a batch of texts is analyzed
+type BatchAnalysisOptions struct {
+
+	// The number of texts that are analyzed at the same time. Defaults to 4.
+	Concurrency int
+
+	// The number of times a failed analysis is retried. Only transport failures and responses with status code 429 or
+	// 5xx are retried. Defaults to 2. Set it to common.NO_RETRIES to disable retries.
+	MaxRetries int
+
+	// The delay before the first retry of a text. The delay doubles for each further retry. A response with status code
+	// 429 and a Retry-After header is retried after the delay that the service asks for. Defaults to one second.
+	RetryDelay time.Duration
+
+	// The minimum interval between two analysis requests across all workers. Zero means no rate limit.
+	MinRequestInterval time.Duration
+
+	// The parameters of the analysis requests, such as the features and the language. Text is replaced by each text
+	// of the batch.
+	AnalyzeOptions *AnalyzeOptions
+}
+
+// NewBatchAnalysisOptions : Instantiate BatchAnalysisOptions with the default values
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) NewBatchAnalysisOptions(features *Features) *BatchAnalysisOptions {
+	return &BatchAnalysisOptions{
+		Concurrency:    DEFAULT_BATCH_CONCURRENCY,
+		MaxRetries:     DEFAULT_BATCH_MAX_RETRIES,
+		RetryDelay:     DEFAULT_BATCH_RETRY_DELAY,
+		AnalyzeOptions: naturalLanguageUnderstanding.NewAnalyzeOptions(features),
+	}
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (options *BatchAnalysisOptions) SetConcurrency(concurrency int) *BatchAnalysisOptions {
+	options.Concurrency = concurrency
+	return options
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (options *BatchAnalysisOptions) SetMaxRetries(maxRetries int) *BatchAnalysisOptions {
+	options.MaxRetries = maxRetries
+	return options
+}
+
+// SetRetryDelay : Allow user to set RetryDelay
+func (options *BatchAnalysisOptions) SetRetryDelay(retryDelay time.Duration) *BatchAnalysisOptions {
+	options.RetryDelay = retryDelay
+	return options
+}
+
+// SetMinRequestInterval : Allow user to set MinRequestInterval
+func (options *BatchAnalysisOptions) SetMinRequestInterval(minRequestInterval time.Duration) *BatchAnalysisOptions {
+	options.MinRequestInterval = minRequestInterval
+	return options
+}
+
+// SetAnalyzeOptions : Allow user to set AnalyzeOptions
+func (options *BatchAnalysisOptions) SetAnalyzeOptions(analyzeOptions *AnalyzeOptions) *BatchAnalysisOptions {
+	options.AnalyzeOptions = analyzeOptions
+	return options
+}
+
+// BatchAnalysisResult : The outcome of the analysis of one text of a batch
+type BatchAnalysisResult struct {
+
+	// The ID of the text.
+	ID string
+
+	// The results of the analysis, if it succeeded.
+	Results *AnalysisResults
+
+	// The number of analysis requests that were sent for the text.
+	Attempts int
+
+	// The error of the last attempt, if the analysis failed.
+	Err error
+}
+
+// BatchAnalysisReport : A summary of the analysis of a batch of texts
+type BatchAnalysisReport struct {
+
+	// The texts that were analyzed, by their IDs.
+	Succeeded map[string]BatchAnalysisResult
+
+	// The texts that could not be analyzed, by their IDs.
+	Failed map[string]BatchAnalysisResult
+}
+
+// AnalyzeTexts : Analyze a batch of texts concurrently
+// Sends one result for each text on the returned channel as soon as the text has been analyzed or has failed, and
+// closes the channel when every text has been processed. Cancelling the context stops the remaining texts, which are
+// reported with the error of the context. Pass the channel to CollectAnalysisReport for a summary.
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) AnalyzeTexts(ctx context.Context, inputs []AnalysisInput, options *BatchAnalysisOptions) <-chan BatchAnalysisResult {
+	options = batchOptionsWithDefaults(options)
+
+	results := make(chan BatchAnalysisResult)
+	retryOptions := common.RetryOptions{
+		MaxRetries: options.MaxRetries,
+		RetryDelay: options.RetryDelay,
+		Limiter:    common.NewIntervalRateLimiter(options.MinRequestInterval),
+	}
+	common.RunConcurrently(len(inputs), options.Concurrency, func(i int) {
+		results <- naturalLanguageUnderstanding.analyzeText(ctx, inputs[i], options, retryOptions)
+	}, func() {
+		close(results)
+	})
+
+	return results
+}
+
+// CollectAnalysisReport : Waits for every result of a batch and summarizes them
+func CollectAnalysisReport(results <-chan BatchAnalysisResult) *BatchAnalysisReport {
+	report := &BatchAnalysisReport{
+		Succeeded: make(map[string]BatchAnalysisResult),
+		Failed:    make(map[string]BatchAnalysisResult),
+	}
+	for result := range results {
+		if result.Err != nil {
+			report.Failed[result.ID] = result
+		} else {
+			report.Succeeded[result.ID] = result
+		}
+	}
+	return report
+}
+
+// analyzeText : Analyzes one text, retrying transient failures
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) analyzeText(ctx context.Context, input AnalysisInput, options *BatchAnalysisOptions, retryOptions common.RetryOptions) BatchAnalysisResult {
+	result := BatchAnalysisResult{ID: input.ID}
+	if options.AnalyzeOptions == nil || options.AnalyzeOptions.Features == nil {
+		result.Err = errors.New("the analyze options of a batch must specify the features")
+		return result
+	}
+
+	analyzeOptions := *options.AnalyzeOptions
+	analyzeOptions.Text = &input.Text
+	analyzeOptions.HTML = nil
+	analyzeOptions.URL = nil
+
+	result.Attempts, result.Err = common.Retry(ctx, retryOptions, func() (err error) {
+		result.Results, _, err = naturalLanguageUnderstanding.Analyze(&analyzeOptions)
+		return
+	})
+	return result
+}
+
+func batchOptionsWithDefaults(options *BatchAnalysisOptions) *BatchAnalysisOptions {
+	withDefaults := &BatchAnalysisOptions{
+		Concurrency: DEFAULT_BATCH_CONCURRENCY,
+		MaxRetries:  DEFAULT_BATCH_MAX_RETRIES,
+		RetryDelay:  DEFAULT_BATCH_RETRY_DELAY,
+	}
+	if options == nil {
+		return withDefaults
+	}
+	if options.Concurrency > 0 {
+		withDefaults.Concurrency = options.Concurrency
+	}
+	if options.MaxRetries > 0 {
+		withDefaults.MaxRetries = options.MaxRetries
+	} else if options.MaxRetries < 0 {
+		withDefaults.MaxRetries = 0
+	}
+	if options.RetryDelay > 0 {
+		withDefaults.RetryDelay = options.RetryDelay
+	}
+	withDefaults.MinRequestInterval = options.MinRequestInterval
+	withDefaults.AnalyzeOptions = options.AnalyzeOptions
+	return withDefaults
+}
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package naturallanguageunderstandingv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/common"
+	"github.com/edwindvinas/go-sdk/naturallanguageunderstandingv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`BatchAnalysis`, func() {
+	Describe(`AnalyzeTexts(ctx context.Context, inputs []AnalysisInput, options *BatchAnalysisOptions)`, func() {
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		Context(`Successfully - Analyze a batch of texts`, func() {
+			var mutex sync.Mutex
+			attempts := make(map[string]int)
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				var body struct {
+					Text     string                 `json:"text"`
+					Language string                 `json:"language"`
+					Features map[string]interface{} `json:"features"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body.Language).To(Equal("en"))
+				Expect(body.Features).To(HaveKey("keywords"))
+				mutex.Lock()
+				attempts[body.Text]++
+				attempt := attempts[body.Text]
+				mutex.Unlock()
+
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case body.Text == "busy" && attempt == 1:
+					res.Header().Set("Retry-After", "0")
+					res.WriteHeader(http.StatusTooManyRequests)
+					fmt.Fprint(res, `{"code": 429, "error": "Too many requests"}`)
+				case body.Text == "invalid":
+					res.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(res, `{"code": 400, "error": "not enough text for language id"}`)
+				default:
+					fmt.Fprintf(res, `{"analyzed_text": "%s", "keywords": [{"text": "%s", "relevance": 1}]}`, body.Text, body.Text)
+				}
+			}))
+			It(`Succeed to call AnalyzeTexts`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := naturallanguageunderstandingv1.NewNaturalLanguageUnderstandingV1(&naturallanguageunderstandingv1.NaturalLanguageUnderstandingV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				features := naturallanguageunderstandingv1.NewFeatures().SetKeywords(&naturallanguageunderstandingv1.KeywordsOptions{})
+				batchAnalysisOptions := testService.NewBatchAnalysisOptions(features).
+					SetConcurrency(2).
+					SetRetryDelay(time.Hour)
+				batchAnalysisOptions.AnalyzeOptions.SetLanguage("en")
+				inputs := []naturallanguageunderstandingv1.AnalysisInput{
+					{ID: "first", Text: "transcript"},
+					{ID: "second", Text: "busy"},
+					{ID: "third", Text: "invalid"},
+				}
+				results := testService.AnalyzeTexts(context.Background(), inputs, batchAnalysisOptions)
+				report := naturallanguageunderstandingv1.CollectAnalysisReport(results)
+
+				Expect(report.Succeeded).To(HaveLen(2))
+				Expect(*report.Succeeded["first"].Results.Keywords[0].Text).To(Equal("transcript"))
+				Expect(report.Succeeded["first"].Attempts).To(Equal(1))
+				Expect(*report.Succeeded["second"].Results.Keywords[0].Text).To(Equal("busy"))
+				Expect(report.Succeeded["second"].Attempts).To(Equal(2))
+
+				Expect(report.Failed).To(HaveLen(1))
+				Expect(report.Failed["third"].Attempts).To(Equal(1))
+				Expect(report.Failed["third"].Err).ToNot(BeNil())
+			})
+		})
+		Context(`Unsuccessfully - Analyze a batch of texts`, func() {
+			It(`Fail to call AnalyzeTexts without features`, func() {
+				testService, testServiceErr := naturallanguageunderstandingv1.NewNaturalLanguageUnderstandingV1(&naturallanguageunderstandingv1.NaturalLanguageUnderstandingV1Options{
+					URL:     "http://localhost",
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				inputs := []naturallanguageunderstandingv1.AnalysisInput{{ID: "first", Text: "transcript"}}
+				report := naturallanguageunderstandingv1.CollectAnalysisReport(testService.AnalyzeTexts(context.Background(), inputs, nil))
+				Expect(report.Succeeded).To(BeEmpty())
+				Expect(report.Failed["first"].Attempts).To(Equal(0))
+			})
+			It(`Fail to call AnalyzeTexts while the service is unavailable`, func() {
+				testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprint(res, `{"code": 503, "error": "Service unavailable"}`)
+				}))
+				defer testServer.Close()
+
+				testService, testServiceErr := naturallanguageunderstandingv1.NewNaturalLanguageUnderstandingV1(&naturallanguageunderstandingv1.NaturalLanguageUnderstandingV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				features := naturallanguageunderstandingv1.NewFeatures().SetKeywords(&naturallanguageunderstandingv1.KeywordsOptions{})
+				inputs := []naturallanguageunderstandingv1.AnalysisInput{{ID: "first", Text: "transcript"}}
+
+				// Zero retries means the default number of retries.
+				batchAnalysisOptions := testService.NewBatchAnalysisOptions(features).
+					SetMaxRetries(0).
+					SetRetryDelay(time.Millisecond)
+				report := naturallanguageunderstandingv1.CollectAnalysisReport(testService.AnalyzeTexts(context.Background(), inputs, batchAnalysisOptions))
+				Expect(report.Failed["first"].Attempts).To(Equal(naturallanguageunderstandingv1.DEFAULT_BATCH_MAX_RETRIES + 1))
+
+				batchAnalysisOptions.SetMaxRetries(common.NO_RETRIES)
+				report = naturallanguageunderstandingv1.CollectAnalysisReport(testService.AnalyzeTexts(context.Background(), inputs, batchAnalysisOptions))
+				Expect(report.Failed["first"].Attempts).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,84 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package naturallanguageunderstandingv1
+
+// NewFeatures : Instantiate Features without any feature
+// Each feature is requested with its setter. Passing empty options, such as &EntitiesOptions{}, requests the feature
+// with the default options of the service.
+func NewFeatures() *Features {
+	return &Features{}
+}
+
+// SetConcepts : Allow user to set Concepts
+func (features *Features) SetConcepts(concepts *ConceptsOptions) *Features {
+	features.Concepts = concepts
+	return features
+}
+
+// SetEmotion : Allow user to set Emotion
+func (features *Features) SetEmotion(emotion *EmotionOptions) *Features {
+	features.Emotion = emotion
+	return features
+}
+
+// SetEntities : Allow user to set Entities
+func (features *Features) SetEntities(entities *EntitiesOptions) *Features {
+	features.Entities = entities
+	return features
+}
+
+// SetKeywords : Allow user to set Keywords
+func (features *Features) SetKeywords(keywords *KeywordsOptions) *Features {
+	features.Keywords = keywords
+	return features
+}
+
+// SetMetadata : Allow user to set Metadata
+func (features *Features) SetMetadata(metadata *MetadataOptions) *Features {
+	features.Metadata = metadata
+	return features
+}
+
+// SetRelations : Allow user to set Relations
+func (features *Features) SetRelations(relations *RelationsOptions) *Features {
+	features.Relations = relations
+	return features
+}
+
+// SetSemanticRoles : Allow user to set SemanticRoles
+func (features *Features) SetSemanticRoles(semanticRoles *SemanticRolesOptions) *Features {
+	features.SemanticRoles = semanticRoles
+	return features
+}
+
+// SetSentiment : Allow user to set Sentiment
+func (features *Features) SetSentiment(sentiment *SentimentOptions) *Features {
+	features.Sentiment = sentiment
+	return features
+}
+
+// SetCategories : Allow user to set Categories
+func (features *Features) SetCategories(categories *CategoriesOptions) *Features {
+	features.Categories = categories
+	return features
+}
+
+// SetSyntax : Allow user to set Syntax
+func (features *Features) SetSyntax(syntax *SyntaxOptions) *Features {
+	features.Syntax = syntax
+	return features
+}
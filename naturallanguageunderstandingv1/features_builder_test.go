@@ -0,0 +1,82 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package naturallanguageunderstandingv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/naturallanguageunderstandingv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Features`, func() {
+	Describe(`Analyze(analyzeOptions *AnalyzeOptions) with built features`, func() {
+		version := "exampleString"
+		bearerToken := "0ui9876453"
+		Context(`Successfully - Analyze text with features`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				var body struct {
+					Text     string                 `json:"text"`
+					Features map[string]interface{} `json:"features"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body.Text).To(Equal("IBM is a great company."))
+				Expect(body.Features).To(HaveLen(4))
+				Expect(body.Features["entities"]).To(Equal(map[string]interface{}{"limit": 5.0, "sentiment": true}))
+				Expect(body.Features["keywords"]).To(Equal(map[string]interface{}{}))
+				Expect(body.Features).To(HaveKey("sentiment"))
+				Expect(body.Features).To(HaveKey("semantic_roles"))
+
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"language": "en", "entities": [{"type": "Company", "text": "IBM", "relevance": 0.9,
+					"sentiment": {"score": 0.4}}], "sentiment": {"document": {"score": 0.6, "label": "positive"}}}`)
+			}))
+			It(`Succeed to call Analyze`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := naturallanguageunderstandingv1.NewNaturalLanguageUnderstandingV1(&naturallanguageunderstandingv1.NaturalLanguageUnderstandingV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				features := naturallanguageunderstandingv1.NewFeatures().
+					SetEntities(&naturallanguageunderstandingv1.EntitiesOptions{Limit: core.Int64Ptr(5), Sentiment: core.BoolPtr(true)}).
+					SetKeywords(&naturallanguageunderstandingv1.KeywordsOptions{}).
+					SetSentiment(&naturallanguageunderstandingv1.SentimentOptions{}).
+					SetSemanticRoles(&naturallanguageunderstandingv1.SemanticRolesOptions{})
+				analyzeOptions := testService.NewAnalyzeOptions(features).SetText("IBM is a great company.")
+				result, _, operationErr := testService.Analyze(analyzeOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(*result.Entities[0].Text).To(Equal("IBM"))
+				Expect(*result.Entities[0].Sentiment.Score).To(Equal(0.4))
+				Expect(*result.Sentiment.Document.Label).To(Equal("positive"))
+			})
+		})
+	})
+})
@@ -22,6 +22,7 @@ import (
 	"github.com/edwindvinas/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/edwindvinas/go-sdk/common"
+	"net/http"
 )
 
 // NaturalLanguageUnderstandingV1 : Analyze various features of text content at scale. Provide text, raw HTML, or a
@@ -47,6 +48,7 @@ type NaturalLanguageUnderstandingV1Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewNaturalLanguageUnderstandingV1 : Instantiate NaturalLanguageUnderstandingV1
@@ -61,7 +63,7 @@ func NewNaturalLanguageUnderstandingV1(options *NaturalLanguageUnderstandingV1Op
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("natural-language-understanding")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("natural-language-understanding")
 		if err != nil {
 			return
 		}
@@ -72,6 +74,10 @@ func NewNaturalLanguageUnderstandingV1(options *NaturalLanguageUnderstandingV1Op
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &NaturalLanguageUnderstandingV1{
 		Service: baseService,
 		Version: options.Version,
@@ -176,6 +182,7 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) Analyze(anal
 	}
 
 	response, err = naturalLanguageUnderstanding.Service.Request(request, new(AnalysisResults))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AnalysisResults)
@@ -224,6 +231,7 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) ListModels(l
 	}
 
 	response, err = naturalLanguageUnderstanding.Service.Request(request, new(ListModelsResults))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListModelsResults)
@@ -274,6 +282,7 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) DeleteModel(
 	}
 
 	response, err = naturalLanguageUnderstanding.Service.Request(request, new(DeleteModelResults))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteModelResults)
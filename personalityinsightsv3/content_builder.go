@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package personalityinsightsv3
+
+import (
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// NewContent : Instantiate Content without any content items
+func NewContent() *Content {
+	return &Content{
+		ContentItems: []ContentItem{},
+	}
+}
+
+// AddContentItem : Allow user to add a ContentItem
+func (content *Content) AddContentItem(contentItem *ContentItem) *Content {
+	content.ContentItems = append(content.ContentItems, *contentItem)
+	return content
+}
+
+// AddText : Allow user to add a ContentItem of plain text
+func (content *Content) AddText(text string) *Content {
+	return content.AddContentItem(NewContentItem(text))
+}
+
+// NewContentItem : Instantiate ContentItem
+func NewContentItem(content string) *ContentItem {
+	return &ContentItem{
+		Content: core.StringPtr(content),
+	}
+}
+
+// SetContent : Allow user to set Content
+func (contentItem *ContentItem) SetContent(content string) *ContentItem {
+	contentItem.Content = core.StringPtr(content)
+	return contentItem
+}
+
+// SetID : Allow user to set ID
+func (contentItem *ContentItem) SetID(ID string) *ContentItem {
+	contentItem.ID = core.StringPtr(ID)
+	return contentItem
+}
+
+// SetCreated : Allow user to set Created, which is sent in milliseconds since the UNIX Epoch
+func (contentItem *ContentItem) SetCreated(created time.Time) *ContentItem {
+	contentItem.Created = core.Int64Ptr(unixMilliseconds(created))
+	return contentItem
+}
+
+// SetUpdated : Allow user to set Updated, which is sent in milliseconds since the UNIX Epoch
+func (contentItem *ContentItem) SetUpdated(updated time.Time) *ContentItem {
+	contentItem.Updated = core.Int64Ptr(unixMilliseconds(updated))
+	return contentItem
+}
+
+// SetContenttype : Allow user to set Contenttype
+func (contentItem *ContentItem) SetContenttype(contenttype string) *ContentItem {
+	contentItem.Contenttype = core.StringPtr(contenttype)
+	return contentItem
+}
+
+// SetLanguage : Allow user to set Language
+func (contentItem *ContentItem) SetLanguage(language string) *ContentItem {
+	contentItem.Language = core.StringPtr(language)
+	return contentItem
+}
+
+// SetParentid : Allow user to set Parentid
+func (contentItem *ContentItem) SetParentid(parentid string) *ContentItem {
+	contentItem.Parentid = core.StringPtr(parentid)
+	return contentItem
+}
+
+// SetReply : Allow user to set Reply
+func (contentItem *ContentItem) SetReply(reply bool) *ContentItem {
+	contentItem.Reply = core.BoolPtr(reply)
+	return contentItem
+}
+
+// SetForward : Allow user to set Forward
+func (contentItem *ContentItem) SetForward(forward bool) *ContentItem {
+	contentItem.Forward = core.BoolPtr(forward)
+	return contentItem
+}
+
+func unixMilliseconds(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
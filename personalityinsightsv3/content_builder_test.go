@@ -0,0 +1,73 @@
+package personalityinsightsv3_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/personalityinsightsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Content", func() {
+	Describe("Profile(profileOptions *ProfileOptions) with built content", func() {
+		version := "exampleString"
+		Context("Successfully - Get profile of built content", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Query().Get("consumption_preferences")).To(Equal("true"))
+				var content map[string][]map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&content)).To(Succeed())
+				Expect(content["contentItems"]).To(HaveLen(2))
+				Expect(content["contentItems"][0]).To(Equal(map[string]interface{}{"content": "First post"}))
+				Expect(content["contentItems"][1]).To(Equal(map[string]interface{}{
+					"content":     "A reply",
+					"id":          "2",
+					"created":     1546300800000.0,
+					"contenttype": "text/plain",
+					"language":    "en",
+					"parentid":    "1",
+					"reply":       true,
+				}))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"processed_language": "en", "word_count": 4}`)
+			}))
+			It("Succeed to call Profile", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := personalityinsightsv3.NewPersonalityInsightsV3(&personalityinsightsv3.PersonalityInsightsV3Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				content := personalityinsightsv3.NewContent().
+					AddText("First post").
+					AddContentItem(personalityinsightsv3.NewContentItem("A reply").
+						SetID("2").
+						SetCreated(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)).
+						SetContenttype(personalityinsightsv3.ContentItem_Contenttype_TextPlain).
+						SetLanguage(personalityinsightsv3.ContentItem_Language_En).
+						SetParentid("1").
+						SetReply(true))
+				profileOptions := testService.NewProfileOptions().
+					SetContent(content).
+					SetContentType("application/json").
+					SetConsumptionPreferences(true)
+				result, _, err := testService.Profile(profileOptions)
+				Expect(err).To(BeNil())
+				Expect(*result.WordCount).To(Equal(int64(4)))
+			})
+		})
+	})
+})
@@ -22,6 +22,7 @@ import (
 	"github.com/edwindvinas/go-sdk-core/core"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 )
 
 // PersonalityInsightsV3 : The IBM Watson&trade; Personality Insights service enables applications to derive insights
@@ -56,6 +57,7 @@ type PersonalityInsightsV3Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewPersonalityInsightsV3 : Instantiate PersonalityInsightsV3
@@ -70,7 +72,7 @@ func NewPersonalityInsightsV3(options *PersonalityInsightsV3Options) (service *P
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("personality_insights")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("personality_insights")
 		if err != nil {
 			return
 		}
@@ -81,6 +83,10 @@ func NewPersonalityInsightsV3(options *PersonalityInsightsV3Options) (service *P
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &PersonalityInsightsV3{
 		Service: baseService,
 		Version: options.Version,
@@ -196,6 +202,7 @@ func (personalityInsights *PersonalityInsightsV3) Profile(profileOptions *Profil
 	}
 
 	response, err = personalityInsights.Service.Request(request, new(Profile))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Profile)
@@ -304,6 +311,7 @@ func (personalityInsights *PersonalityInsightsV3) ProfileAsCsv(profileOptions *P
 	}
 
 	response, err = personalityInsights.Service.Request(request, new(io.ReadCloser))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
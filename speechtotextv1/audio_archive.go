@@ -0,0 +1,161 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// MAX_ARCHIVE_FILE_NAME_LENGTH is the maximum length of the name of an audio file contained in an archive-type
+// audio resource.
+const MAX_ARCHIVE_FILE_NAME_LENGTH = 128
+
+// ValidateArchiveFileName : Checks that a name can be used for an audio file contained in an archive-type audio
+// resource: it must not be empty, must not contain spaces or slashes, and can include at most 128 characters.
+func ValidateArchiveFileName(name string) error {
+	if name == "" {
+		return fmt.Errorf("the name of an archived audio file cannot be empty")
+	}
+	if len([]rune(name)) > MAX_ARCHIVE_FILE_NAME_LENGTH {
+		return fmt.Errorf("the name of archived audio file '%s' is longer than %d characters", name, MAX_ARCHIVE_FILE_NAME_LENGTH)
+	}
+	if strings.ContainsAny(name, " \t/\\") {
+		return fmt.Errorf("the name of archived audio file '%s' cannot contain spaces or slashes", name)
+	}
+	return nil
+}
+
+// WriteAudioArchive : Writes the files to a tar.gz archive, each under its base name. The names are validated with
+// ValidateArchiveFileName before anything is written.
+func WriteAudioArchive(writer io.Writer, fileNames []string) error {
+	for _, fileName := range fileNames {
+		if err := ValidateArchiveFileName(filepath.Base(fileName)); err != nil {
+			return err
+		}
+	}
+
+	gzipWriter := gzip.NewWriter(writer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, fileName := range fileNames {
+		if err := addArchiveFile(tarWriter, fileName); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func addArchiveFile(tarWriter *tar.Writer, fileName string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = filepath.Base(fileName)
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = io.Copy(tarWriter, file)
+	return err
+}
+
+// AddAudioDirectory : Add the audio files of a directory as an archive-type audio resource
+// Packages the files into a tar.gz archive that is streamed to AddAudio as it is written, so that no temporary archive
+// file is needed. If ContainedContentType of addAudioOptions is set, every regular file of the directory is added;
+// otherwise, only files with a known audio extension, such as .wav, .flac, .mp3, .ogg, and .webm, are added. Set
+// ContainedContentType for audio of type `audio/alaw`, `audio/basic`, `audio/l16`, or `audio/mulaw`. Subdirectories
+// are not searched. The names of the files are validated before the upload starts. AudioResource and ContentType of
+// addAudioOptions are ignored.
+func (speechToText *SpeechToTextV1) AddAudioDirectory(addAudioOptions *AddAudioOptions, directory string) (*core.DetailedResponse, error) {
+	return speechToText.AddAudioDirectoryWithContext(context.Background(), addAudioOptions, directory)
+}
+
+// AddAudioDirectoryWithContext is an alternate form of the AddAudioDirectory method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddAudioDirectoryWithContext(ctx context.Context, addAudioOptions *AddAudioOptions, directory string) (*core.DetailedResponse, error) {
+	if err := core.ValidateNotNil(addAudioOptions, "addAudioOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	fileNames, err := audioFilesInDirectory(directory, addAudioOptions.ContainedContentType != nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(fileNames) == 0 {
+		return nil, fmt.Errorf("directory %s contains no audio files", directory)
+	}
+	for _, fileName := range fileNames {
+		if err := ValidateArchiveFileName(filepath.Base(fileName)); err != nil {
+			return nil, err
+		}
+	}
+
+	reader, writer := io.Pipe()
+	archiveErr := make(chan error, 1)
+	go func() {
+		err := WriteAudioArchive(writer, fileNames)
+		writer.CloseWithError(err)
+		archiveErr <- err
+	}()
+
+	archiveOptions := *addAudioOptions
+	archiveOptions.AudioResource = reader
+	archiveOptions.ContentType = core.StringPtr("application/gzip")
+	response, err := speechToText.AddAudioWithContext(ctx, &archiveOptions)
+	reader.Close()
+	if writeErr := <-archiveErr; writeErr != nil && writeErr != io.ErrClosedPipe {
+		return response, writeErr
+	}
+	return response, err
+}
+
+// audioFilesInDirectory : Returns the regular files of the directory, only those with a known audio extension unless
+// all is set
+func audioFilesInDirectory(directory string, all bool) ([]string, error) {
+	entries, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileNames []string
+	for _, entry := range entries {
+		extension := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.Mode().IsRegular() && (all || audioContentTypesByExtension[extension] != "") {
+			fileNames = append(fileNames, filepath.Join(directory, entry.Name()))
+		}
+	}
+	return fileNames, nil
+}
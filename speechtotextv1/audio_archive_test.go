@@ -0,0 +1,99 @@
+package speechtotextv1_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioArchive", func() {
+	Describe("ValidateArchiveFileName(name string)", func() {
+		It("Rejects names with spaces, slashes, or too many characters", func() {
+			Expect(speechtotextv1.ValidateArchiveFileName("audio1.wav")).To(Succeed())
+			Expect(speechtotextv1.ValidateArchiveFileName("audio 1.wav")).ToNot(Succeed())
+			Expect(speechtotextv1.ValidateArchiveFileName("dir/audio1.wav")).ToNot(Succeed())
+			Expect(speechtotextv1.ValidateArchiveFileName(strings.Repeat("a", 125) + ".wav")).ToNot(Succeed())
+		})
+	})
+	Describe("AddAudioDirectory(addAudioOptions *AddAudioOptions, directory string)", func() {
+		Context("Successfully - Stream the audio files of a directory as a tar.gz archive", func() {
+			var archived []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/acoustic_customizations/customization1/audio/archive1"))
+				Expect(req.Header.Get("Content-Type")).To(Equal("application/gzip"))
+				Expect(req.Header.Get("Contained-Content-Type")).To(BeEmpty())
+				gzipReader, err := gzip.NewReader(req.Body)
+				Expect(err).To(BeNil())
+				tarReader := tar.NewReader(gzipReader)
+				for {
+					header, err := tarReader.Next()
+					if err == io.EOF {
+						break
+					}
+					Expect(err).To(BeNil())
+					data, _ := ioutil.ReadAll(tarReader)
+					Expect(string(data)).To(Equal("audio of " + header.Name))
+					archived = append(archived, header.Name)
+				}
+				res.WriteHeader(http.StatusCreated)
+			}))
+			It("Succeed to call AddAudioDirectory", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				directory, err := ioutil.TempDir("", "archive")
+				Expect(err).To(BeNil())
+				defer os.RemoveAll(directory)
+				for _, name := range []string{"audio1.wav", "audio2.flac", "notes.txt"} {
+					Expect(ioutil.WriteFile(filepath.Join(directory, name), []byte("audio of "+name), 0644)).To(Succeed())
+				}
+
+				addAudioOptions := testService.NewAddAudioOptions("customization1", "archive1", nil)
+				_, err = testService.AddAudioDirectory(addAudioOptions, directory)
+				Expect(err).To(BeNil())
+				Expect(archived).To(Equal([]string{"audio1.wav", "audio2.flac"}))
+			})
+		})
+		Context("Unsuccessfully - Add a directory with an invalid file name", func() {
+			It("Fails before the upload starts", func() {
+				testService, _ := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: "http://localhost:1",
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				directory, err := ioutil.TempDir("", "archive")
+				Expect(err).To(BeNil())
+				defer os.RemoveAll(directory)
+				Expect(ioutil.WriteFile(filepath.Join(directory, "audio 1.wav"), []byte("audio"), 0644)).To(Succeed())
+
+				_, err = testService.AddAudioDirectory(testService.NewAddAudioOptions("customization1", "archive1", nil), directory)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("spaces"))
+			})
+		})
+	})
+})
@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// AudioChecksumManifest : The SHA-256 checksums of the audio resources that were added to a custom acoustic model,
+// used by AddAudioIfNew to skip audio that was already added
+type AudioChecksumManifest struct {
+
+	// The ID of the custom acoustic model.
+	CustomizationID string `json:"customization_id"`
+
+	// The names of the audio resources, by the hexadecimal SHA-256 checksum of their audio.
+	Resources map[string]string `json:"resources"`
+}
+
+// NewAudioChecksumManifest : Instantiate AudioChecksumManifest
+func NewAudioChecksumManifest(customizationID string) *AudioChecksumManifest {
+	return &AudioChecksumManifest{
+		CustomizationID: customizationID,
+		Resources:       map[string]string{},
+	}
+}
+
+// WriteAudioChecksumManifest : Writes a manifest as indented JSON
+func WriteAudioChecksumManifest(writer io.Writer, manifest *AudioChecksumManifest) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(manifest)
+}
+
+// ReadAudioChecksumManifest : Reads a manifest written by WriteAudioChecksumManifest
+func ReadAudioChecksumManifest(reader io.Reader) (*AudioChecksumManifest, error) {
+	manifest := new(AudioChecksumManifest)
+	if err := json.NewDecoder(reader).Decode(manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Resources == nil {
+		manifest.Resources = map[string]string{}
+	}
+	return manifest, nil
+}
+
+// AudioChecksum : Returns the hexadecimal SHA-256 checksum of the audio
+func AudioChecksum(audio io.Reader) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, audio); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// AudioDuplicate : An audio resource that was not added because the model already has the same audio
+type AudioDuplicate struct {
+
+	// The name of the audio resource that was not added.
+	AudioName string
+
+	// The name of the audio resource of the model with the same audio.
+	DuplicateOf string
+
+	// The hexadecimal SHA-256 checksum of the audio.
+	Checksum string
+}
+
+// AddAudioIfNew : Add an audio resource unless the custom acoustic model already has the same audio
+// Computes the SHA-256 checksum of AudioResource and looks it up in the manifest. If the manifest names a resource
+// with the same checksum that the model still has, the audio is not added and the duplicate is returned. Otherwise
+// the audio is added with AddAudio and recorded in the manifest, which the caller should save. If AudioResource is an
+// io.Seeker, such as an os.File, it is rewound after the checksum is computed; otherwise it is read into memory.
+func (speechToText *SpeechToTextV1) AddAudioIfNew(addAudioOptions *AddAudioOptions, manifest *AudioChecksumManifest) (*AudioDuplicate, error) {
+	return speechToText.AddAudioIfNewWithContext(context.Background(), addAudioOptions, manifest)
+}
+
+// AddAudioIfNewWithContext is an alternate form of the AddAudioIfNew method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddAudioIfNewWithContext(ctx context.Context, addAudioOptions *AddAudioOptions, manifest *AudioChecksumManifest) (*AudioDuplicate, error) {
+	err := core.ValidateNotNil(addAudioOptions, "addAudioOptions cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+	err = core.ValidateStruct(addAudioOptions, "addAudioOptions")
+	if err != nil {
+		return nil, err
+	}
+	err = core.ValidateNotNil(manifest, "manifest cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+	if manifest.CustomizationID != "" && manifest.CustomizationID != *addAudioOptions.CustomizationID {
+		return nil, fmt.Errorf("the manifest is for custom model '%s', not '%s'", manifest.CustomizationID, *addAudioOptions.CustomizationID)
+	}
+
+	audio := addAudioOptions.AudioResource
+	var checksum string
+	if seeker, ok := audio.(io.Seeker); ok {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		if checksum, err = AudioChecksum(audio); err != nil {
+			return nil, err
+		}
+		if _, err = seeker.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+	} else {
+		data, err := ioutil.ReadAll(audio)
+		if err != nil {
+			return nil, err
+		}
+		checksum, _ = AudioChecksum(bytes.NewReader(data))
+		audio = &audioReadCloser{Reader: bytes.NewReader(data), Closer: audio}
+	}
+
+	if manifest.Resources == nil {
+		manifest.Resources = map[string]string{}
+	}
+	if existing, ok := manifest.Resources[checksum]; ok {
+		exists, err := speechToText.hasAudio(ctx, *addAudioOptions.CustomizationID, existing)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			audio.Close()
+			return &AudioDuplicate{
+				AudioName:   *addAudioOptions.AudioName,
+				DuplicateOf: existing,
+				Checksum:    checksum,
+			}, nil
+		}
+	}
+
+	uploadOptions := *addAudioOptions
+	uploadOptions.AudioResource = audio
+	if _, err = speechToText.AddAudioWithContext(ctx, &uploadOptions); err != nil {
+		return nil, err
+	}
+	manifest.Resources[checksum] = *addAudioOptions.AudioName
+	return nil, nil
+}
+
+// hasAudio : Reports whether the custom acoustic model has an audio resource with the name
+func (speechToText *SpeechToTextV1) hasAudio(ctx context.Context, customizationID string, audioName string) (bool, error) {
+	_, _, err := speechToText.GetAudioWithContext(ctx, speechToText.NewGetAudioOptions(customizationID, audioName))
+	if serviceError, ok := err.(*common.ServiceError); ok && serviceError.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,70 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioChecksum", func() {
+	Describe("AddAudioIfNew(addAudioOptions *AddAudioOptions, manifest *AudioChecksumManifest)", func() {
+		Context("Successfully - Skip audio that the model already has", func() {
+			var added []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				switch req.Method {
+				case "POST":
+					body, _ := ioutil.ReadAll(req.Body)
+					Expect(string(body)).To(Equal("audio one"))
+					added = append(added, req.URL.Path)
+					res.WriteHeader(http.StatusCreated)
+				case "GET":
+					Expect(req.URL.Path).To(Equal("/v1/acoustic_customizations/customization1/audio/audio1"))
+					res.Header().Set("Content-type", "application/json")
+					res.Write([]byte(`{"name": "audio1", "status": "ok", "duration": 1}`))
+				}
+			}))
+			It("Succeed to call AddAudioIfNew", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				manifest := speechtotextv1.NewAudioChecksumManifest("customization1")
+				audio := ioutil.NopCloser(strings.NewReader("audio one"))
+				duplicate, err := testService.AddAudioIfNew(testService.NewAddAudioOptions("customization1", "audio1", audio), manifest)
+				Expect(err).To(BeNil())
+				Expect(duplicate).To(BeNil())
+				Expect(manifest.Resources).To(HaveLen(1))
+
+				var saved bytes.Buffer
+				Expect(speechtotextv1.WriteAudioChecksumManifest(&saved, manifest)).To(Succeed())
+				manifest, err = speechtotextv1.ReadAudioChecksumManifest(&saved)
+				Expect(err).To(BeNil())
+
+				audio = ioutil.NopCloser(strings.NewReader("audio one"))
+				duplicate, err = testService.AddAudioIfNew(testService.NewAddAudioOptions("customization1", "audio2", audio), manifest)
+				Expect(err).To(BeNil())
+				Expect(duplicate).ToNot(BeNil())
+				Expect(duplicate.AudioName).To(Equal("audio2"))
+				Expect(duplicate.DuplicateOf).To(Equal("audio1"))
+				Expect(added).To(Equal([]string{"/v1/acoustic_customizations/customization1/audio/audio1"}))
+			})
+		})
+	})
+})
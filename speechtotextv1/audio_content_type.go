@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// AUDIO_SNIFF_LENGTH is the number of leading bytes of audio inspected by DetectAudioContentType.
+const AUDIO_SNIFF_LENGTH = 64
+
+var audioContentTypesByExtension = map[string]string{
+	".flac": "audio/flac",
+	".mp3":  "audio/mp3",
+	".mpeg": "audio/mpeg",
+	".oga":  "audio/ogg",
+	".ogg":  "audio/ogg",
+	".opus": "audio/ogg;codecs=opus",
+	".wav":  "audio/wav",
+	".webm": "audio/webm",
+}
+
+// DetectAudioContentType : Determines the content type of audio from its leading bytes, which identify WAV, FLAC,
+// MP3, Ogg, and WebM audio. If the bytes are not recognized, the extension of the file name is used instead. An empty
+// string is returned if neither identifies the format; formats such as audio/l16 and audio/mulaw cannot be detected
+// because they also require a sampling rate.
+func DetectAudioContentType(header []byte, fileName string) string {
+	switch {
+	case len(header) >= 12 && bytes.Equal(header[0:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WAVE")):
+		return "audio/wav"
+	case bytes.HasPrefix(header, []byte("fLaC")):
+		return "audio/flac"
+	case bytes.HasPrefix(header, []byte("ID3")):
+		return "audio/mp3"
+	case len(header) >= 2 && header[0] == 0xFF && header[1]&0xE0 == 0xE0:
+		return "audio/mp3"
+	case bytes.HasPrefix(header, []byte("OggS")):
+		return detectOggCodec(header)
+	case bytes.HasPrefix(header, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		return "audio/webm"
+	}
+
+	return audioContentTypesByExtension[strings.ToLower(filepath.Ext(fileName))]
+}
+
+// detectOggCodec : Identifies the codec of Ogg audio from the first packet of its first page
+func detectOggCodec(header []byte) string {
+	if len(header) < 27 {
+		return "audio/ogg"
+	}
+	// The first packet starts after the 27-byte page header and the segment table
+	packetStart := 27 + int(header[26])
+	if packetStart >= len(header) {
+		return "audio/ogg"
+	}
+	packet := header[packetStart:]
+	switch {
+	case bytes.HasPrefix(packet, []byte("OpusHead")):
+		return "audio/ogg;codecs=opus"
+	case bytes.HasPrefix(packet, []byte("\x01vorbis")):
+		return "audio/ogg;codecs=vorbis"
+	}
+	return "audio/ogg"
+}
+
+// sniffAudioContentType : Reads the leading bytes of the audio to detect its content type, and returns a reader that
+// yields the complete audio, including the bytes that were inspected
+func sniffAudioContentType(audio io.ReadCloser, fileName string) (string, io.ReadCloser, error) {
+	header := make([]byte, AUDIO_SNIFF_LENGTH)
+	bytesRead, err := io.ReadFull(audio, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", audio, err
+	}
+	header = header[:bytesRead]
+
+	replayed := &audioReadCloser{
+		Reader: io.MultiReader(bytes.NewReader(header), audio),
+		Closer: audio,
+	}
+	return DetectAudioContentType(header, fileName), replayed, nil
+}
+
+type audioReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// DetectContentType : Sets ContentType from the leading bytes of Audio, or from the extension of the file name if the
+// bytes are not recognized. The file name can be empty. ContentType is left unchanged if the format cannot be
+// detected. Audio must be set before calling DetectContentType.
+func (options *RecognizeOptions) DetectContentType(fileName string) (*RecognizeOptions, error) {
+	contentType, audio, err := sniffAudioContentType(options.Audio, fileName)
+	options.Audio = audio
+	if err == nil && contentType != "" {
+		options.SetContentType(contentType)
+	}
+	return options, err
+}
+
+// DetectContentType : Sets ContentType from the leading bytes of Audio, or from the extension of the file name if the
+// bytes are not recognized. The file name can be empty. ContentType is left unchanged if the format cannot be
+// detected. Audio must be set before calling DetectContentType.
+func (options *CreateJobOptions) DetectContentType(fileName string) (*CreateJobOptions, error) {
+	contentType, audio, err := sniffAudioContentType(options.Audio, fileName)
+	options.Audio = audio
+	if err == nil && contentType != "" {
+		options.SetContentType(contentType)
+	}
+	return options, err
+}
+
+// DetectContentType : Sets ContentType from the leading bytes of AudioResource, or from the extension of the file
+// name if the bytes are not recognized. The file name can be empty. ContentType is left unchanged if the format
+// cannot be detected. Archives of audio files are not detected; set ContentType and ContainedContentType for them.
+func (options *AddAudioOptions) DetectContentType(fileName string) (*AddAudioOptions, error) {
+	contentType, audio, err := sniffAudioContentType(options.AudioResource, fileName)
+	options.AudioResource = audio
+	if err == nil && contentType != "" {
+		options.SetContentType(contentType)
+	}
+	return options, err
+}
@@ -0,0 +1,45 @@
+package speechtotextv1_test
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioContentType", func() {
+	Describe("DetectAudioContentType(header []byte, fileName string)", func() {
+		It("Detects formats from magic bytes", func() {
+			Expect(speechtotextv1.DetectAudioContentType([]byte("RIFF\x24\x00\x00\x00WAVEfmt "), "")).To(Equal("audio/wav"))
+			Expect(speechtotextv1.DetectAudioContentType([]byte("fLaC\x00\x00\x00\x22"), "")).To(Equal("audio/flac"))
+			Expect(speechtotextv1.DetectAudioContentType([]byte("ID3\x03\x00"), "")).To(Equal("audio/mp3"))
+			Expect(speechtotextv1.DetectAudioContentType([]byte{0xFF, 0xFB, 0x90, 0x00}, "")).To(Equal("audio/mp3"))
+			Expect(speechtotextv1.DetectAudioContentType([]byte{0x1A, 0x45, 0xDF, 0xA3, 0x01}, "")).To(Equal("audio/webm"))
+		})
+		It("Detects the codec of Ogg audio", func() {
+			page := append([]byte("OggS"), make([]byte, 22)...)
+			page = append(page, 1, 19)
+			Expect(speechtotextv1.DetectAudioContentType(append(page, []byte("OpusHead")...), "")).To(Equal("audio/ogg;codecs=opus"))
+			Expect(speechtotextv1.DetectAudioContentType(append(page, []byte("\x01vorbis")...), "")).To(Equal("audio/ogg;codecs=vorbis"))
+		})
+		It("Falls back to the file extension", func() {
+			Expect(speechtotextv1.DetectAudioContentType([]byte("unknown"), "speech.FLAC")).To(Equal("audio/flac"))
+			Expect(speechtotextv1.DetectAudioContentType([]byte("unknown"), "speech.raw")).To(Equal(""))
+		})
+	})
+	Describe("DetectContentType(fileName string)", func() {
+		It("Sets the content type without consuming the audio", func() {
+			audio := "RIFF\x24\x00\x00\x00WAVEfmt audio data"
+			testService := &speechtotextv1.SpeechToTextV1{}
+			recognizeOptions, err := testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader(audio))).DetectContentType("")
+			Expect(err).To(BeNil())
+			Expect(*recognizeOptions.ContentType).To(Equal("audio/wav"))
+
+			data, err := ioutil.ReadAll(recognizeOptions.Audio)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal(audio))
+		})
+	})
+})
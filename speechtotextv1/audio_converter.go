@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+)
+
+// DEFAULT_CONVERT_SAMPLE_RATE is the sampling rate of converted audio, which suits broadband models.
+const DEFAULT_CONVERT_SAMPLE_RATE = BROADBAND_MIN_SAMPLE_RATE
+
+// ConvertPCM : Converts 16-bit PCM samples to mono little-endian samples at the sampling rate
+// The format of the samples must be `audio/l16` with a rate; it can have any number of channels, which are mixed
+// down, and either endianness. Downsampling averages the samples that fall into each output sample, which filters
+// out most of the frequencies that the lower rate cannot represent; upsampling interpolates linearly.
+func ConvertPCM(samples []byte, from AudioFormat, sampleRate int) ([]byte, error) {
+	if from.MimeType() != "audio/l16" {
+		return nil, fmt.Errorf("only audio/l16 samples can be converted, not %s", from.MimeType())
+	}
+	if err := from.Validate(); err != nil {
+		return nil, err
+	}
+	if sampleRate <= 0 {
+		return nil, fmt.Errorf("invalid sampling rate %d", sampleRate)
+	}
+	channels := from.Channels()
+	if channels == 0 {
+		channels = 1
+	}
+	var byteOrder binary.ByteOrder = binary.LittleEndian
+	if from.Endianness() == ENDIANNESS_BIG_ENDIAN {
+		byteOrder = binary.BigEndian
+	}
+
+	mono := downmixPCM(samples, channels, byteOrder)
+	resampled := resamplePCM(mono, from.Rate(), sampleRate)
+
+	converted := make([]byte, 2*len(resampled))
+	for i, sample := range resampled {
+		binary.LittleEndian.PutUint16(converted[2*i:], uint16(clampSample(sample)))
+	}
+	return converted, nil
+}
+
+// ConvertAudio : Converts 16-bit PCM WAV or `audio/l16` audio to mono little-endian `audio/l16` audio at the sampling
+// rate, and returns it with its content type. Converting to 16000 Hz mono before the upload reduces the size of the
+// audio and ensures that it suits both broadband and narrowband models.
+func ConvertAudio(audio []byte, contentType string, sampleRate int) ([]byte, string, error) {
+	var samples []byte
+	var from AudioFormat
+	mediaType, _ := parseAudioContentType(contentType)
+	switch mediaType {
+	case "audio/wav", "audio/wave", "audio/x-wav":
+		format, data, err := parseWAV(audio)
+		if err != nil {
+			return nil, "", err
+		}
+		samples = data
+		from = NewAudioFormat("audio/l16").
+			WithRate(format.sampleRate).
+			WithChannels(format.channels).
+			WithEndianness(ENDIANNESS_LITTLE_ENDIAN)
+	case "audio/l16":
+		format, err := ParseAudioFormat(contentType)
+		if err != nil {
+			return nil, "", err
+		}
+		samples, from = audio, format
+	default:
+		return nil, "", fmt.Errorf("audio of type '%s' cannot be converted; decode it to WAV first", contentType)
+	}
+
+	converted, err := ConvertPCM(samples, from, sampleRate)
+	if err != nil {
+		return nil, "", err
+	}
+	to := NewAudioFormat("audio/l16").
+		WithRate(sampleRate).
+		WithChannels(1).
+		WithEndianness(ENDIANNESS_LITTLE_ENDIAN)
+	return converted, to.String(), nil
+}
+
+// ConvertAudio : Converts Audio with ConvertAudio and replaces Audio and ContentType with the converted audio.
+// Audio is read completely and closed. ContentType must be set, for example with DetectContentType.
+func (options *RecognizeOptions) ConvertAudio(sampleRate int) (*RecognizeOptions, error) {
+	if options.ContentType == nil {
+		return options, fmt.Errorf("the content type of the audio must be set before it can be converted")
+	}
+	audio, err := ioutil.ReadAll(options.Audio)
+	options.Audio.Close()
+	if err != nil {
+		return options, err
+	}
+	converted, contentType, err := ConvertAudio(audio, *options.ContentType, sampleRate)
+	if err != nil {
+		options.Audio = ioutil.NopCloser(bytes.NewReader(audio))
+		return options, err
+	}
+	options.Audio = ioutil.NopCloser(bytes.NewReader(converted))
+	return options.SetContentType(contentType), nil
+}
+
+// downmixPCM : Averages the channels of interleaved 16-bit samples
+func downmixPCM(samples []byte, channels int, byteOrder binary.ByteOrder) []float64 {
+	frameSize := 2 * channels
+	mono := make([]float64, len(samples)/frameSize)
+	for i := range mono {
+		var sum float64
+		for channel := 0; channel < channels; channel++ {
+			sum += float64(int16(byteOrder.Uint16(samples[i*frameSize+2*channel:])))
+		}
+		mono[i] = sum / float64(channels)
+	}
+	return mono
+}
+
+// resamplePCM : Changes the sampling rate of mono samples
+func resamplePCM(samples []float64, fromRate int, toRate int) []float64 {
+	if fromRate == toRate || len(samples) == 0 {
+		return samples
+	}
+	ratio := float64(fromRate) / float64(toRate)
+	resampled := make([]float64, int(float64(len(samples))/ratio))
+	for i := range resampled {
+		position := float64(i) * ratio
+		if ratio > 1 {
+			start := int(position)
+			end := int(position + ratio)
+			if end > len(samples) {
+				end = len(samples)
+			}
+			if end <= start {
+				end = start + 1
+			}
+			var sum float64
+			for _, sample := range samples[start:end] {
+				sum += sample
+			}
+			resampled[i] = sum / float64(end-start)
+			continue
+		}
+		index := int(position)
+		fraction := position - float64(index)
+		next := index + 1
+		if next >= len(samples) {
+			next = index
+		}
+		resampled[i] = samples[index]*(1-fraction) + samples[next]*fraction
+	}
+	return resampled
+}
+
+func clampSample(sample float64) int16 {
+	rounded := math.Round(sample)
+	if rounded > math.MaxInt16 {
+		return math.MaxInt16
+	}
+	if rounded < math.MinInt16 {
+		return math.MinInt16
+	}
+	return int16(rounded)
+}
@@ -0,0 +1,68 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioConverter", func() {
+	Describe("ConvertPCM(samples []byte, from AudioFormat, sampleRate int)", func() {
+		It("Mixes down channels", func() {
+			stereo := make([]byte, 8)
+			binary.LittleEndian.PutUint16(stereo[0:], uint16(1000))
+			binary.LittleEndian.PutUint16(stereo[2:], uint16(3000))
+			binary.LittleEndian.PutUint16(stereo[4:], uint16(0xFFFF))
+			binary.LittleEndian.PutUint16(stereo[6:], uint16(0xFFFD))
+			from := speechtotextv1.NewAudioFormat("audio/l16").WithRate(16000).WithChannels(2)
+			mono, err := speechtotextv1.ConvertPCM(stereo, from, 16000)
+			Expect(err).To(BeNil())
+			Expect(int16(binary.LittleEndian.Uint16(mono[0:]))).To(Equal(int16(2000)))
+			Expect(int16(binary.LittleEndian.Uint16(mono[2:]))).To(Equal(int16(-2)))
+		})
+		It("Changes the sampling rate and the endianness", func() {
+			samples := make([]byte, 2*48000)
+			for i := 0; i < 48000; i++ {
+				binary.BigEndian.PutUint16(samples[2*i:], uint16(100))
+			}
+			from := speechtotextv1.NewAudioFormat("audio/l16").WithRate(48000).WithEndianness(speechtotextv1.ENDIANNESS_BIG_ENDIAN)
+			converted, err := speechtotextv1.ConvertPCM(samples, from, 16000)
+			Expect(err).To(BeNil())
+			Expect(converted).To(HaveLen(2 * 16000))
+			Expect(int16(binary.LittleEndian.Uint16(converted[100:]))).To(Equal(int16(100)))
+
+			upsampled, err := speechtotextv1.ConvertPCM(converted, speechtotextv1.NewAudioFormat("audio/l16").WithRate(16000), 22050)
+			Expect(err).To(BeNil())
+			Expect(upsampled).To(HaveLen(2 * 22050))
+		})
+	})
+	Describe("ConvertAudio(sampleRate int)", func() {
+		It("Converts WAV audio to 16 kHz mono audio/l16", func() {
+			testService := &speechtotextv1.SpeechToTextV1{}
+			wav := wavFile(8000, 2, pcmTone(800, 1000))
+			recognizeOptions, err := testService.NewRecognizeOptions(ioutil.NopCloser(bytes.NewReader(wav))).
+				SetContentType("audio/wav").
+				ConvertAudio(speechtotextv1.DEFAULT_CONVERT_SAMPLE_RATE)
+			Expect(err).To(BeNil())
+			Expect(*recognizeOptions.ContentType).To(Equal("audio/l16;rate=16000;channels=1;endianness=little-endian"))
+			audio, _ := ioutil.ReadAll(recognizeOptions.Audio)
+			Expect(audio).To(HaveLen(2 * 800))
+		})
+		It("Fails for compressed audio", func() {
+			_, _, err := speechtotextv1.ConvertAudio([]byte("fLaC"), "audio/flac", 16000)
+			Expect(err).NotTo(BeNil())
+		})
+		It("Fails for 8-bit WAV audio", func() {
+			wav := wavFile(8000, 1, pcmTone(800, 1000))
+			bitsPerSample := bytes.Index(wav, []byte("fmt ")) + 8 + 14
+			wav[bitsPerSample] = 8
+			_, _, err := speechtotextv1.ConvertAudio(wav, "audio/wav", 16000)
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(Equal("the WAV audio is not 16-bit PCM"))
+		})
+	})
+})
@@ -0,0 +1,392 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+)
+
+// DEFAULT_FLAC_BLOCK_SIZE is the number of samples per channel in each FLAC frame.
+const DEFAULT_FLAC_BLOCK_SIZE = 4096
+
+// AudioEncoder : Compresses 16-bit PCM audio before it is uploaded
+// Implement AudioEncoder to plug in other codecs, such as an Opus encoder that wraps a native library, and pass the
+// implementation to EncodeAudio.
+type AudioEncoder interface {
+
+	// Encode compresses the samples, which are in the `audio/l16` format, and returns the encoded audio and its
+	// content type.
+	Encode(samples []byte, format AudioFormat) ([]byte, string, error)
+}
+
+// FLACEncoder : A lossless pure-Go FLAC encoder
+// Each channel of each block is encoded with the fixed linear predictor that compresses it best, and the residuals
+// are Rice coded. Speech typically compresses to 50-60% of its PCM size.
+type FLACEncoder struct {
+
+	// The number of samples per channel in each frame. Defaults to DEFAULT_FLAC_BLOCK_SIZE.
+	BlockSize int
+}
+
+// NewFLACEncoder : Instantiate FLACEncoder
+func NewFLACEncoder() *FLACEncoder {
+	return &FLACEncoder{BlockSize: DEFAULT_FLAC_BLOCK_SIZE}
+}
+
+// Encode : Encodes 16-bit PCM samples with up to eight channels as `audio/flac`
+func (encoder *FLACEncoder) Encode(samples []byte, format AudioFormat) ([]byte, string, error) {
+	if format.MimeType() != "audio/l16" {
+		return nil, "", fmt.Errorf("only audio/l16 samples can be encoded, not %s", format.MimeType())
+	}
+	if err := format.Validate(); err != nil {
+		return nil, "", err
+	}
+	channels := format.Channels()
+	if channels == 0 {
+		channels = 1
+	}
+	if channels > 8 {
+		return nil, "", fmt.Errorf("FLAC supports at most eight channels, not %d", channels)
+	}
+	if format.Rate() >= 1<<20 {
+		return nil, "", fmt.Errorf("FLAC does not support a sampling rate of %d Hz", format.Rate())
+	}
+	blockSize := encoder.BlockSize
+	if blockSize < 16 || blockSize > 65535 {
+		blockSize = DEFAULT_FLAC_BLOCK_SIZE
+	}
+	var byteOrder binary.ByteOrder = binary.LittleEndian
+	if format.Endianness() == ENDIANNESS_BIG_ENDIAN {
+		byteOrder = binary.BigEndian
+	}
+
+	// Deinterleave the samples, and compute the MD5 signature of the samples in little-endian order
+	frames := len(samples) / (2 * channels)
+	channelSamples := make([][]int32, channels)
+	for channel := range channelSamples {
+		channelSamples[channel] = make([]int32, frames)
+	}
+	signature := md5.New()
+	littleEndian := make([]byte, 2)
+	for i := 0; i < frames*channels; i++ {
+		sample := byteOrder.Uint16(samples[2*i:])
+		channelSamples[i%channels][i/channels] = int32(int16(sample))
+		binary.LittleEndian.PutUint16(littleEndian, sample)
+		signature.Write(littleEndian)
+	}
+
+	var flac bytes.Buffer
+	flac.WriteString("fLaC")
+	streamInfo := newFLACBitWriter()
+	streamInfo.writeBits(1<<7, 8) // Last metadata block, STREAMINFO
+	streamInfo.writeBits(34, 24)
+	streamInfo.writeBits(uint64(blockSize), 16)
+	streamInfo.writeBits(uint64(blockSize), 16)
+	streamInfo.writeBits(0, 24) // Unknown minimum frame size
+	streamInfo.writeBits(0, 24) // Unknown maximum frame size
+	streamInfo.writeBits(uint64(format.Rate()), 20)
+	streamInfo.writeBits(uint64(channels-1), 3)
+	streamInfo.writeBits(15, 5) // 16 bits per sample
+	streamInfo.writeBits(uint64(frames), 36)
+	flac.Write(streamInfo.bytes())
+	flac.Write(signature.Sum(nil))
+
+	for frameNumber, start := 0, 0; start < frames; frameNumber, start = frameNumber+1, start+blockSize {
+		end := start + blockSize
+		if end > frames {
+			end = frames
+		}
+		flac.Write(encodeFLACFrame(channelSamples, start, end, frameNumber))
+	}
+	return flac.Bytes(), "audio/flac", nil
+}
+
+// EncodeAudio : Encodes WAV or `audio/l16` audio with the encoder, and returns the encoded audio and its content type
+func EncodeAudio(audio []byte, contentType string, encoder AudioEncoder) ([]byte, string, error) {
+	mediaType, _ := parseAudioContentType(contentType)
+	switch mediaType {
+	case "audio/wav", "audio/wave", "audio/x-wav":
+		format, samples, err := parseWAV(audio)
+		if err != nil {
+			return nil, "", err
+		}
+		from := NewAudioFormat("audio/l16").
+			WithRate(format.sampleRate).
+			WithChannels(format.channels).
+			WithEndianness(ENDIANNESS_LITTLE_ENDIAN)
+		return encoder.Encode(samples, from)
+	case "audio/l16":
+		from, err := ParseAudioFormat(contentType)
+		if err != nil {
+			return nil, "", err
+		}
+		return encoder.Encode(audio, from)
+	}
+	return nil, "", fmt.Errorf("audio of type '%s' cannot be encoded; decode it to WAV first", contentType)
+}
+
+// EncodeAudio : Encodes Audio with EncodeAudio and replaces Audio and ContentType with the encoded audio. Audio is
+// read completely and closed. ContentType must be set, for example with DetectContentType.
+func (options *RecognizeOptions) EncodeAudio(encoder AudioEncoder) (*RecognizeOptions, error) {
+	if options.ContentType == nil {
+		return options, fmt.Errorf("the content type of the audio must be set before it can be encoded")
+	}
+	audio, err := ioutil.ReadAll(options.Audio)
+	options.Audio.Close()
+	if err != nil {
+		return options, err
+	}
+	encoded, contentType, err := EncodeAudio(audio, *options.ContentType, encoder)
+	if err != nil {
+		options.Audio = ioutil.NopCloser(bytes.NewReader(audio))
+		return options, err
+	}
+	options.Audio = ioutil.NopCloser(bytes.NewReader(encoded))
+	return options.SetContentType(contentType), nil
+}
+
+// EncodeAudio : Encodes AudioResource with EncodeAudio and replaces AudioResource and ContentType with the encoded
+// audio. AudioResource is read completely and closed. ContentType must be set, and archives cannot be encoded.
+func (options *AddAudioOptions) EncodeAudio(encoder AudioEncoder) (*AddAudioOptions, error) {
+	if options.ContentType == nil {
+		return options, fmt.Errorf("the content type of the audio must be set before it can be encoded")
+	}
+	audio, err := ioutil.ReadAll(options.AudioResource)
+	options.AudioResource.Close()
+	if err != nil {
+		return options, err
+	}
+	encoded, contentType, err := EncodeAudio(audio, *options.ContentType, encoder)
+	if err != nil {
+		options.AudioResource = ioutil.NopCloser(bytes.NewReader(audio))
+		return options, err
+	}
+	options.AudioResource = ioutil.NopCloser(bytes.NewReader(encoded))
+	return options.SetContentType(contentType), nil
+}
+
+// encodeFLACFrame : Encodes the samples of all channels from start to end as a FLAC frame
+func encodeFLACFrame(channelSamples [][]int32, start int, end int, frameNumber int) []byte {
+	frame := newFLACBitWriter()
+	frame.writeBits(0x3FFE, 14) // Sync code
+	frame.writeBits(0, 1)
+	frame.writeBits(0, 1) // Fixed block size
+	frame.writeBits(7, 4) // Block size in a 16-bit field at the end of the header
+	frame.writeBits(0, 4) // Sampling rate from STREAMINFO
+	frame.writeBits(uint64(len(channelSamples)-1), 4)
+	frame.writeBits(4, 3) // 16 bits per sample
+	frame.writeBits(0, 1)
+	frame.writeUTF8(uint64(frameNumber))
+	frame.writeBits(uint64(end-start-1), 16)
+	frame.writeBits(uint64(flacCRC8(frame.bytes())), 8)
+
+	for _, samples := range channelSamples {
+		encodeFLACSubframe(frame, samples[start:end])
+	}
+	frame.alignToByte()
+	frame.writeBits(uint64(flacCRC16(frame.bytes())), 16)
+	return frame.bytes()
+}
+
+// encodeFLACSubframe : Encodes the samples of a channel with the smallest of the constant, verbatim, and fixed
+// predictor subframes
+func encodeFLACSubframe(writer *flacBitWriter, samples []int32) {
+	constant := true
+	for _, sample := range samples {
+		if sample != samples[0] {
+			constant = false
+			break
+		}
+	}
+	if constant {
+		writer.writeBits(0, 8) // Constant subframe
+		writer.writeSigned(samples[0], 16)
+		return
+	}
+
+	bestOrder, bestParameter, bestBits := -1, 0, 16*len(samples)
+	var bestResiduals []int32
+	for order := 0; order <= 4 && order < len(samples); order++ {
+		residuals := fixedResiduals(samples, order)
+		parameter, bits := bestRiceParameter(residuals)
+		bits += 16*order + 4
+		if bits < bestBits {
+			bestOrder, bestParameter, bestBits, bestResiduals = order, parameter, bits, residuals
+		}
+	}
+
+	if bestOrder < 0 {
+		writer.writeBits(1<<1, 8) // Verbatim subframe
+		for _, sample := range samples {
+			writer.writeSigned(sample, 16)
+		}
+		return
+	}
+	writer.writeBits(uint64(8|bestOrder)<<1, 8) // Fixed subframe
+	for _, sample := range samples[:bestOrder] {
+		writer.writeSigned(sample, 16)
+	}
+	writer.writeBits(0, 2) // Rice coding with 4-bit parameters
+	writer.writeBits(0, 4) // A single partition
+	writer.writeBits(uint64(bestParameter), 4)
+	for _, residual := range bestResiduals {
+		folded := foldResidual(residual)
+		writer.writeUnary(folded >> uint(bestParameter))
+		writer.writeBits(uint64(folded), bestParameter)
+	}
+}
+
+// fixedResiduals : The residuals of the fixed linear predictor of the order
+func fixedResiduals(samples []int32, order int) []int32 {
+	residuals := make([]int32, len(samples)-order)
+	for i := order; i < len(samples); i++ {
+		var prediction int32
+		switch order {
+		case 1:
+			prediction = samples[i-1]
+		case 2:
+			prediction = 2*samples[i-1] - samples[i-2]
+		case 3:
+			prediction = 3*samples[i-1] - 3*samples[i-2] + samples[i-3]
+		case 4:
+			prediction = 4*samples[i-1] - 6*samples[i-2] + 4*samples[i-3] - samples[i-4]
+		}
+		residuals[i-order] = samples[i] - prediction
+	}
+	return residuals
+}
+
+// bestRiceParameter : The Rice parameter that codes the residuals in the fewest bits, and that number of bits
+func bestRiceParameter(residuals []int32) (int, int) {
+	bestParameter, bestBits := 0, -1
+	for parameter := 0; parameter <= 14; parameter++ {
+		bits := 0
+		for _, residual := range residuals {
+			bits += int(foldResidual(residual)>>uint(parameter)) + 1 + parameter
+		}
+		if bestBits < 0 || bits < bestBits {
+			bestParameter, bestBits = parameter, bits
+		}
+	}
+	return bestParameter, bestBits
+}
+
+// foldResidual : Maps signed residuals to unsigned values, interleaving positive and negative values
+func foldResidual(residual int32) uint32 {
+	return uint32(residual<<1) ^ uint32(residual>>31)
+}
+
+// flacBitWriter : Writes values of any number of bits, most significant bit first
+type flacBitWriter struct {
+	buffer  []byte
+	current uint64
+	bits    uint
+}
+
+func newFLACBitWriter() *flacBitWriter {
+	return &flacBitWriter{}
+}
+
+func (writer *flacBitWriter) writeBits(value uint64, bits int) {
+	for bits > 0 {
+		chunk := bits
+		if chunk > 32 {
+			chunk = 32
+		}
+		bits -= chunk
+		writer.current = writer.current<<uint(chunk) | (value>>uint(bits))&(1<<uint(chunk)-1)
+		writer.bits += uint(chunk)
+		for writer.bits >= 8 {
+			writer.bits -= 8
+			writer.buffer = append(writer.buffer, byte(writer.current>>writer.bits))
+		}
+		writer.current &= 1<<writer.bits - 1
+	}
+}
+
+func (writer *flacBitWriter) writeSigned(value int32, bits int) {
+	writer.writeBits(uint64(value)&(1<<uint(bits)-1), bits)
+}
+
+func (writer *flacBitWriter) writeUnary(zeros uint32) {
+	for ; zeros >= 32; zeros -= 32 {
+		writer.writeBits(0, 32)
+	}
+	writer.writeBits(1, int(zeros)+1)
+}
+
+// writeUTF8 : Writes the value in the extended UTF-8 coding that FLAC uses for frame numbers
+func (writer *flacBitWriter) writeUTF8(value uint64) {
+	if value < 0x80 {
+		writer.writeBits(value, 8)
+		return
+	}
+	continuationBytes := 1
+	for value >= 1<<uint(5*continuationBytes+6) {
+		continuationBytes++
+	}
+	leadingOnes := uint64(0xFF) << uint(7-continuationBytes) & 0xFF
+	writer.writeBits(leadingOnes|value>>uint(6*continuationBytes), 8)
+	for i := continuationBytes - 1; i >= 0; i-- {
+		writer.writeBits(0x80|(value>>uint(6*i))&0x3F, 8)
+	}
+}
+
+func (writer *flacBitWriter) alignToByte() {
+	if writer.bits > 0 {
+		writer.writeBits(0, int(8-writer.bits))
+	}
+}
+
+// bytes : The complete bytes that were written
+func (writer *flacBitWriter) bytes() []byte {
+	return writer.buffer
+}
+
+func flacCRC8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func flacCRC16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x8005
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,84 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"io/ioutil"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// crc8 computes the CRC-8 of FLAC frame headers, with the polynomial x^8 + x^2 + x + 1
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+var _ = Describe("AudioEncoder", func() {
+	Describe("Encode(samples []byte, format AudioFormat)", func() {
+		It("Encodes PCM audio as FLAC", func() {
+			samples := append(pcmTone(10000, 5000), pcmTone(10000, 0)...)
+			format := speechtotextv1.NewAudioFormat("audio/l16").WithRate(16000).WithChannels(2)
+			flac, contentType, err := speechtotextv1.NewFLACEncoder().Encode(samples, format)
+			Expect(err).To(BeNil())
+			Expect(contentType).To(Equal("audio/flac"))
+			Expect(string(flac[0:4])).To(Equal("fLaC"))
+			Expect(len(flac)).To(BeNumerically("<", len(samples)))
+			Expect(speechtotextv1.DetectAudioContentType(flac, "")).To(Equal("audio/flac"))
+
+			// The only metadata block is a STREAMINFO block of 34 bytes
+			Expect(flac[4]).To(Equal(byte(0x80)))
+			Expect(flac[5:8]).To(Equal([]byte{0, 0, 34}))
+			streamInfo := flac[8:42]
+			Expect(binary.BigEndian.Uint16(streamInfo[0:])).To(Equal(uint16(speechtotextv1.DEFAULT_FLAC_BLOCK_SIZE)))
+			Expect(binary.BigEndian.Uint16(streamInfo[2:])).To(Equal(uint16(speechtotextv1.DEFAULT_FLAC_BLOCK_SIZE)))
+			fields := binary.BigEndian.Uint64(streamInfo[10:])
+			Expect(fields >> 44).To(Equal(uint64(16000)))
+			Expect(fields>>41&0x7 + 1).To(Equal(uint64(2)))
+			Expect(fields>>36&0x1F + 1).To(Equal(uint64(16)))
+			Expect(fields & (1<<36 - 1)).To(Equal(uint64(10000)))
+			signature := md5.Sum(samples)
+			Expect(streamInfo[18:34]).To(Equal(signature[:]))
+
+			// The first frame has a fixed block size, stereo 16-bit samples, frame number 0, and the block size in
+			// a 16-bit field, followed by the CRC-8 of the header
+			frame := flac[42:]
+			Expect(frame[0:2]).To(Equal([]byte{0xFF, 0xF8}))
+			Expect(frame[2]).To(Equal(byte(0x70)))
+			Expect(frame[3]).To(Equal(byte(0x18)))
+			Expect(frame[4]).To(Equal(byte(0)))
+			Expect(binary.BigEndian.Uint16(frame[5:]) + 1).To(Equal(uint16(speechtotextv1.DEFAULT_FLAC_BLOCK_SIZE)))
+			Expect(frame[7]).To(Equal(crc8(frame[0:7])))
+		})
+		It("Fails for formats other than audio/l16", func() {
+			_, _, err := speechtotextv1.NewFLACEncoder().Encode([]byte{}, speechtotextv1.NewAudioFormat("audio/mulaw").WithRate(8000))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+	Describe("EncodeAudio(encoder AudioEncoder)", func() {
+		It("Replaces WAV audio with FLAC audio", func() {
+			testService := &speechtotextv1.SpeechToTextV1{}
+			wav := wavFile(16000, 2, pcmTone(8000, 3000))
+			recognizeOptions, err := testService.NewRecognizeOptions(ioutil.NopCloser(bytes.NewReader(wav))).
+				SetContentType("audio/wav").
+				EncodeAudio(speechtotextv1.NewFLACEncoder())
+			Expect(err).To(BeNil())
+			Expect(*recognizeOptions.ContentType).To(Equal("audio/flac"))
+			flac, _ := ioutil.ReadAll(recognizeOptions.Audio)
+			Expect(len(flac)).To(BeNumerically("<", len(wav)))
+		})
+	})
+})
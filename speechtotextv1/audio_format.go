@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Byte orders of `audio/l16` audio.
+const (
+	ENDIANNESS_BIG_ENDIAN    = "big-endian"
+	ENDIANNESS_LITTLE_ENDIAN = "little-endian"
+)
+
+// AudioFormat : The format of audio, rendered as the value of a Content-Type header by String
+// The formats `audio/alaw`, `audio/l16`, and `audio/mulaw` require the sampling rate of the audio, and `audio/l16`
+// can also specify the number of channels and the endianness. An AudioFormat is immutable; the With methods return
+// modified copies, so a format can be shared as a template.
+type AudioFormat struct {
+	mimeType   string
+	rate       int
+	channels   int
+	endianness string
+}
+
+// NewAudioFormat : Instantiate AudioFormat for a MIME type, such as `audio/l16` or `audio/ogg;codecs=opus`
+func NewAudioFormat(mimeType string) AudioFormat {
+	return AudioFormat{mimeType: strings.ToLower(strings.TrimSpace(mimeType))}
+}
+
+// ParseAudioFormat : Parses the value of a Content-Type header into an AudioFormat
+func ParseAudioFormat(contentType string) (AudioFormat, error) {
+	mimeType, parameters := parseAudioContentType(contentType)
+	if codecs, ok := parameters["codecs"]; ok {
+		mimeType += ";codecs=" + strings.ToLower(codecs)
+	}
+	format := NewAudioFormat(mimeType)
+	if rate, ok := parameters["rate"]; ok {
+		value, err := strconv.Atoi(rate)
+		if err != nil {
+			return format, fmt.Errorf("invalid rate '%s' in content type '%s'", rate, contentType)
+		}
+		format.rate = value
+	}
+	if channels, ok := parameters["channels"]; ok {
+		value, err := strconv.Atoi(channels)
+		if err != nil {
+			return format, fmt.Errorf("invalid channels '%s' in content type '%s'", channels, contentType)
+		}
+		format.channels = value
+	}
+	format.endianness = strings.ToLower(parameters["endianness"])
+	return format, format.Validate()
+}
+
+// WithRate : Returns a copy of the format with the sampling rate of the audio in Hz
+func (format AudioFormat) WithRate(rate int) AudioFormat {
+	format.rate = rate
+	return format
+}
+
+// WithChannels : Returns a copy of the format with the number of channels of the audio
+func (format AudioFormat) WithChannels(channels int) AudioFormat {
+	format.channels = channels
+	return format
+}
+
+// WithEndianness : Returns a copy of the format with the byte order of the audio, ENDIANNESS_BIG_ENDIAN or
+// ENDIANNESS_LITTLE_ENDIAN
+func (format AudioFormat) WithEndianness(endianness string) AudioFormat {
+	format.endianness = strings.ToLower(endianness)
+	return format
+}
+
+// MimeType : The MIME type of the format, without the rate, channels, and endianness parameters
+func (format AudioFormat) MimeType() string {
+	return format.mimeType
+}
+
+// Rate : The sampling rate of the format in Hz, or zero if it is not set
+func (format AudioFormat) Rate() int {
+	return format.rate
+}
+
+// Channels : The number of channels of the format, or zero if it is not set
+func (format AudioFormat) Channels() int {
+	return format.channels
+}
+
+// Endianness : The byte order of the format, or an empty string if it is not set
+func (format AudioFormat) Endianness() string {
+	return format.endianness
+}
+
+// Validate : Checks that the format has the parameters that the service requires, and only those it accepts
+func (format AudioFormat) Validate() error {
+	if format.mimeType == "" {
+		return fmt.Errorf("the audio format has no MIME type")
+	}
+	requiresRate := false
+	switch format.mimeType {
+	case "audio/alaw", "audio/mulaw":
+		requiresRate = true
+		if format.channels != 0 || format.endianness != "" {
+			return fmt.Errorf("%s accepts only the rate parameter", format.mimeType)
+		}
+	case "audio/l16":
+		requiresRate = true
+	default:
+		if format.rate != 0 || format.channels != 0 || format.endianness != "" {
+			return fmt.Errorf("%s does not accept rate, channels, or endianness parameters", format.mimeType)
+		}
+	}
+	if requiresRate && format.rate <= 0 {
+		return fmt.Errorf("%s requires a positive sampling rate", format.mimeType)
+	}
+	if format.channels < 0 {
+		return fmt.Errorf("the number of channels cannot be negative")
+	}
+	if format.endianness != "" && format.endianness != ENDIANNESS_BIG_ENDIAN && format.endianness != ENDIANNESS_LITTLE_ENDIAN {
+		return fmt.Errorf("invalid endianness '%s'", format.endianness)
+	}
+	return nil
+}
+
+// String : Renders the format as the value of a Content-Type header, for example
+// `audio/l16;rate=16000;channels=2;endianness=little-endian`
+func (format AudioFormat) String() string {
+	var builder strings.Builder
+	builder.WriteString(format.mimeType)
+	if format.rate > 0 {
+		builder.WriteString(";rate=")
+		builder.WriteString(strconv.Itoa(format.rate))
+	}
+	if format.channels > 0 {
+		builder.WriteString(";channels=")
+		builder.WriteString(strconv.Itoa(format.channels))
+	}
+	if format.endianness != "" {
+		builder.WriteString(";endianness=")
+		builder.WriteString(format.endianness)
+	}
+	return builder.String()
+}
+
+// SetAudioFormat : Sets ContentType to a validated audio format
+func (options *RecognizeOptions) SetAudioFormat(format AudioFormat) (*RecognizeOptions, error) {
+	if err := format.Validate(); err != nil {
+		return options, err
+	}
+	return options.SetContentType(format.String()), nil
+}
+
+// SetAudioFormat : Sets ContentType to a validated audio format
+func (options *CreateJobOptions) SetAudioFormat(format AudioFormat) (*CreateJobOptions, error) {
+	if err := format.Validate(); err != nil {
+		return options, err
+	}
+	return options.SetContentType(format.String()), nil
+}
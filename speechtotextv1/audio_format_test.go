@@ -0,0 +1,56 @@
+package speechtotextv1_test
+
+import (
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioFormat", func() {
+	Describe("String()", func() {
+		It("Renders the parameters of the format", func() {
+			format := speechtotextv1.NewAudioFormat("audio/l16").
+				WithRate(16000).
+				WithChannels(2).
+				WithEndianness(speechtotextv1.ENDIANNESS_LITTLE_ENDIAN)
+			Expect(format.String()).To(Equal("audio/l16;rate=16000;channels=2;endianness=little-endian"))
+			Expect(format.Validate()).To(Succeed())
+			Expect(speechtotextv1.NewAudioFormat("audio/mulaw").WithRate(8000).String()).To(Equal("audio/mulaw;rate=8000"))
+		})
+		It("Leaves templates unchanged", func() {
+			template := speechtotextv1.NewAudioFormat("audio/l16").WithRate(16000)
+			template.WithRate(8000)
+			Expect(template.Rate()).To(Equal(16000))
+		})
+	})
+	Describe("Validate()", func() {
+		It("Rejects missing and unsupported parameters", func() {
+			Expect(speechtotextv1.NewAudioFormat("audio/l16").Validate()).NotTo(Succeed())
+			Expect(speechtotextv1.NewAudioFormat("audio/mulaw").WithRate(8000).WithChannels(1).Validate()).NotTo(Succeed())
+			Expect(speechtotextv1.NewAudioFormat("audio/flac").WithRate(16000).Validate()).NotTo(Succeed())
+			Expect(speechtotextv1.NewAudioFormat("audio/l16").WithRate(16000).WithEndianness("middle").Validate()).NotTo(Succeed())
+		})
+	})
+	Describe("ParseAudioFormat(contentType string)", func() {
+		It("Parses a Content-Type header", func() {
+			format, err := speechtotextv1.ParseAudioFormat("audio/L16; rate=22050; endianness=big-endian")
+			Expect(err).To(BeNil())
+			Expect(format.MimeType()).To(Equal("audio/l16"))
+			Expect(format.Rate()).To(Equal(22050))
+			Expect(format.Endianness()).To(Equal(speechtotextv1.ENDIANNESS_BIG_ENDIAN))
+
+			format, err = speechtotextv1.ParseAudioFormat("audio/ogg;codecs=opus")
+			Expect(err).To(BeNil())
+			Expect(format.String()).To(Equal("audio/ogg;codecs=opus"))
+		})
+	})
+	Describe("SetAudioFormat(format AudioFormat)", func() {
+		It("Sets a valid format as the content type", func() {
+			testService := &speechtotextv1.SpeechToTextV1{}
+			recognizeOptions, err := testService.NewRecognizeOptions(nil).
+				SetAudioFormat(speechtotextv1.NewAudioFormat("audio/l16").WithRate(16000))
+			Expect(err).To(BeNil())
+			Expect(*recognizeOptions.ContentType).To(Equal("audio/l16;rate=16000"))
+		})
+	})
+})
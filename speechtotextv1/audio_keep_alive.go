@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+)
+
+// KEEP_ALIVE_SILENCE_DURATION is the duration of the silence that is sent each time the audio of a stream stalls for
+// the keep-alive interval.
+const KEEP_ALIVE_SILENCE_DURATION = 100 * time.Millisecond
+
+// keepAliveChunk : Audio read from the source of a stream, or the error that ended it
+type keepAliveChunk struct {
+	data []byte
+	err  error
+}
+
+// keepAliveAudio : Passes on the audio of a stream, and inserts silence whenever the source produces no audio for the
+// interval, so that the service does not close the connection while the source stalls. Silence is inserted only
+// between whole frames of uncompressed audio.
+type keepAliveAudio struct {
+	source    io.ReadCloser
+	chunks    chan keepAliveChunk
+	done      chan struct{}
+	interval  time.Duration
+	silence   []byte
+	frameSize int
+
+	pending   []byte
+	err       error
+	dataBytes int
+}
+
+// newKeepAliveAudio : Wraps the audio of a stream with a keep-alive sender. The content type must be `audio/l16`,
+// `audio/mulaw`, `audio/alaw`, or WAV audio with one of those encodings. The header of WAV audio is read before the
+// function returns.
+func newKeepAliveAudio(source io.ReadCloser, contentType string, interval time.Duration) (io.ReadCloser, error) {
+	var prefix []byte
+	mediaType, _ := parseAudioContentType(contentType)
+	format, err := ParseAudioFormat(contentType)
+	if mediaType == "audio/wav" || mediaType == "audio/wave" || mediaType == "audio/x-wav" {
+		var header *WAVHeader
+		header, prefix, err = readWAVHeader(source)
+		if err == nil {
+			format, err = header.RawAudioFormat()
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("keep-alive requires uncompressed audio: %v", err)
+	}
+
+	var silenceByte byte
+	frameSize := 1
+	switch format.MimeType() {
+	case "audio/l16":
+		frameSize = 2
+		if format.Channels() > 0 {
+			frameSize *= format.Channels()
+		}
+	case "audio/mulaw":
+		silenceByte = 0xFF
+	case "audio/alaw":
+		silenceByte = 0xD5
+	default:
+		return nil, fmt.Errorf("keep-alive requires uncompressed audio; audio of type '%s' cannot be padded with silence", contentType)
+	}
+	frames := int(KEEP_ALIVE_SILENCE_DURATION.Seconds() * float64(format.Rate()))
+	if frames < 1 {
+		frames = 1
+	}
+
+	audio := &keepAliveAudio{
+		source:    source,
+		chunks:    make(chan keepAliveChunk),
+		done:      make(chan struct{}),
+		interval:  interval,
+		silence:   bytes.Repeat([]byte{silenceByte}, frames*frameSize),
+		frameSize: frameSize,
+		pending:   prefix,
+		dataBytes: -len(prefix),
+	}
+	go audio.readSource()
+	return audio, nil
+}
+
+func (audio *keepAliveAudio) readSource() {
+	for {
+		buffer := make([]byte, 32*1024)
+		bytesRead, err := audio.source.Read(buffer)
+		if bytesRead > 0 || err != nil {
+			select {
+			case audio.chunks <- keepAliveChunk{data: buffer[:bytesRead], err: err}:
+			case <-audio.done:
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Read : Returns the audio of the source, or silence if the source produces no audio for the interval
+func (audio *keepAliveAudio) Read(p []byte) (int, error) {
+	for len(audio.pending) == 0 && audio.err == nil {
+		timer := time.NewTimer(audio.interval)
+		select {
+		case chunk := <-audio.chunks:
+			timer.Stop()
+			audio.pending, audio.err = chunk.data, chunk.err
+		case <-timer.C:
+			if audio.dataBytes >= 0 && audio.dataBytes%audio.frameSize == 0 {
+				length := len(audio.silence)
+				if length > len(p) {
+					length = len(p) - len(p)%audio.frameSize
+				}
+				if length > 0 {
+					return copy(p, audio.silence[:length]), nil
+				}
+			}
+		}
+	}
+	if len(audio.pending) > 0 {
+		bytesCopied := copy(p, audio.pending)
+		audio.pending = audio.pending[bytesCopied:]
+		audio.dataBytes += bytesCopied
+		return bytesCopied, nil
+	}
+	return 0, audio.err
+}
+
+// Close : Stops reading the source and closes it
+func (audio *keepAliveAudio) Close() error {
+	select {
+	case <-audio.done:
+		return nil
+	default:
+		close(audio.done)
+	}
+	return audio.source.Close()
+}
@@ -0,0 +1,107 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioKeepAlive", func() {
+	Describe("RecognizeStream(recognizeOptions *RecognizeOptions)", func() {
+		Context("Successfully - Send silence while the audio stalls", func() {
+			var received []byte
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				received, _ = ioutil.ReadAll(req.Body)
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"results": [], "result_index": 0}`)
+			}))
+			It("Succeed to call RecognizeStream", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				audioReader, audioWriter := io.Pipe()
+				go func() {
+					audioWriter.Write([]byte{1, 1, 1, 1})
+					time.Sleep(200 * time.Millisecond)
+					audioWriter.Write([]byte{2, 2})
+					audioWriter.Close()
+				}()
+
+				recognizeOptions := testService.NewRecognizeStreamOptions(audioReader, "audio/l16;rate=8000").
+					SetKeepAliveInterval(50 * time.Millisecond)
+				_, _, err := testService.RecognizeStream(recognizeOptions)
+				Expect(err).To(BeNil())
+				Expect(received[:4]).To(Equal([]byte{1, 1, 1, 1}))
+				Expect(received[len(received)-2:]).To(Equal([]byte{2, 2}))
+				Expect(len(received)).To(BeNumerically(">=", 6+1600))
+				for _, sample := range received[4 : len(received)-2] {
+					Expect(sample).To(Equal(byte(0)))
+				}
+			})
+		})
+		Context("Unsuccessfully - Keep-alive requires uncompressed audio", func() {
+			It("Fail to call RecognizeStream", func() {
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: "http://localhost",
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				recognizeOptions := testService.NewRecognizeStreamOptions(strings.NewReader("fLaC"), "audio/flac").
+					SetKeepAliveInterval(time.Second)
+				_, _, err := testService.RecognizeStream(recognizeOptions)
+				Expect(err).ToNot(BeNil())
+			})
+		})
+	})
+	Describe("Recognize(recognizeOptions *RecognizeOptions)", func() {
+		Context("Unsuccessfully - The request does not complete within its timeout", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				time.Sleep(500 * time.Millisecond)
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"results": []}`)
+			}))
+			It("Fail to call Recognize", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				recognizeOptions := testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader("audio"))).
+					SetContentType("audio/wav").
+					SetTimeout(50 * time.Millisecond)
+				_, _, err := testService.Recognize(recognizeOptions)
+				Expect(err).ToNot(BeNil())
+			})
+		})
+	})
+})
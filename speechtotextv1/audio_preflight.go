@@ -0,0 +1,331 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"time"
+)
+
+// Limits of the service that are checked by the pre-flight checks, in addition to MAX_RECOGNIZE_AUDIO_SIZE
+const (
+	MIN_AUDIO_SIZE                    = 100
+	MAX_JOB_AUDIO_SIZE                = 1024 * 1024 * 1024
+	MAX_AUDIO_RESOURCE_SIZE           = 100 * 1024 * 1024
+	MIN_ACOUSTIC_MODEL_AUDIO_DURATION = 10 * time.Minute
+	MAX_ACOUSTIC_MODEL_AUDIO_DURATION = 200 * time.Hour
+)
+
+// AUDIO_ESTIMATE_HEADER_LENGTH is the number of leading bytes of audio that are read to estimate its duration.
+const AUDIO_ESTIMATE_HEADER_LENGTH = 4096
+
+// AudioEstimate : The size and duration of audio, estimated from its headers without reading all of it
+type AudioEstimate struct {
+
+	// The content type of the audio, as given or as detected from its leading bytes.
+	ContentType string
+
+	// The size of the audio in bytes, or -1 if it is unknown.
+	Size int64
+
+	// The duration of the audio, or 0 if it is unknown. The duration is known for WAV and FLAC audio and, if the size
+	// is known, for `audio/l16`, `audio/mulaw`, and `audio/alaw` audio.
+	Duration time.Duration
+}
+
+// EstimateAudio : Estimates the size and duration of audio from its leading bytes and its size in bytes, which is -1
+// if it is unknown. If the content type is empty, it is detected from the leading bytes.
+func EstimateAudio(header []byte, size int64, contentType string) *AudioEstimate {
+	if contentType == "" {
+		contentType = DetectAudioContentType(header, "")
+	}
+	estimate := &AudioEstimate{ContentType: contentType, Size: size}
+
+	mediaType, _ := parseAudioContentType(contentType)
+	switch mediaType {
+	case "audio/wav", "audio/wave", "audio/x-wav":
+		wavHeader, _, err := readWAVHeader(bytes.NewReader(header))
+		if err != nil || wavHeader.Encoding != WAV_ENCODING_PCM {
+			break
+		}
+		dataSize := wavHeader.DataSize
+		if dataSize < 0 && size >= 0 {
+			dataSize = size - wavHeader.HeaderSize
+		}
+		bytesPerSecond := int64(wavHeader.SampleRate * wavHeader.Channels * wavHeader.BitsPerSample / 8)
+		if dataSize > 0 && bytesPerSecond > 0 {
+			estimate.Duration = time.Duration(float64(dataSize) / float64(bytesPerSecond) * float64(time.Second))
+		}
+	case "audio/flac":
+		estimate.Duration = flacDuration(header)
+	case "audio/l16", "audio/mulaw", "audio/basic", "audio/alaw":
+		format, err := ParseAudioFormat(contentType)
+		if err != nil || size < 0 || format.Rate() <= 0 {
+			break
+		}
+		bytesPerSecond := format.Rate()
+		if format.Channels() > 0 {
+			bytesPerSecond *= format.Channels()
+		}
+		if mediaType == "audio/l16" {
+			bytesPerSecond *= 2
+		}
+		estimate.Duration = time.Duration(float64(size) / float64(bytesPerSecond) * float64(time.Second))
+	}
+	return estimate
+}
+
+// flacDuration : Returns the duration of FLAC audio from its STREAMINFO block, or 0 if it is unknown
+func flacDuration(header []byte) time.Duration {
+	// "fLaC", the 4-byte header of the STREAMINFO block, and 10 bytes of block and frame sizes precede the
+	// 20-bit sampling rate, 3-bit channel count, 5-bit sample size, and 36-bit total number of samples
+	if len(header) < 26 || !bytes.HasPrefix(header, []byte("fLaC")) {
+		return 0
+	}
+	bits := binary.BigEndian.Uint64(header[18:26])
+	sampleRate := bits >> 44
+	totalSamples := bits & (1<<36 - 1)
+	if sampleRate == 0 || totalSamples == 0 {
+		return 0
+	}
+	return time.Duration(float64(totalSamples) / float64(sampleRate) * float64(time.Second))
+}
+
+// AudioLimitError : A limit of the service that a request would exceed, as found by a pre-flight check
+type AudioLimitError struct {
+
+	// The method whose request would exceed the limit, such as `Recognize`.
+	Method string
+
+	// A description of the limit and of how to stay within it.
+	Message string
+
+	// The estimate of the audio of the request, if the check examined it.
+	Estimate *AudioEstimate
+}
+
+// Error : Returns the method and the message of the error
+func (err *AudioLimitError) Error() string {
+	return fmt.Sprintf("%s: %s", err.Method, err.Message)
+}
+
+// PreflightWarningFunc : Called with the limit that a request would exceed when the request is sent anyway
+type PreflightWarningFunc func(err *AudioLimitError)
+
+// audioPreflight : Checks the audio of requests against the limits of the service before they are sent
+type audioPreflight struct {
+	warning PreflightWarningFunc
+}
+
+// EnablePreflightChecks : Check the audio of Recognize, CreateJob, and AddAudio requests, and the audio of custom
+// acoustic models that are trained, against the limits of the service before the requests are sent
+// Only audio of known size is checked: an AudioUpload, or a file opened with os.Open. Its size and duration are
+// estimated from its headers, and the headers are still sent with the request. Adding audio to and training a custom
+// acoustic model also list the audio of the model to check its total duration.
+//
+// If warning is nil, a request that would exceed a limit fails with an AudioLimitError and is not sent. Otherwise the
+// warning is called and the request is sent anyway.
+func (speechToText *SpeechToTextV1) EnablePreflightChecks(warning PreflightWarningFunc) {
+	speechToText.audioPreflight = &audioPreflight{warning: warning}
+}
+
+// DisablePreflightChecks : Stop checking requests against the limits of the service
+func (speechToText *SpeechToTextV1) DisablePreflightChecks() {
+	speechToText.audioPreflight = nil
+}
+
+// report : Returns the limit error, or calls the warning with it and returns nil
+func (preflight *audioPreflight) report(err *AudioLimitError) error {
+	if preflight.warning == nil {
+		return err
+	}
+	preflight.warning(err)
+	return nil
+}
+
+// checkRecognize : Checks the audio of a Recognize request
+func (preflight *audioPreflight) checkRecognize(audio io.Reader, contentType string) error {
+	estimate, err := estimateAudioBody(audio, contentType)
+	if err != nil || estimate == nil {
+		return err
+	}
+	if estimate.Size < MIN_AUDIO_SIZE {
+		return preflight.report(&AudioLimitError{
+			Method:   "Recognize",
+			Message:  fmt.Sprintf("the audio is %d bytes, but the service requires at least %d bytes", estimate.Size, MIN_AUDIO_SIZE),
+			Estimate: estimate,
+		})
+	}
+	if estimate.Size > MAX_RECOGNIZE_AUDIO_SIZE {
+		return preflight.report(&AudioLimitError{
+			Method:   "Recognize",
+			Message:  fmt.Sprintf("the audio is %s, which exceeds the limit of %s; send it with CreateJob, which accepts up to %s, or split it with RecognizeLargeAudio", formatAudioSize(estimate.Size), formatAudioSize(MAX_RECOGNIZE_AUDIO_SIZE), formatAudioSize(MAX_JOB_AUDIO_SIZE)),
+			Estimate: estimate,
+		})
+	}
+	return nil
+}
+
+// checkCreateJob : Checks the audio of a CreateJob request
+func (preflight *audioPreflight) checkCreateJob(audio io.Reader, contentType string) error {
+	estimate, err := estimateAudioBody(audio, contentType)
+	if err != nil || estimate == nil {
+		return err
+	}
+	if estimate.Size < MIN_AUDIO_SIZE {
+		return preflight.report(&AudioLimitError{
+			Method:   "CreateJob",
+			Message:  fmt.Sprintf("the audio is %d bytes, but the service requires at least %d bytes", estimate.Size, MIN_AUDIO_SIZE),
+			Estimate: estimate,
+		})
+	}
+	if estimate.Size > MAX_JOB_AUDIO_SIZE {
+		return preflight.report(&AudioLimitError{
+			Method:   "CreateJob",
+			Message:  fmt.Sprintf("the audio is %s, which exceeds the limit of %s; split it with SplitAudio and create a job for each segment, or compress it to a format such as audio/ogg;codecs=opus", formatAudioSize(estimate.Size), formatAudioSize(MAX_JOB_AUDIO_SIZE)),
+			Estimate: estimate,
+		})
+	}
+	return nil
+}
+
+// checkAddAudio : Checks the audio of an AddAudio request, and that it does not take the custom acoustic model over
+// its maximum duration of audio
+func (preflight *audioPreflight) checkAddAudio(ctx context.Context, speechToText *SpeechToTextV1, addAudioOptions *AddAudioOptions) error {
+	estimate, err := estimateAudioBody(addAudioOptions.AudioResource, stringOrEmpty(addAudioOptions.ContentType))
+	if err != nil || estimate == nil {
+		return err
+	}
+	if estimate.Size > MAX_AUDIO_RESOURCE_SIZE {
+		return preflight.report(&AudioLimitError{
+			Method:   "AddAudio",
+			Message:  fmt.Sprintf("the audio resource is %s, which exceeds the limit of %s; split it into several audio resources, or compress it to a format such as audio/ogg;codecs=opus", formatAudioSize(estimate.Size), formatAudioSize(MAX_AUDIO_RESOURCE_SIZE)),
+			Estimate: estimate,
+		})
+	}
+	if estimate.Duration <= 0 {
+		return nil
+	}
+
+	total, err := acousticModelAudioDuration(ctx, speechToText, *addAudioOptions.CustomizationID)
+	if err != nil {
+		return err
+	}
+	if total+estimate.Duration > MAX_ACOUSTIC_MODEL_AUDIO_DURATION {
+		return preflight.report(&AudioLimitError{
+			Method:   "AddAudio",
+			Message:  fmt.Sprintf("the custom acoustic model has %s of audio, and adding %s would exceed the limit of %s; delete audio resources with DeleteAudio first", formatAudioDuration(total), formatAudioDuration(estimate.Duration), formatAudioDuration(MAX_ACOUSTIC_MODEL_AUDIO_DURATION)),
+			Estimate: estimate,
+		})
+	}
+	return nil
+}
+
+// checkTrainAcousticModel : Checks that a custom acoustic model has enough audio to be trained
+func (preflight *audioPreflight) checkTrainAcousticModel(ctx context.Context, speechToText *SpeechToTextV1, customizationID string) error {
+	total, err := acousticModelAudioDuration(ctx, speechToText, customizationID)
+	if err != nil {
+		return err
+	}
+	if total < MIN_ACOUSTIC_MODEL_AUDIO_DURATION {
+		return preflight.report(&AudioLimitError{
+			Method:  "TrainAcousticModel",
+			Message: fmt.Sprintf("the custom acoustic model has %s of audio, but training requires at least %s; add at least %s more with AddAudio", formatAudioDuration(total), formatAudioDuration(MIN_ACOUSTIC_MODEL_AUDIO_DURATION), formatAudioDuration(MIN_ACOUSTIC_MODEL_AUDIO_DURATION-total)),
+		})
+	}
+	if total > MAX_ACOUSTIC_MODEL_AUDIO_DURATION {
+		return preflight.report(&AudioLimitError{
+			Method:  "TrainAcousticModel",
+			Message: fmt.Sprintf("the custom acoustic model has %s of audio, which exceeds the limit of %s; delete audio resources with DeleteAudio first", formatAudioDuration(total), formatAudioDuration(MAX_ACOUSTIC_MODEL_AUDIO_DURATION)),
+		})
+	}
+	return nil
+}
+
+// acousticModelAudioDuration : Returns the total duration of the audio of a custom acoustic model
+func acousticModelAudioDuration(ctx context.Context, speechToText *SpeechToTextV1, customizationID string) (time.Duration, error) {
+	audioResources, _, err := speechToText.ListAudioWithContext(ctx, speechToText.NewListAudioOptions(customizationID))
+	if err != nil {
+		return 0, err
+	}
+	if audioResources == nil || audioResources.TotalMinutesOfAudio == nil {
+		return 0, nil
+	}
+	return time.Duration(*audioResources.TotalMinutesOfAudio * float64(time.Minute)), nil
+}
+
+// estimateAudioBody : Estimates the audio of a request body of known size, without consuming its leading bytes.
+// Returns nil if the size of the body is unknown.
+func estimateAudioBody(audio io.Reader, contentType string) (*AudioEstimate, error) {
+	header := make([]byte, AUDIO_ESTIMATE_HEADER_LENGTH)
+	switch audio := audio.(type) {
+	case *AudioUpload:
+		bytesRead, err := io.ReadFull(audio.reader, header)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+		header = header[:bytesRead]
+		audio.reader = io.MultiReader(bytes.NewReader(header), audio.reader)
+		return EstimateAudio(header, audio.Size(), contentType), nil
+	case *os.File:
+		info, err := audio.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return nil, nil
+		}
+		offset, err := audio.Seek(0, io.SeekCurrent)
+		if err != nil || offset > info.Size() {
+			return nil, nil
+		}
+		bytesRead, err := audio.ReadAt(header, offset)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		return EstimateAudio(header[:bytesRead], info.Size()-offset, contentType), nil
+	}
+	return nil, nil
+}
+
+// formatAudioSize : Formats a number of bytes in the largest unit that fits
+func formatAudioSize(size int64) string {
+	switch {
+	case size >= 1024*1024*1024:
+		return fmt.Sprintf("%g GB", roundTenth(float64(size)/(1024*1024*1024)))
+	case size >= 1024*1024:
+		return fmt.Sprintf("%g MB", roundTenth(float64(size)/(1024*1024)))
+	case size >= 1024:
+		return fmt.Sprintf("%g KB", roundTenth(float64(size)/1024))
+	}
+	return fmt.Sprintf("%d bytes", size)
+}
+
+// formatAudioDuration : Formats a duration in minutes, or in hours if it is longer than two hours
+func formatAudioDuration(duration time.Duration) string {
+	if duration > 2*time.Hour {
+		return fmt.Sprintf("%g hours", roundTenth(duration.Hours()))
+	}
+	return fmt.Sprintf("%g minutes", roundTenth(duration.Minutes()))
+}
+
+func roundTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
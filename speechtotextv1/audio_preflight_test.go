@@ -0,0 +1,114 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioPreflight", func() {
+	Describe("EstimateAudio(header []byte, size int64, contentType string)", func() {
+		It("Succeed to call EstimateAudio", func() {
+			audio := wavFile(16000, 1, pcmTone(48000, 1000))
+			estimate := speechtotextv1.EstimateAudio(audio[:64], int64(len(audio)), "")
+			Expect(estimate.ContentType).To(Equal("audio/wav"))
+			Expect(estimate.Size).To(Equal(int64(len(audio))))
+			Expect(estimate.Duration).To(Equal(3 * time.Second))
+
+			estimate = speechtotextv1.EstimateAudio(nil, 80000, "audio/l16;rate=8000")
+			Expect(estimate.Duration).To(Equal(5 * time.Second))
+		})
+	})
+
+	Describe("Recognize(recognizeOptions *RecognizeOptions)", func() {
+		Context("Unsuccessfully - The audio exceeds the limit", func() {
+			It("Fail to call Recognize", func() {
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: "http://localhost",
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				testService.EnablePreflightChecks(nil)
+
+				audio := wavFile(16000, 1, pcmTone(1600, 1000))
+				upload := speechtotextv1.NewAudioUpload(bytes.NewReader(audio), 200*1024*1024)
+				recognizeOptions := testService.NewRecognizeOptions(upload).SetContentType("audio/wav")
+				_, _, err := testService.Recognize(recognizeOptions)
+				Expect(err).ToNot(BeNil())
+				limitErr, ok := err.(*speechtotextv1.AudioLimitError)
+				Expect(ok).To(BeTrue())
+				Expect(limitErr.Method).To(Equal("Recognize"))
+				Expect(limitErr.Message).To(ContainSubstring("CreateJob"))
+			})
+		})
+		Context("Successfully - Warn about the audio and send it anyway", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"results": [], "result_index": 0}`)
+			}))
+			It("Succeed to call Recognize", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				var warnings []*speechtotextv1.AudioLimitError
+				testService.EnablePreflightChecks(func(err *speechtotextv1.AudioLimitError) {
+					warnings = append(warnings, err)
+				})
+
+				upload := speechtotextv1.NewAudioUpload(bytes.NewReader(make([]byte, 50)), 50)
+				recognizeOptions := testService.NewRecognizeOptions(upload).SetContentType("audio/l16;rate=8000")
+				_, _, err := testService.Recognize(recognizeOptions)
+				Expect(err).To(BeNil())
+				Expect(warnings).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("TrainAcousticModel(trainAcousticModelOptions *TrainAcousticModelOptions)", func() {
+		Context("Unsuccessfully - The custom model has too little audio", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.URL.Path).To(Equal("/v1/acoustic_customizations/customization1/audio"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"total_minutes_of_audio": 4.5, "audio": []}`)
+			}))
+			It("Fail to call TrainAcousticModel", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				testService.EnablePreflightChecks(nil)
+
+				_, _, err := testService.TrainAcousticModel(testService.NewTrainAcousticModelOptions("customization1"))
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("add at least 5.5 minutes more"))
+			})
+		})
+	})
+})
@@ -0,0 +1,608 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+const (
+	// MAX_RECOGNIZE_AUDIO_SIZE is the largest audio, in bytes, that the service accepts in one request.
+	MAX_RECOGNIZE_AUDIO_SIZE = 100 * 1024 * 1024
+
+	DEFAULT_SPLIT_MAX_SEGMENT_SIZE        = 95 * 1024 * 1024
+	DEFAULT_SPLIT_SILENCE_THRESHOLD       = 500
+	DEFAULT_SPLIT_MIN_SILENCE_DURATION    = 200 * time.Millisecond
+	DEFAULT_SPLIT_SILENCE_SEARCH_DURATION = 30 * time.Second
+
+	wavHeaderSize = 44
+)
+
+// SplitAudioOptions : Options that control how long audio is divided into segments
+type SplitAudioOptions struct {
+
+	// The maximum size of a segment in bytes, including its header. Defaults to 95 MB.
+	MaxSegmentSize int64
+
+	// The peak amplitude of 16-bit samples below which audio is treated as silence. Defaults to 500.
+	SilenceThreshold int
+
+	// The minimum duration of a silence at which a segment can end. Defaults to 200 milliseconds.
+	MinSilenceDuration time.Duration
+
+	// How far before the maximum segment size to search for a silence. If no silence is found, the segment ends at
+	// the quietest point of this range. Defaults to 30 seconds.
+	SilenceSearchDuration time.Duration
+}
+
+// NewSplitAudioOptions : Instantiate SplitAudioOptions with the default values
+func NewSplitAudioOptions() *SplitAudioOptions {
+	return &SplitAudioOptions{
+		MaxSegmentSize:        DEFAULT_SPLIT_MAX_SEGMENT_SIZE,
+		SilenceThreshold:      DEFAULT_SPLIT_SILENCE_THRESHOLD,
+		MinSilenceDuration:    DEFAULT_SPLIT_MIN_SILENCE_DURATION,
+		SilenceSearchDuration: DEFAULT_SPLIT_SILENCE_SEARCH_DURATION,
+	}
+}
+
+// SetMaxSegmentSize : Allow user to set MaxSegmentSize
+func (options *SplitAudioOptions) SetMaxSegmentSize(maxSegmentSize int64) *SplitAudioOptions {
+	options.MaxSegmentSize = maxSegmentSize
+	return options
+}
+
+// SetSilenceThreshold : Allow user to set SilenceThreshold
+func (options *SplitAudioOptions) SetSilenceThreshold(silenceThreshold int) *SplitAudioOptions {
+	options.SilenceThreshold = silenceThreshold
+	return options
+}
+
+// SetMinSilenceDuration : Allow user to set MinSilenceDuration
+func (options *SplitAudioOptions) SetMinSilenceDuration(minSilenceDuration time.Duration) *SplitAudioOptions {
+	options.MinSilenceDuration = minSilenceDuration
+	return options
+}
+
+// SetSilenceSearchDuration : Allow user to set SilenceSearchDuration
+func (options *SplitAudioOptions) SetSilenceSearchDuration(silenceSearchDuration time.Duration) *SplitAudioOptions {
+	options.SilenceSearchDuration = silenceSearchDuration
+	return options
+}
+
+// AudioSegment : A part of long audio that can be recognized on its own
+type AudioSegment struct {
+
+	// The audio of the segment, including a header if the format requires one.
+	Audio []byte
+
+	// The content type of the segment.
+	ContentType string
+
+	// The time in seconds at which the segment starts in the original audio.
+	Offset float64
+}
+
+// pcmFormat : The layout of uncompressed 16-bit audio
+type pcmFormat struct {
+	sampleRate int
+	channels   int
+}
+
+func (format pcmFormat) frameSize() int {
+	return 2 * format.channels
+}
+
+func (format pcmFormat) bytesPerSecond() float64 {
+	return float64(format.sampleRate * format.frameSize())
+}
+
+// SplitWAV : Divides 16-bit PCM WAV audio into WAV segments that do not exceed the maximum segment size. Segments
+// end at silences where possible, so that words are not cut in two.
+func SplitWAV(audio []byte, options *SplitAudioOptions) ([]AudioSegment, error) {
+	splitter, err := newWAVSegmentReader(bytes.NewReader(audio), options)
+	if err != nil {
+		return nil, err
+	}
+	return readSegments(splitter)
+}
+
+// SplitPCM : Divides raw little-endian 16-bit PCM audio into audio/l16 segments that do not exceed the maximum
+// segment size. Segments end at silences where possible, so that words are not cut in two.
+func SplitPCM(audio []byte, sampleRate int, channels int, options *SplitAudioOptions) ([]AudioSegment, error) {
+	splitter, err := newPCMSegmentReader(bytes.NewReader(audio), sampleRate, channels, options)
+	if err != nil {
+		return nil, err
+	}
+	return readSegments(splitter)
+}
+
+// SplitAudio : Divides audio of the given content type into segments. WAV, audio/l16, and FLAC audio can be split, as
+// described for SplitWAV, SplitPCM, and SplitFLAC; other formats must be decoded to WAV first.
+func SplitAudio(audio []byte, contentType string, options *SplitAudioOptions) ([]AudioSegment, error) {
+	splitter, err := newSegmentReader(bytes.NewReader(audio), contentType, options)
+	if err != nil {
+		return nil, err
+	}
+	return readSegments(splitter)
+}
+
+// RecognizeLargeAudio : Recognize audio that exceeds the size limit of the service
+// Splits the audio into segments at silences, recognizes each segment, and stitches the results together. Audio that
+// is within the limit is recognized with a single request. The content type is taken from recognizeOptions, or
+// detected if it is not set. Audio and ContentType of recognizeOptions are ignored otherwise. WAV, audio/l16, and FLAC
+// audio can be split, as described for SplitAudio; larger audio of other formats fails. The audio is read one segment
+// at a time, so that it is never held in memory as a whole.
+func (speechToText *SpeechToTextV1) RecognizeLargeAudio(audio io.Reader, recognizeOptions *RecognizeOptions, splitOptions *SplitAudioOptions) (*SpeechRecognitionResults, error) {
+	return speechToText.RecognizeLargeAudioWithContext(context.Background(), audio, recognizeOptions, splitOptions)
+}
+
+// RecognizeLargeAudioWithContext is an alternate form of the RecognizeLargeAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RecognizeLargeAudioWithContext(ctx context.Context, audio io.Reader, recognizeOptions *RecognizeOptions, splitOptions *SplitAudioOptions) (*SpeechRecognitionResults, error) {
+	splitOptions = splitOptionsWithDefaults(splitOptions)
+
+	// Read just enough of the audio to tell whether it must be split
+	head, err := ioutil.ReadAll(io.LimitReader(audio, splitOptions.MaxSegmentSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := DetectAudioContentType(head, "")
+	if recognizeOptions != nil && recognizeOptions.ContentType != nil {
+		contentType = *recognizeOptions.ContentType
+	}
+
+	if int64(len(head)) <= splitOptions.MaxSegmentSize {
+		segment := AudioSegment{Audio: head, ContentType: contentType}
+		result, err := speechToText.recognizeSegment(ctx, recognizeOptions, segment)
+		if err != nil {
+			return nil, err
+		}
+		return StitchRecognitionResults([]AudioSegment{segment}, []*SpeechRecognitionResults{result}), nil
+	}
+
+	splitter, err := newSegmentReader(io.MultiReader(bytes.NewReader(head), audio), contentType, splitOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []AudioSegment
+	var results []*SpeechRecognitionResults
+	for {
+		segment, err := splitter.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		result, err := speechToText.recognizeSegment(ctx, recognizeOptions, *segment)
+		if err != nil {
+			return nil, err
+		}
+		// Only the offset of the segment is needed to stitch the results
+		segment.Audio = nil
+		segments = append(segments, *segment)
+		results = append(results, result)
+	}
+	return StitchRecognitionResults(segments, results), nil
+}
+
+// recognizeSegment : Recognizes the audio of one segment with the other parameters of recognizeOptions
+func (speechToText *SpeechToTextV1) recognizeSegment(ctx context.Context, recognizeOptions *RecognizeOptions, segment AudioSegment) (*SpeechRecognitionResults, error) {
+	segmentOptions := speechToText.NewRecognizeOptions(nil)
+	if recognizeOptions != nil {
+		*segmentOptions = *recognizeOptions
+	}
+	segmentOptions.Audio = ioutil.NopCloser(bytes.NewReader(segment.Audio))
+	segmentOptions.ContentType = core.StringPtr(segment.ContentType)
+
+	result, _, err := speechToText.RecognizeWithContext(ctx, segmentOptions)
+	if err != nil {
+		return nil, fmt.Errorf("segment at %.3f seconds: %v", segment.Offset, err)
+	}
+	return result, nil
+}
+
+// StitchRecognitionResults : Combines the results of audio segments into the results of the original audio by
+// shifting the times of words, keywords, word alternatives, and speaker labels by the offset of each segment.
+// Processing and audio metrics are not combined. Speaker labels are numbered independently in each segment.
+func StitchRecognitionResults(segments []AudioSegment, results []*SpeechRecognitionResults) *SpeechRecognitionResults {
+	stitched := &SpeechRecognitionResults{ResultIndex: core.Int64Ptr(0)}
+	for i, result := range results {
+		if result == nil || i >= len(segments) {
+			continue
+		}
+		offset := segments[i].Offset
+		for _, recognitionResult := range result.Results {
+			stitched.Results = append(stitched.Results, shiftRecognitionResult(recognitionResult, offset))
+		}
+		for _, speakerLabel := range result.SpeakerLabels {
+			if speakerLabel.From != nil {
+				speakerLabel.From = core.Float32Ptr(*speakerLabel.From + float32(offset))
+			}
+			if speakerLabel.To != nil {
+				speakerLabel.To = core.Float32Ptr(*speakerLabel.To + float32(offset))
+			}
+			stitched.SpeakerLabels = append(stitched.SpeakerLabels, speakerLabel)
+		}
+		stitched.Warnings = append(stitched.Warnings, result.Warnings...)
+	}
+	return stitched
+}
+
+func shiftRecognitionResult(result SpeechRecognitionResult, offset float64) SpeechRecognitionResult {
+	return mapRecognitionResultTimes(result, func(seconds float64) float64 {
+		return seconds + offset
+	})
+}
+
+// mapRecognitionResultTimes : Returns a copy of the result with the times of words, keywords, and word alternatives
+// mapped by the function
+func mapRecognitionResultTimes(result SpeechRecognitionResult, mapTime func(float64) float64) SpeechRecognitionResult {
+	mapped := result
+	mapped.Alternatives = make([]SpeechRecognitionAlternative, len(result.Alternatives))
+	for i, alternative := range result.Alternatives {
+		mapped.Alternatives[i] = alternative
+		mapped.Alternatives[i].Timestamps = make([]WordTimestamp, len(alternative.Timestamps))
+		for j, timestamp := range alternative.Timestamps {
+			mapped.Alternatives[i].Timestamps[j] = WordTimestamp{
+				Word:  timestamp.Word,
+				Start: mapTime(timestamp.Start),
+				End:   mapTime(timestamp.End),
+			}
+		}
+	}
+
+	if result.KeywordsResult != nil {
+		mapped.KeywordsResult = map[string][]KeywordResult{}
+		for keyword, matches := range result.KeywordsResult {
+			for _, match := range matches {
+				match.StartTime = mapTimePtr(match.StartTime, mapTime)
+				match.EndTime = mapTimePtr(match.EndTime, mapTime)
+				mapped.KeywordsResult[keyword] = append(mapped.KeywordsResult[keyword], match)
+			}
+		}
+	}
+
+	mapped.WordAlternatives = nil
+	for _, wordAlternatives := range result.WordAlternatives {
+		wordAlternatives.StartTime = mapTimePtr(wordAlternatives.StartTime, mapTime)
+		wordAlternatives.EndTime = mapTimePtr(wordAlternatives.EndTime, mapTime)
+		mapped.WordAlternatives = append(mapped.WordAlternatives, wordAlternatives)
+	}
+	return mapped
+}
+
+func mapTimePtr(seconds *float64, mapTime func(float64) float64) *float64 {
+	if seconds == nil {
+		return nil
+	}
+	return core.Float64Ptr(mapTime(*seconds))
+}
+
+// segmentReader : Reads audio one segment at a time, so that long audio is never held in memory as a whole
+type segmentReader interface {
+
+	// next returns the next segment, or io.EOF after the last one.
+	next() (*AudioSegment, error)
+}
+
+// newSegmentReader : Returns the segment reader for audio of the content type
+func newSegmentReader(reader io.Reader, contentType string, options *SplitAudioOptions) (segmentReader, error) {
+	mediaType, parameters := parseAudioContentType(contentType)
+	switch mediaType {
+	case "audio/wav", "audio/wave", "audio/x-wav":
+		return newWAVSegmentReader(reader, options)
+	case "audio/l16":
+		if parameters["endianness"] == "big-endian" {
+			return nil, fmt.Errorf("big-endian audio/l16 audio cannot be split")
+		}
+		sampleRate, _ := strconv.Atoi(parameters["rate"])
+		channels := 1
+		if parameters["channels"] != "" {
+			channels, _ = strconv.Atoi(parameters["channels"])
+		}
+		return newPCMSegmentReader(reader, sampleRate, channels, options)
+	case "audio/flac":
+		return newFLACSegmentReader(reader, options)
+	}
+	return nil, fmt.Errorf("audio of type '%s' cannot be split; only WAV, FLAC, and audio/l16 audio is supported, so decode it to WAV first", contentType)
+}
+
+// readSegments : Reads all segments of the segment reader
+func readSegments(splitter segmentReader) ([]AudioSegment, error) {
+	var segments []AudioSegment
+	for {
+		segment, err := splitter.next()
+		if err == io.EOF {
+			return segments, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, *segment)
+	}
+}
+
+// pcmSegmentReader : Reads 16-bit PCM samples one segment at a time, ending segments at silences where possible
+type pcmSegmentReader struct {
+	reader      io.Reader
+	format      pcmFormat
+	options     *SplitAudioOptions
+	maxBytes    int
+	contentType string
+	wrap        func(samples []byte, format pcmFormat) []byte
+	buffer      []byte
+	offset      int64
+	emitted     bool
+}
+
+// newWAVSegmentReader : Reads the header of 16-bit PCM WAV audio, up to its samples, which are split into WAV segments
+func newWAVSegmentReader(reader io.Reader, options *SplitAudioOptions) (*pcmSegmentReader, error) {
+	header, err := ReadWAVHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+	if (header.Encoding != WAV_ENCODING_PCM && header.Encoding != WAV_ENCODING_EXTENSIBLE) || header.BitsPerSample != 16 {
+		return nil, fmt.Errorf("the WAV audio is not 16-bit PCM")
+	}
+	if header.Channels <= 0 || header.SampleRate <= 0 {
+		return nil, fmt.Errorf("invalid WAV format: rate %d, channels %d", header.SampleRate, header.Channels)
+	}
+	if header.DataSize >= 0 {
+		reader = io.LimitReader(reader, header.DataSize)
+	}
+
+	options = splitOptionsWithDefaults(options)
+	format := pcmFormat{sampleRate: header.SampleRate, channels: header.Channels}
+	return &pcmSegmentReader{
+		reader:      reader,
+		format:      format,
+		options:     options,
+		maxBytes:    maxSegmentFrameBytes(options.MaxSegmentSize-wavHeaderSize, format),
+		contentType: "audio/wav",
+		wrap:        buildWAV,
+	}, nil
+}
+
+// newPCMSegmentReader : Reads raw little-endian 16-bit PCM audio, which is split into audio/l16 segments
+func newPCMSegmentReader(reader io.Reader, sampleRate int, channels int, options *SplitAudioOptions) (*pcmSegmentReader, error) {
+	if sampleRate <= 0 || channels <= 0 {
+		return nil, fmt.Errorf("invalid PCM format: rate %d, channels %d", sampleRate, channels)
+	}
+	options = splitOptionsWithDefaults(options)
+	format := pcmFormat{sampleRate: sampleRate, channels: channels}
+	return &pcmSegmentReader{
+		reader:      reader,
+		format:      format,
+		options:     options,
+		maxBytes:    maxSegmentFrameBytes(options.MaxSegmentSize, format),
+		contentType: fmt.Sprintf("audio/l16;rate=%d;channels=%d;endianness=little-endian", sampleRate, channels),
+	}, nil
+}
+
+// next : Returns the next segment, which ends at a silence if the remaining samples exceed the maximum segment size
+func (splitter *pcmSegmentReader) next() (*AudioSegment, error) {
+	// Read one byte past the limit to tell whether the remaining samples fit in one segment
+	var err error
+	splitter.buffer, _, err = fillBuffer(splitter.reader, splitter.buffer, splitter.maxBytes+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(splitter.buffer) == 0 && splitter.emitted {
+		return nil, io.EOF
+	}
+
+	end := len(splitter.buffer)
+	if end > splitter.maxBytes {
+		frameSize := splitter.format.frameSize()
+		silenceBytes := durationBytes(splitter.options.MinSilenceDuration, splitter.format)
+		searchBytes := durationBytes(splitter.options.SilenceSearchDuration, splitter.format)
+		end = findSplitPoint(splitter.buffer, 0, splitter.maxBytes, frameSize, silenceBytes, searchBytes, splitter.options.SilenceThreshold)
+	}
+
+	segment := &AudioSegment{
+		Audio:       splitter.buffer[:end],
+		ContentType: splitter.contentType,
+		Offset:      float64(splitter.offset) / splitter.format.bytesPerSecond(),
+	}
+	if splitter.wrap != nil {
+		segment.Audio = splitter.wrap(segment.Audio, splitter.format)
+	}
+	splitter.buffer = splitter.buffer[end:]
+	splitter.offset += int64(end)
+	splitter.emitted = true
+	return segment, nil
+}
+
+// maxSegmentFrameBytes : Returns the largest whole number of frames, and at least one, that fit in the segment size
+func maxSegmentFrameBytes(maxSegmentBytes int64, format pcmFormat) int {
+	frameSize := format.frameSize()
+	maxBytes := int(maxSegmentBytes) / frameSize * frameSize
+	if maxBytes < frameSize {
+		maxBytes = frameSize
+	}
+	return maxBytes
+}
+
+// fillBuffer : Reads from the reader until the buffer holds size bytes, and reports whether the reader ended first
+func fillBuffer(reader io.Reader, buffer []byte, size int) ([]byte, bool, error) {
+	if len(buffer) >= size {
+		return buffer, false, nil
+	}
+	if cap(buffer) < size {
+		grown := make([]byte, len(buffer), size)
+		copy(grown, buffer)
+		buffer = grown
+	}
+	bytesRead, err := io.ReadFull(reader, buffer[len(buffer):size])
+	buffer = buffer[:len(buffer)+bytesRead]
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return buffer, true, nil
+	}
+	return buffer, false, err
+}
+
+// findSplitPoint : Finds the end of a segment that starts at start and must end by limit. Returns the middle of the
+// latest silent block in the search range, or of the quietest block if none is silent.
+func findSplitPoint(data []byte, start int, limit int, frameSize int, blockBytes int, searchBytes int, threshold int) int {
+	halfBlock := blockBytes / frameSize / 2 * frameSize
+	searchStart := limit - searchBytes
+	if searchStart < start+blockBytes {
+		searchStart = start + blockBytes
+	}
+
+	bestBlock, bestPeak := -1, 0
+	for blockEnd := limit; blockEnd-blockBytes >= searchStart; blockEnd -= blockBytes {
+		peak := peakAmplitude(data[blockEnd-blockBytes : blockEnd])
+		if peak < threshold {
+			return blockEnd - halfBlock
+		}
+		if bestBlock < 0 || peak < bestPeak {
+			bestBlock, bestPeak = blockEnd, peak
+		}
+	}
+	if bestBlock < 0 {
+		return limit
+	}
+	return bestBlock - halfBlock
+}
+
+// peakAmplitude : Returns the largest absolute value of the little-endian 16-bit samples
+func peakAmplitude(samples []byte) int {
+	peak := 0
+	for i := 0; i+1 < len(samples); i += 2 {
+		sample := int(int16(binary.LittleEndian.Uint16(samples[i:])))
+		if sample < 0 {
+			sample = -sample
+		}
+		if sample > peak {
+			peak = sample
+		}
+	}
+	return peak
+}
+
+func durationBytes(duration time.Duration, format pcmFormat) int {
+	frames := int(duration.Seconds() * float64(format.sampleRate))
+	if frames < 1 {
+		frames = 1
+	}
+	return frames * format.frameSize()
+}
+
+// parseWAV : Returns the format and the samples of 16-bit PCM WAV audio
+func parseWAV(audio []byte) (pcmFormat, []byte, error) {
+	var format pcmFormat
+	if len(audio) < 12 || string(audio[0:4]) != "RIFF" || string(audio[8:12]) != "WAVE" {
+		return format, nil, fmt.Errorf("the audio is not WAV audio")
+	}
+
+	haveFormat := false
+	for position := 12; position+8 <= len(audio); {
+		chunkID := string(audio[position : position+4])
+		chunkSize := int(binary.LittleEndian.Uint32(audio[position+4:]))
+		body := position + 8
+		switch chunkID {
+		case "fmt ":
+			if body+16 > len(audio) {
+				return format, nil, fmt.Errorf("the WAV format chunk is truncated")
+			}
+			audioFormat := binary.LittleEndian.Uint16(audio[body:])
+			bitsPerSample := binary.LittleEndian.Uint16(audio[body+14:])
+			if (audioFormat != 1 && audioFormat != 0xFFFE) || bitsPerSample != 16 {
+				return format, nil, fmt.Errorf("the WAV audio is not 16-bit PCM")
+			}
+			format.channels = int(binary.LittleEndian.Uint16(audio[body+2:]))
+			format.sampleRate = int(binary.LittleEndian.Uint32(audio[body+4:]))
+			haveFormat = format.channels > 0 && format.sampleRate > 0
+		case "data":
+			if !haveFormat {
+				return format, nil, fmt.Errorf("the WAV data chunk precedes the format chunk")
+			}
+			end := body + chunkSize
+			// Streamed WAV files can have an unknown data size
+			if end > len(audio) || end < body {
+				end = len(audio)
+			}
+			return format, audio[body:end], nil
+		}
+		position = body + chunkSize + chunkSize%2
+	}
+	return format, nil, fmt.Errorf("the WAV audio has no data chunk")
+}
+
+// buildWAV : Builds a canonical 44-byte header WAV file for the samples
+func buildWAV(samples []byte, format pcmFormat) []byte {
+	wav := make([]byte, wavHeaderSize, wavHeaderSize+len(samples))
+	copy(wav[0:], "RIFF")
+	binary.LittleEndian.PutUint32(wav[4:], uint32(36+len(samples)))
+	copy(wav[8:], "WAVEfmt ")
+	binary.LittleEndian.PutUint32(wav[16:], 16)
+	binary.LittleEndian.PutUint16(wav[20:], 1)
+	binary.LittleEndian.PutUint16(wav[22:], uint16(format.channels))
+	binary.LittleEndian.PutUint32(wav[24:], uint32(format.sampleRate))
+	binary.LittleEndian.PutUint32(wav[28:], uint32(format.sampleRate*format.frameSize()))
+	binary.LittleEndian.PutUint16(wav[32:], uint16(format.frameSize()))
+	binary.LittleEndian.PutUint16(wav[34:], 16)
+	copy(wav[36:], "data")
+	binary.LittleEndian.PutUint32(wav[40:], uint32(len(samples)))
+	return append(wav, samples...)
+}
+
+// parseAudioContentType : Splits a content type such as `audio/l16;rate=16000` into its media type and parameters
+func parseAudioContentType(contentType string) (string, map[string]string) {
+	parts := strings.Split(contentType, ";")
+	parameters := map[string]string{}
+	for _, part := range parts[1:] {
+		keyValue := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(keyValue) == 2 {
+			parameters[strings.ToLower(keyValue[0])] = strings.TrimSpace(keyValue[1])
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(parts[0])), parameters
+}
+
+func splitOptionsWithDefaults(options *SplitAudioOptions) *SplitAudioOptions {
+	withDefaults := NewSplitAudioOptions()
+	if options == nil {
+		return withDefaults
+	}
+	if options.MaxSegmentSize > 0 {
+		withDefaults.MaxSegmentSize = options.MaxSegmentSize
+	}
+	if options.SilenceThreshold > 0 {
+		withDefaults.SilenceThreshold = options.SilenceThreshold
+	}
+	if options.MinSilenceDuration > 0 {
+		withDefaults.MinSilenceDuration = options.MinSilenceDuration
+	}
+	if options.SilenceSearchDuration > 0 {
+		withDefaults.SilenceSearchDuration = options.SilenceSearchDuration
+	}
+	return withDefaults
+}
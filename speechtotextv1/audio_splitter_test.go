@@ -0,0 +1,180 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// pcmTone returns mono 16-bit samples that alternate between amplitude and -amplitude
+func pcmTone(samples int, amplitude int16) []byte {
+	pcm := make([]byte, 2*samples)
+	for i := 0; i < samples; i++ {
+		sample := amplitude
+		if i%2 == 1 {
+			sample = -amplitude
+		}
+		binary.LittleEndian.PutUint16(pcm[2*i:], uint16(sample))
+	}
+	return pcm
+}
+
+// countingReader counts the bytes that were read from the reader
+type countingReader struct {
+	reader io.Reader
+	read   int
+}
+
+func (reader *countingReader) Read(p []byte) (int, error) {
+	n, err := reader.reader.Read(p)
+	reader.read += n
+	return n, err
+}
+
+var _ = Describe("AudioSplitter", func() {
+	// Three seconds of speech at 8000 Hz separated by two half-second silences
+	var pcm []byte
+	pcm = append(pcm, pcmTone(8000, 5000)...)
+	pcm = append(pcm, pcmTone(4000, 0)...)
+	pcm = append(pcm, pcmTone(8000, 5000)...)
+	pcm = append(pcm, pcmTone(4000, 0)...)
+	pcm = append(pcm, pcmTone(8000, 5000)...)
+
+	Describe("SplitAudio(audio []byte, contentType string, options *SplitAudioOptions)", func() {
+		It("Splits PCM audio at silences", func() {
+			options := speechtotextv1.NewSplitAudioOptions().
+				SetMaxSegmentSize(2 * 16000).
+				SetSilenceSearchDuration(2 * time.Second)
+			segments, err := speechtotextv1.SplitAudio(pcm, "audio/l16; rate=8000", options)
+			Expect(err).To(BeNil())
+			Expect(segments).To(HaveLen(3))
+			Expect(segments[0].ContentType).To(Equal("audio/l16;rate=8000;channels=1;endianness=little-endian"))
+			Expect(segments[1].Offset).To(BeNumerically("~", 1.3, 0.01))
+			Expect(segments[2].Offset).To(BeNumerically("~", 2.8, 0.01))
+
+			total := 0
+			for _, segment := range segments {
+				Expect(len(segment.Audio)).To(BeNumerically("<=", 2*16000))
+				total += len(segment.Audio)
+			}
+			Expect(total).To(Equal(len(pcm)))
+		})
+		It("Splits FLAC audio between frames", func() {
+			encoder := &speechtotextv1.FLACEncoder{BlockSize: 1000}
+			flac, _, err := encoder.Encode(pcm, speechtotextv1.NewAudioFormat("audio/l16").WithRate(8000))
+			Expect(err).To(BeNil())
+			const headerSize = 42
+			frames := flac[headerSize:]
+
+			options := speechtotextv1.NewSplitAudioOptions().SetMaxSegmentSize(int64(len(flac)) / 3)
+			segments, err := speechtotextv1.SplitAudio(flac, "audio/flac", options)
+			Expect(err).To(BeNil())
+			Expect(len(segments)).To(BeNumerically(">=", 3))
+
+			var joined []byte
+			var totalSamples int64
+			for i, segment := range segments {
+				Expect(segment.ContentType).To(Equal("audio/flac"))
+				Expect(len(segment.Audio)).To(BeNumerically("<=", len(flac)/3))
+				Expect(segment.Audio[:headerSize-34]).To(Equal([]byte{'f', 'L', 'a', 'C', 0x80, 0, 0, 34}))
+				Expect(segment.Audio[headerSize : headerSize+2]).To(Equal([]byte{0xFF, 0xF8}))
+				Expect(segment.Offset).To(BeNumerically("~", float64(totalSamples)/8000, 0.0001))
+
+				streamInfo := segment.Audio[8:headerSize]
+				samples := int64(streamInfo[13]&0x0F)<<32 | int64(binary.BigEndian.Uint32(streamInfo[14:]))
+				if i < len(segments)-1 {
+					Expect(samples % 1000).To(BeZero())
+				}
+				totalSamples += samples
+				joined = append(joined, segment.Audio[headerSize:]...)
+			}
+			Expect(totalSamples).To(Equal(int64(len(pcm) / 2)))
+			Expect(joined).To(Equal(frames))
+		})
+		It("Fails for audio that cannot be split", func() {
+			_, err := speechtotextv1.SplitAudio([]byte("ID3"), "audio/mpeg", nil)
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("decode it to WAV first"))
+		})
+	})
+	Describe("RecognizeLargeAudio(audio io.Reader, recognizeOptions *RecognizeOptions, splitOptions *SplitAudioOptions)", func() {
+		Context("Successfully - Recognize the segments as the audio is read", func() {
+			wav := wavFile(8000, 1, pcm)
+			audio := &countingReader{reader: bytes.NewReader(wav)}
+			var readAtRequests []int
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				Expect(req.Header.Get("Content-Type")).To(Equal("audio/wav"))
+				readAtRequests = append(readAtRequests, audio.read)
+
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"results": [{"final": true, "alternatives": [{"transcript": "hello ",
+					"timestamps": [["hello", 0.5, 0.9]]}]}], "result_index": 0}`)
+			}))
+			It("Succeed to call RecognizeLargeAudio", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				splitOptions := speechtotextv1.NewSplitAudioOptions().
+					SetMaxSegmentSize(2*16000 + 44).
+					SetSilenceSearchDuration(2 * time.Second)
+				results, err := testService.RecognizeLargeAudio(audio, nil, splitOptions)
+				Expect(err).To(BeNil())
+				Expect(results.Results).To(HaveLen(3))
+				Expect(results.Results[1].Alternatives[0].Timestamps[0].Start).To(BeNumerically("~", 1.8, 0.01))
+
+				// The first segment is recognized before the rest of the audio is read
+				Expect(readAtRequests).To(HaveLen(3))
+				Expect(readAtRequests[0]).To(BeNumerically("<", len(wav)))
+			})
+		})
+	})
+	Describe("StitchRecognitionResults(segments []AudioSegment, results []*SpeechRecognitionResults)", func() {
+		It("Shifts the times of each segment by its offset", func() {
+			segments := []speechtotextv1.AudioSegment{{Offset: 0}, {Offset: 90}}
+			segmentResult := func(word string) *speechtotextv1.SpeechRecognitionResults {
+				return &speechtotextv1.SpeechRecognitionResults{
+					Results: []speechtotextv1.SpeechRecognitionResult{{
+						Final: core.BoolPtr(true),
+						Alternatives: []speechtotextv1.SpeechRecognitionAlternative{{
+							Transcript: core.StringPtr(word),
+							Timestamps: []speechtotextv1.WordTimestamp{{Word: word, Start: 1.5, End: 2}},
+						}},
+						KeywordsResult: map[string][]speechtotextv1.KeywordResult{
+							word: {{NormalizedText: core.StringPtr(word), StartTime: core.Float64Ptr(1.5), EndTime: core.Float64Ptr(2), Confidence: core.Float64Ptr(1)}},
+						},
+					}},
+				}
+			}
+
+			stitched := speechtotextv1.StitchRecognitionResults(segments, []*speechtotextv1.SpeechRecognitionResults{
+				segmentResult("hello"),
+				segmentResult("world"),
+			})
+			Expect(stitched.Results).To(HaveLen(2))
+			Expect(stitched.Results[0].Alternatives[0].Timestamps[0].Start).To(Equal(1.5))
+			Expect(stitched.Results[1].Alternatives[0].Timestamps[0]).To(Equal(speechtotextv1.WordTimestamp{Word: "world", Start: 91.5, End: 92}))
+			Expect(*stitched.Results[1].KeywordsResult["world"][0].StartTime).To(Equal(91.5))
+		})
+	})
+})
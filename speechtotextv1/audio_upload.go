@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"io"
+	"net/http"
+	"os"
+)
+
+// UploadProgressFunc : Called as the body of a request is sent, with the number of bytes sent so far and the total
+// number of bytes
+type UploadProgressFunc func(sent int64, total int64)
+
+// AudioUpload : An audio request body of known length
+// AddAudio and Recognize stream an AudioUpload to the service with a Content-Length header, instead of with chunked
+// transfer encoding, so that large archives are neither buffered in memory nor rejected by proxies that require the
+// length. An optional progress function reports the bytes that have been sent.
+type AudioUpload struct {
+	reader   io.Reader
+	closer   io.Closer
+	size     int64
+	sent     int64
+	progress UploadProgressFunc
+}
+
+// NewAudioUpload : Instantiate AudioUpload for size bytes of the reader. If the reader is an io.Closer, it is closed
+// with the upload.
+func NewAudioUpload(reader io.Reader, size int64) *AudioUpload {
+	upload := &AudioUpload{
+		reader: io.LimitReader(reader, size),
+		size:   size,
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		upload.closer = closer
+	}
+	return upload
+}
+
+// OpenAudioUpload : Opens a file as an AudioUpload of the size of the file
+func OpenAudioUpload(fileName string) (*AudioUpload, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return NewAudioUpload(file, info.Size()), nil
+}
+
+// SetProgress : Allow user to set the function that is called as the upload is sent
+func (upload *AudioUpload) SetProgress(progress UploadProgressFunc) *AudioUpload {
+	upload.progress = progress
+	return upload
+}
+
+// Size : The number of bytes of the upload
+func (upload *AudioUpload) Size() int64 {
+	return upload.size
+}
+
+// Read : Reads from the underlying reader and reports the progress
+func (upload *AudioUpload) Read(p []byte) (int, error) {
+	n, err := upload.reader.Read(p)
+	if n > 0 {
+		upload.sent += int64(n)
+		if upload.progress != nil {
+			upload.progress(upload.sent, upload.size)
+		}
+	}
+	return n, err
+}
+
+// Close : Closes the underlying reader, if it is an io.Closer
+func (upload *AudioUpload) Close() error {
+	if upload.closer == nil {
+		return nil
+	}
+	return upload.closer.Close()
+}
+
+// setUploadContentLength : Sets the content length of a request whose body is an AudioUpload or a regular file, so
+// that the body is streamed with a Content-Length header
+func setUploadContentLength(request *http.Request, body io.Reader) {
+	if request.ContentLength > 0 {
+		return
+	}
+	switch body := body.(type) {
+	case *AudioUpload:
+		request.ContentLength = body.Size()
+	case *os.File:
+		info, err := body.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return
+		}
+		offset, err := body.Seek(0, io.SeekCurrent)
+		if err != nil || offset > info.Size() {
+			return
+		}
+		request.ContentLength = info.Size() - offset
+	}
+}
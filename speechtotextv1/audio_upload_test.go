@@ -0,0 +1,65 @@
+package speechtotextv1_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioUpload", func() {
+	Describe("AddAudio(addAudioOptions *AddAudioOptions)", func() {
+		Context("Successfully - Stream an archive with a Content-Length header", func() {
+			archive := make([]byte, 100*1024)
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/acoustic_customizations/customization1/audio/archive1"))
+				Expect(req.ContentLength).To(Equal(int64(len(archive))))
+				Expect(req.TransferEncoding).To(BeEmpty())
+				body, _ := ioutil.ReadAll(req.Body)
+				Expect(body).To(HaveLen(len(archive)))
+				res.WriteHeader(http.StatusCreated)
+			}))
+			It("Succeed to call AddAudio", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				directory, err := ioutil.TempDir("", "upload")
+				Expect(err).To(BeNil())
+				defer os.RemoveAll(directory)
+				fileName := filepath.Join(directory, "archive1.zip")
+				Expect(ioutil.WriteFile(fileName, archive, 0644)).To(Succeed())
+
+				upload, err := speechtotextv1.OpenAudioUpload(fileName)
+				Expect(err).To(BeNil())
+				var lastSent, lastTotal int64
+				upload.SetProgress(func(sent int64, total int64) {
+					lastSent, lastTotal = sent, total
+				})
+
+				addAudioOptions := testService.NewAddAudioOptions("customization1", "archive1", upload).
+					SetContentType("application/zip")
+				_, err = testService.AddAudio(addAudioOptions)
+				Expect(err).To(BeNil())
+				Expect(lastSent).To(Equal(int64(len(archive))))
+				Expect(lastTotal).To(Equal(int64(len(archive))))
+			})
+		})
+	})
+})
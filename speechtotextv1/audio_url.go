@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// OpenAudioURL : Opens the audio at an HTTP(S) URL, such as a presigned URL of an object in IBM Cloud Object Storage
+// or another S3-compatible store, so that it can be streamed into a request body without a temporary file. Returns the
+// audio and its content type, which is taken from the Content-Type header of the response if it is an audio type, and
+// is otherwise detected from the leading bytes of the audio or the extension of the URL path. The content type is
+// empty if it cannot be determined.
+//
+// If the response has a Content-Length, the audio is an AudioUpload of that size; otherwise it is sent with chunked
+// transfer encoding. The client defaults to http.DefaultClient. Close the audio if it is not passed to a request.
+//
+// To send an object that is read with an object storage SDK instead, wrap its reader with NewAudioUpload and the size
+// of the object.
+func OpenAudioURL(ctx context.Context, client *http.Client, audioURL string) (io.ReadCloser, string, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	parsedURL, err := url.Parse(audioURL)
+	if err != nil {
+		return nil, "", err
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, "", fmt.Errorf("audio URL '%s' must use http or https", audioURL)
+	}
+
+	request, err := http.NewRequest("GET", audioURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, "", err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		response.Body.Close()
+		// The query is left out of the message because a presigned URL carries its signature in the query
+		return nil, "", fmt.Errorf("audio URL '%s://%s%s' returned status %s", parsedURL.Scheme, parsedURL.Host, parsedURL.Path, response.Status)
+	}
+
+	var audio io.ReadCloser = response.Body
+	contentType := response.Header.Get("Content-Type")
+	if mediaType, _ := parseAudioContentType(contentType); !strings.HasPrefix(mediaType, "audio/") {
+		contentType, audio, err = sniffAudioContentType(response.Body, path.Base(parsedURL.Path))
+		if err != nil {
+			response.Body.Close()
+			return nil, "", err
+		}
+	}
+
+	if response.ContentLength >= 0 {
+		return NewAudioUpload(audio, response.ContentLength), contentType, nil
+	}
+	return &streamingAudio{audio: audio}, contentType, nil
+}
+
+// RecognizeFromURL : Recognize the audio at an HTTP(S) URL
+// Streams the audio from the URL into the body of the request. ContentType is set from the audio if it is not set in
+// the options. The client that fetches the audio defaults to http.DefaultClient; see OpenAudioURL.
+func (speechToText *SpeechToTextV1) RecognizeFromURL(recognizeOptions *RecognizeOptions, audioURL string, client *http.Client) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	return speechToText.RecognizeFromURLWithContext(context.Background(), recognizeOptions, audioURL, client)
+}
+
+// RecognizeFromURLWithContext is an alternate form of the RecognizeFromURL method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RecognizeFromURLWithContext(ctx context.Context, recognizeOptions *RecognizeOptions, audioURL string, client *http.Client) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(recognizeOptions, "recognizeOptions cannot be nil")
+	if err != nil {
+		return
+	}
+
+	audio, contentType, err := OpenAudioURL(ctx, client, audioURL)
+	if err != nil {
+		return
+	}
+	defer audio.Close()
+
+	urlOptions := *recognizeOptions
+	urlOptions.Audio = audio
+	if urlOptions.ContentType == nil && contentType != "" {
+		urlOptions.ContentType = core.StringPtr(contentType)
+	}
+	return speechToText.RecognizeWithContext(ctx, &urlOptions)
+}
+
+// AddAudioFromURL : Add an audio resource from an HTTP(S) URL
+// Streams the audio from the URL into the body of the request. ContentType is set from the audio if it is not set in
+// the options. The client that fetches the audio defaults to http.DefaultClient; see OpenAudioURL.
+func (speechToText *SpeechToTextV1) AddAudioFromURL(addAudioOptions *AddAudioOptions, audioURL string, client *http.Client) (response *core.DetailedResponse, err error) {
+	return speechToText.AddAudioFromURLWithContext(context.Background(), addAudioOptions, audioURL, client)
+}
+
+// AddAudioFromURLWithContext is an alternate form of the AddAudioFromURL method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddAudioFromURLWithContext(ctx context.Context, addAudioOptions *AddAudioOptions, audioURL string, client *http.Client) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(addAudioOptions, "addAudioOptions cannot be nil")
+	if err != nil {
+		return
+	}
+
+	audio, contentType, err := OpenAudioURL(ctx, client, audioURL)
+	if err != nil {
+		return
+	}
+	defer audio.Close()
+
+	urlOptions := *addAudioOptions
+	urlOptions.AudioResource = audio
+	if urlOptions.ContentType == nil && contentType != "" {
+		urlOptions.ContentType = core.StringPtr(contentType)
+	}
+	return speechToText.AddAudioWithContext(ctx, &urlOptions)
+}
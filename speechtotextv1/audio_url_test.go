@@ -0,0 +1,87 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AudioURL", func() {
+	audio := wavFile(16000, 1, pcmTone(1600, 1000))
+	serveAudio := func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/bucket/meeting.wav" {
+			res.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// Without a Content-Length, audio larger than the response buffer would be sent chunked with an unknown length
+		res.Header().Set("Content-Type", "application/octet-stream")
+		res.Header().Set("Content-Length", strconv.Itoa(len(audio)))
+		res.Write(audio)
+	}
+
+	Describe("RecognizeFromURL(recognizeOptions *RecognizeOptions, audioURL string, client *http.Client)", func() {
+		Context("Successfully - Stream the audio at a URL", func() {
+			mediaServer := httptest.NewServer(http.HandlerFunc(serveAudio))
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				Expect(req.Header.Get("Content-Type")).To(Equal("audio/wav"))
+				Expect(req.ContentLength).To(Equal(int64(len(audio))))
+				body, _ := ioutil.ReadAll(req.Body)
+				Expect(body).To(Equal(audio))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"results": [], "result_index": 0}`)
+			}))
+			It("Succeed to call RecognizeFromURL", func() {
+				defer testServer.Close()
+				defer mediaServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				recognizeOptions := testService.NewRecognizeOptions(nil)
+				result, _, err := testService.RecognizeFromURL(recognizeOptions, mediaServer.URL+"/bucket/meeting.wav", nil)
+				Expect(err).To(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
+
+	Describe("AddAudioFromURL(addAudioOptions *AddAudioOptions, audioURL string, client *http.Client)", func() {
+		Context("Unsuccessfully - The audio is not found", func() {
+			mediaServer := httptest.NewServer(http.HandlerFunc(serveAudio))
+			It("Fail to call AddAudioFromURL", func() {
+				defer mediaServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: "http://localhost",
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				addAudioOptions := testService.NewAddAudioOptions("customization1", "audio1", nil)
+				_, err := testService.AddAudioFromURL(addAudioOptions, mediaServer.URL+"/bucket/missing.wav?signature=secret", nil)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).ToNot(ContainSubstring("secret"))
+			})
+		})
+	})
+})
@@ -0,0 +1,225 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+const (
+	DEFAULT_BATCH_CONCURRENCY = 4
+	DEFAULT_BATCH_MAX_RETRIES = 2
+	DEFAULT_BATCH_RETRY_DELAY = time.Second
+)
+
+// BatchTranscriptionOptions : Options that control how a batch of audio files is transcribed
+type BatchTranscriptionOptions struct {
+
+	// The number of files that are transcribed at the same time. Defaults to 4.
+	Concurrency int
+
+	// The number of times a failed recognition is retried. Only transport failures and responses with status code 429
+	// or 5xx are retried. Defaults to 2. Set it to common.NO_RETRIES to disable retries.
+	MaxRetries int
+
+	// The delay before the first retry of a file. The delay doubles for each further retry. A response with status code
+	// 429 and a Retry-After header is retried after the delay that the service asks for. Defaults to one second.
+	RetryDelay time.Duration
+
+	// The minimum interval between two recognition requests across all workers. Zero means no rate limit.
+	MinRequestInterval time.Duration
+
+	// The parameters of the recognition requests, such as the model and timestamps. Audio is replaced by the
+	// content of each file. If ContentType is not set, it is detected for each file.
+	RecognizeOptions *RecognizeOptions
+}
+
+// NewBatchTranscriptionOptions : Instantiate BatchTranscriptionOptions with the default values
+func (speechToText *SpeechToTextV1) NewBatchTranscriptionOptions() *BatchTranscriptionOptions {
+	return &BatchTranscriptionOptions{
+		Concurrency: DEFAULT_BATCH_CONCURRENCY,
+		MaxRetries:  DEFAULT_BATCH_MAX_RETRIES,
+		RetryDelay:  DEFAULT_BATCH_RETRY_DELAY,
+	}
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (options *BatchTranscriptionOptions) SetConcurrency(concurrency int) *BatchTranscriptionOptions {
+	options.Concurrency = concurrency
+	return options
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (options *BatchTranscriptionOptions) SetMaxRetries(maxRetries int) *BatchTranscriptionOptions {
+	options.MaxRetries = maxRetries
+	return options
+}
+
+// SetRetryDelay : Allow user to set RetryDelay
+func (options *BatchTranscriptionOptions) SetRetryDelay(retryDelay time.Duration) *BatchTranscriptionOptions {
+	options.RetryDelay = retryDelay
+	return options
+}
+
+// SetMinRequestInterval : Allow user to set MinRequestInterval
+func (options *BatchTranscriptionOptions) SetMinRequestInterval(minRequestInterval time.Duration) *BatchTranscriptionOptions {
+	options.MinRequestInterval = minRequestInterval
+	return options
+}
+
+// SetRecognizeOptions : Allow user to set RecognizeOptions
+func (options *BatchTranscriptionOptions) SetRecognizeOptions(recognizeOptions *RecognizeOptions) *BatchTranscriptionOptions {
+	options.RecognizeOptions = recognizeOptions
+	return options
+}
+
+// BatchTranscriptionResult : The outcome of the transcription of one file of a batch
+type BatchTranscriptionResult struct {
+
+	// The path of the audio file.
+	FileName string
+
+	// The results of the recognition, if it succeeded.
+	Results *SpeechRecognitionResults
+
+	// The number of recognition requests that were sent for the file.
+	Attempts int
+
+	// The error of the last attempt, if the transcription failed.
+	Err error
+}
+
+// BatchTranscriptionReport : A summary of the transcription of a batch of files
+type BatchTranscriptionReport struct {
+
+	// The files that were transcribed, in the order of their file names.
+	Succeeded []BatchTranscriptionResult
+
+	// The files that could not be transcribed, in the order of their file names.
+	Failed []BatchTranscriptionResult
+}
+
+// TranscribeFiles : Transcribe a batch of audio files concurrently
+// Sends one result for each file on the returned channel as soon as the file has been transcribed or has failed, and
+// closes the channel when every file has been processed. Cancelling the context stops the remaining files, which
+// are reported with the error of the context. Pass the channel to CollectBatchReport for a summary.
+func (speechToText *SpeechToTextV1) TranscribeFiles(ctx context.Context, fileNames []string, options *BatchTranscriptionOptions) <-chan BatchTranscriptionResult {
+	options = batchOptionsWithDefaults(options)
+
+	results := make(chan BatchTranscriptionResult)
+	retryOptions := common.RetryOptions{
+		MaxRetries: options.MaxRetries,
+		RetryDelay: options.RetryDelay,
+		Limiter:    common.NewIntervalRateLimiter(options.MinRequestInterval),
+	}
+	common.RunConcurrently(len(fileNames), options.Concurrency, func(i int) {
+		results <- speechToText.transcribeFile(ctx, fileNames[i], options, retryOptions)
+	}, func() {
+		close(results)
+	})
+
+	return results
+}
+
+// TranscribeDirectory : Transcribe the audio files of a directory concurrently
+// Only files with a known audio extension, such as .wav, .flac, .mp3, .ogg, and .webm, are transcribed;
+// subdirectories are not searched. See TranscribeFiles for how results are reported.
+func (speechToText *SpeechToTextV1) TranscribeDirectory(ctx context.Context, directory string, options *BatchTranscriptionOptions) (<-chan BatchTranscriptionResult, error) {
+	fileNames, err := audioFilesInDirectory(directory, false)
+	if err != nil {
+		return nil, err
+	}
+	return speechToText.TranscribeFiles(ctx, fileNames, options), nil
+}
+
+// CollectBatchReport : Waits for every result of a batch and summarizes them
+func CollectBatchReport(results <-chan BatchTranscriptionResult) *BatchTranscriptionReport {
+	report := &BatchTranscriptionReport{}
+	for result := range results {
+		if result.Err != nil {
+			report.Failed = append(report.Failed, result)
+		} else {
+			report.Succeeded = append(report.Succeeded, result)
+		}
+	}
+	sort.Slice(report.Succeeded, func(i, j int) bool { return report.Succeeded[i].FileName < report.Succeeded[j].FileName })
+	sort.Slice(report.Failed, func(i, j int) bool { return report.Failed[i].FileName < report.Failed[j].FileName })
+	return report
+}
+
+// transcribeFile : Recognizes one file, retrying transient failures
+func (speechToText *SpeechToTextV1) transcribeFile(ctx context.Context, fileName string, options *BatchTranscriptionOptions, retryOptions common.RetryOptions) BatchTranscriptionResult {
+	result := BatchTranscriptionResult{FileName: fileName}
+	result.Attempts, result.Err = common.Retry(ctx, retryOptions, func() (err error) {
+		result.Results, err = speechToText.recognizeFile(ctx, fileName, options.RecognizeOptions)
+		return
+	})
+	return result
+}
+
+// recognizeFile : Sends one recognition request with the content of the file
+func (speechToText *SpeechToTextV1) recognizeFile(ctx context.Context, fileName string, template *RecognizeOptions) (*SpeechRecognitionResults, error) {
+	audio, err := os.Open(fileName)
+	if err != nil {
+		return nil, &common.NonRetryableError{Err: err}
+	}
+	defer audio.Close()
+
+	recognizeOptions := speechToText.NewRecognizeOptions(audio)
+	if template != nil {
+		*recognizeOptions = *template
+		recognizeOptions.Audio = audio
+	}
+	if recognizeOptions.ContentType == nil {
+		if _, err = recognizeOptions.DetectContentType(fileName); err != nil {
+			return nil, &common.NonRetryableError{Err: err}
+		}
+	}
+
+	result, _, err := speechToText.RecognizeWithContext(ctx, recognizeOptions)
+	return result, err
+}
+
+func batchOptionsWithDefaults(options *BatchTranscriptionOptions) *BatchTranscriptionOptions {
+	withDefaults := &BatchTranscriptionOptions{
+		Concurrency: DEFAULT_BATCH_CONCURRENCY,
+		MaxRetries:  DEFAULT_BATCH_MAX_RETRIES,
+		RetryDelay:  DEFAULT_BATCH_RETRY_DELAY,
+	}
+	if options == nil {
+		return withDefaults
+	}
+	if options.Concurrency > 0 {
+		withDefaults.Concurrency = options.Concurrency
+	}
+	if options.MaxRetries > 0 {
+		withDefaults.MaxRetries = options.MaxRetries
+	} else if options.MaxRetries < 0 {
+		withDefaults.MaxRetries = 0
+	}
+	if options.RetryDelay > 0 {
+		withDefaults.RetryDelay = options.RetryDelay
+	}
+	withDefaults.MinRequestInterval = options.MinRequestInterval
+	withDefaults.RecognizeOptions = options.RecognizeOptions
+	return withDefaults
+}
@@ -0,0 +1,131 @@
+package speechtotextv1_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/common"
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BatchTranscriber", func() {
+	Describe("TranscribeDirectory(ctx context.Context, directory string, options *BatchTranscriptionOptions)", func() {
+		Context("Successfully - Transcribe, retry, and report failures", func() {
+			var mutex sync.Mutex
+			attempts := map[string]int{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				Expect(req.URL.Query().Get("model")).To(Equal("en-US_NarrowbandModel"))
+				body, _ := ioutil.ReadAll(req.Body)
+
+				mutex.Lock()
+				attempts[string(body)]++
+				attempt := attempts[string(body)]
+				mutex.Unlock()
+
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case string(body) == "fLaC bad":
+					res.WriteHeader(http.StatusBadRequest)
+					fmt.Fprintf(res, `{"code": 400, "error": "Stream was 0 bytes but needs to be at least 100 bytes."}`)
+				case string(body) == "fLaC flaky" && attempt == 1:
+					res.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprintf(res, `{"code": 503, "error": "Service unavailable"}`)
+				default:
+					Expect(req.Header.Get("Content-Type")).To(Equal("audio/flac"))
+					fmt.Fprintf(res, `{"results": [{"final": true, "alternatives": [{"transcript": "hello"}]}]}`)
+				}
+			}))
+			It("Succeed to call TranscribeDirectory", func() {
+				defer testServer.Close()
+
+				directory, err := ioutil.TempDir("", "batch")
+				Expect(err).To(BeNil())
+				defer os.RemoveAll(directory)
+				for name, content := range map[string]string{"good.flac": "fLaC good", "flaky.flac": "fLaC flaky", "bad.flac": "fLaC bad", "notes.txt": "notes"} {
+					Expect(ioutil.WriteFile(filepath.Join(directory, name), []byte(content), 0644)).To(Succeed())
+				}
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				options := testService.NewBatchTranscriptionOptions().
+					SetConcurrency(2).
+					SetRetryDelay(time.Millisecond).
+					SetRecognizeOptions(testService.NewRecognizeOptions(nil).SetModel("en-US_NarrowbandModel"))
+				results, err := testService.TranscribeDirectory(context.Background(), directory, options)
+				Expect(err).To(BeNil())
+
+				report := speechtotextv1.CollectBatchReport(results)
+				Expect(report.Succeeded).To(HaveLen(2))
+				Expect(report.Succeeded[0].FileName).To(Equal(filepath.Join(directory, "flaky.flac")))
+				Expect(report.Succeeded[0].Attempts).To(Equal(2))
+				Expect(*report.Succeeded[1].Results.Results[0].Alternatives[0].Transcript).To(Equal("hello"))
+				Expect(report.Failed).To(HaveLen(1))
+				Expect(report.Failed[0].FileName).To(Equal(filepath.Join(directory, "bad.flac")))
+				Expect(report.Failed[0].Attempts).To(Equal(1))
+				Expect(report.Failed[0].Err).NotTo(BeNil())
+			})
+		})
+		Context("Unsuccessfully - Service unavailable", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(res, `{"code": 503, "error": "Service unavailable"}`)
+			}))
+			It("Fail to call TranscribeDirectory", func() {
+				defer testServer.Close()
+
+				directory, err := ioutil.TempDir("", "batch")
+				Expect(err).To(BeNil())
+				defer os.RemoveAll(directory)
+				Expect(ioutil.WriteFile(filepath.Join(directory, "good.flac"), []byte("fLaC good"), 0644)).To(Succeed())
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				// Zero retries means the default number of retries.
+				options := testService.NewBatchTranscriptionOptions().
+					SetMaxRetries(0).
+					SetRetryDelay(time.Millisecond)
+				results, err := testService.TranscribeDirectory(context.Background(), directory, options)
+				Expect(err).To(BeNil())
+				report := speechtotextv1.CollectBatchReport(results)
+				Expect(report.Failed).To(HaveLen(1))
+				Expect(report.Failed[0].Attempts).To(Equal(speechtotextv1.DEFAULT_BATCH_MAX_RETRIES + 1))
+
+				options.SetMaxRetries(common.NO_RETRIES)
+				results, err = testService.TranscribeDirectory(context.Background(), directory, options)
+				Expect(err).To(BeNil())
+				report = speechtotextv1.CollectBatchReport(results)
+				Expect(report.Failed).To(HaveLen(1))
+				Expect(report.Failed[0].Attempts).To(Equal(1))
+			})
+		})
+	})
+})
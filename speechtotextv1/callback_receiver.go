@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// HEADER_CALLBACK_SIGNATURE is the header in which the service sends the HMAC-SHA1 signature of a callback request.
+const HEADER_CALLBACK_SIGNATURE = "X-Callback-Signature"
+
+// ErrInvalidCallbackSignature is returned by VerifyCallbackRequest when the signature of a request does not match.
+var ErrInvalidCallbackSignature = errors.New("the callback signature does not match the payload")
+
+// CallbackEvent : A notification that the service sends to a registered callback URL about an asynchronous job
+type CallbackEvent struct {
+
+	// The ID of the job.
+	ID string `json:"id"`
+
+	// The event that triggered the notification, for example `recognitions.completed`. See the
+	// CreateJobOptions_Events_* constants.
+	Event string `json:"event"`
+
+	// The user token that was specified when the job was created.
+	UserToken string `json:"user_token,omitempty"`
+
+	// The results of the recognition, for the `recognitions.completed_with_results` event.
+	Results []SpeechRecognitionResults `json:"results,omitempty"`
+}
+
+// CallbackHandler : Handles the callback notifications for an event
+type CallbackHandler func(event *CallbackEvent)
+
+// CallbackReceiver : An http.Handler that implements the callback protocol of the service. It answers the
+// verification request that the service sends when a callback URL is registered by echoing the challenge string,
+// checks the signature of every request if a user secret is set, and dispatches job notifications to the
+// registered handlers. Serve it at the callback URL that is passed to RegisterCallback.
+type CallbackReceiver struct {
+
+	// The user secret passed to RegisterCallback. If it is set, requests without a valid signature are rejected
+	// with status code 401.
+	UserSecret string
+
+	mutex    sync.RWMutex
+	handlers map[string][]CallbackHandler
+}
+
+// NewCallbackReceiver : Instantiate CallbackReceiver
+func NewCallbackReceiver(userSecret string) *CallbackReceiver {
+	return &CallbackReceiver{
+		UserSecret: userSecret,
+		handlers:   map[string][]CallbackHandler{},
+	}
+}
+
+// Handle : Registers a handler for the notifications of an event, such as CreateJobOptions_Events_RecognitionsCompleted.
+// An empty event registers the handler for every event. Handlers are called in the order they are registered, on the
+// goroutine that serves the notification.
+func (receiver *CallbackReceiver) Handle(event string, handler CallbackHandler) *CallbackReceiver {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+	if receiver.handlers == nil {
+		receiver.handlers = map[string][]CallbackHandler{}
+	}
+	receiver.handlers[event] = append(receiver.handlers[event], handler)
+	return receiver
+}
+
+// ServeHTTP : Answers the verification request and dispatches job notifications
+func (receiver *CallbackReceiver) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		challengeString := req.URL.Query().Get("challenge_string")
+		if challengeString == "" {
+			http.Error(res, "missing challenge_string", http.StatusBadRequest)
+			return
+		}
+		if !receiver.verify(req, []byte(challengeString)) {
+			http.Error(res, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		res.Header().Set("Content-Type", "text/plain")
+		res.Write([]byte(challengeString))
+
+	case http.MethodPost:
+		payload, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			http.Error(res, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !receiver.verify(req, payload) {
+			http.Error(res, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		event := new(CallbackEvent)
+		if err = json.Unmarshal(payload, event); err != nil {
+			http.Error(res, err.Error(), http.StatusBadRequest)
+			return
+		}
+		receiver.dispatch(event)
+		res.WriteHeader(http.StatusOK)
+
+	default:
+		res.Header().Set("Allow", "GET, POST")
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (receiver *CallbackReceiver) verify(req *http.Request, payload []byte) bool {
+	if receiver.UserSecret == "" {
+		return true
+	}
+	return VerifyCallbackSignature(receiver.UserSecret, payload, req.Header.Get(HEADER_CALLBACK_SIGNATURE))
+}
+
+func (receiver *CallbackReceiver) dispatch(event *CallbackEvent) {
+	receiver.mutex.RLock()
+	handlers := append(append([]CallbackHandler{}, receiver.handlers[event.Event]...), receiver.handlers[""]...)
+	receiver.mutex.RUnlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// CallbackSignature : Calculates the signature that the service sends for a payload, the base64-encoded HMAC-SHA1 of
+// the payload keyed with the user secret
+func CallbackSignature(userSecret string, payload []byte) string {
+	mac := hmac.New(sha1.New, []byte(userSecret))
+	mac.Write(payload)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyCallbackSignature : Reports whether the signature of a callback request matches the payload. For the
+// verification request the payload is the challenge string; for notifications it is the request body.
+func VerifyCallbackSignature(userSecret string, payload []byte, signature string) bool {
+	expected := CallbackSignature(userSecret, payload)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// VerifyCallbackRequest : Verifies the signature of a request that the service sent to a callback URL, for users who
+// serve the callback URL with their own handler instead of CallbackReceiver. It returns the signed payload: the
+// challenge string of a verification request, or the body of a notification. The body of the request is restored so
+// that it can still be read by the caller.
+func VerifyCallbackRequest(userSecret string, req *http.Request) ([]byte, error) {
+	var payload []byte
+	if req.Method == http.MethodGet {
+		payload = []byte(req.URL.Query().Get("challenge_string"))
+	} else if req.Body != nil {
+		var err error
+		payload, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(payload))
+	}
+
+	if !VerifyCallbackSignature(userSecret, payload, req.Header.Get(HEADER_CALLBACK_SIGNATURE)) {
+		return payload, ErrInvalidCallbackSignature
+	}
+	return payload, nil
+}
@@ -0,0 +1,74 @@
+package speechtotextv1_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CallbackReceiver", func() {
+	userSecret := "secret"
+	var receiver *speechtotextv1.CallbackReceiver
+	var events []string
+	BeforeEach(func() {
+		events = nil
+		receiver = speechtotextv1.NewCallbackReceiver(userSecret).
+			Handle(speechtotextv1.CreateJobOptions_Events_RecognitionsCompletedWithResults, func(event *speechtotextv1.CallbackEvent) {
+				events = append(events, event.ID+":"+*event.Results[0].Results[0].Alternatives[0].Transcript)
+			}).
+			Handle("", func(event *speechtotextv1.CallbackEvent) {
+				events = append(events, event.ID+":"+event.Event)
+			})
+	})
+	Describe("ServeHTTP(res http.ResponseWriter, req *http.Request)", func() {
+		It("Echoes the challenge string", func() {
+			req := httptest.NewRequest("GET", "/callback?challenge_string=abc123", nil)
+			req.Header.Set(speechtotextv1.HEADER_CALLBACK_SIGNATURE, speechtotextv1.CallbackSignature(userSecret, []byte("abc123")))
+			res := httptest.NewRecorder()
+			receiver.ServeHTTP(res, req)
+			Expect(res.Code).To(Equal(http.StatusOK))
+			body, _ := ioutil.ReadAll(res.Body)
+			Expect(string(body)).To(Equal("abc123"))
+		})
+		It("Dispatches signed notifications", func() {
+			payload := `{"id": "job1", "event": "recognitions.completed_with_results", "results": [{"results": [{"final": true, "alternatives": [{"transcript": "hello"}]}]}]}`
+			req := httptest.NewRequest("POST", "/callback", strings.NewReader(payload))
+			req.Header.Set(speechtotextv1.HEADER_CALLBACK_SIGNATURE, speechtotextv1.CallbackSignature(userSecret, []byte(payload)))
+			res := httptest.NewRecorder()
+			receiver.ServeHTTP(res, req)
+			Expect(res.Code).To(Equal(http.StatusOK))
+			Expect(events).To(Equal([]string{"job1:hello", "job1:recognitions.completed_with_results"}))
+		})
+		It("Rejects requests with an invalid signature", func() {
+			req := httptest.NewRequest("POST", "/callback", strings.NewReader(`{"id": "job1", "event": "recognitions.failed"}`))
+			req.Header.Set(speechtotextv1.HEADER_CALLBACK_SIGNATURE, "forged")
+			res := httptest.NewRecorder()
+			receiver.ServeHTTP(res, req)
+			Expect(res.Code).To(Equal(http.StatusUnauthorized))
+			Expect(events).To(BeEmpty())
+		})
+	})
+	Describe("VerifyCallbackRequest(userSecret string, req *http.Request)", func() {
+		It("Returns the payload of a signed request and restores the body", func() {
+			payload := `{"id": "job1", "event": "recognitions.started"}`
+			req := httptest.NewRequest("POST", "/callback", strings.NewReader(payload))
+			req.Header.Set(speechtotextv1.HEADER_CALLBACK_SIGNATURE, speechtotextv1.CallbackSignature(userSecret, []byte(payload)))
+			verified, err := speechtotextv1.VerifyCallbackRequest(userSecret, req)
+			Expect(err).To(BeNil())
+			Expect(string(verified)).To(Equal(payload))
+			body, _ := ioutil.ReadAll(req.Body)
+			Expect(string(body)).To(Equal(payload))
+		})
+		It("Fails for a request with an invalid signature", func() {
+			req := httptest.NewRequest("GET", "/callback?challenge_string=abc123", nil)
+			req.Header.Set(speechtotextv1.HEADER_CALLBACK_SIGNATURE, speechtotextv1.CallbackSignature("other", []byte("abc123")))
+			_, err := speechtotextv1.VerifyCallbackRequest(userSecret, req)
+			Expect(err).To(Equal(speechtotextv1.ErrInvalidCallbackSignature))
+		})
+	})
+})
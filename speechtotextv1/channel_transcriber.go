@@ -0,0 +1,213 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// ChannelUtterance : A final result of one channel of a multichannel recording, placed on the shared timeline
+type ChannelUtterance struct {
+
+	// The index of the channel, from 0.
+	Channel int
+
+	// The name of the speaker of the channel, such as `agent` or `customer`.
+	Speaker string
+
+	// The transcript of the best alternative.
+	Transcript string
+
+	// The confidence score of the transcript, if the service returned one.
+	Confidence float64
+
+	// The start time of the first word in seconds from the beginning of the audio.
+	Start float64
+
+	// The end time of the last word in seconds from the beginning of the audio.
+	End float64
+}
+
+// ChannelTranscript : The results of the separate recognition of each channel of a recording
+type ChannelTranscript struct {
+
+	// The results of each channel, in channel order.
+	Channels []*SpeechRecognitionResults
+
+	// The final results of all channels, ordered by their start time.
+	Timeline []ChannelUtterance
+}
+
+// String : Renders the timeline as one line per utterance, prefixed with the name of the speaker
+func (transcript *ChannelTranscript) String() string {
+	var builder strings.Builder
+	for _, utterance := range transcript.Timeline {
+		fmt.Fprintf(&builder, "[%s] %s: %s\n", formatSubtitleTime(utterance.Start, "."), utterance.Speaker, utterance.Transcript)
+	}
+	return builder.String()
+}
+
+// SplitAudioChannels : Separates 16-bit PCM WAV or little-endian `audio/l16` audio into one mono recording per channel,
+// and returns the recordings with their content type
+func SplitAudioChannels(audio []byte, contentType string) ([][]byte, string, error) {
+	mediaType, parameters := parseAudioContentType(contentType)
+	switch mediaType {
+	case "audio/wav", "audio/wave", "audio/x-wav":
+		format, data, err := parseWAV(audio)
+		if err != nil {
+			return nil, "", err
+		}
+		monoFormat := pcmFormat{sampleRate: format.sampleRate, channels: 1}
+		channels := deinterleavePCM(data, format.channels)
+		for i := range channels {
+			channels[i] = buildWAV(channels[i], monoFormat)
+		}
+		return channels, "audio/wav", nil
+	case "audio/l16":
+		if parameters["endianness"] == "big-endian" {
+			return nil, "", fmt.Errorf("big-endian audio/l16 audio cannot be separated into channels")
+		}
+		sampleRate, _ := strconv.Atoi(parameters["rate"])
+		channels := 1
+		if parameters["channels"] != "" {
+			channels, _ = strconv.Atoi(parameters["channels"])
+		}
+		if sampleRate <= 0 || channels <= 0 {
+			return nil, "", fmt.Errorf("invalid PCM format: rate %d, channels %d", sampleRate, channels)
+		}
+		monoContentType := fmt.Sprintf("audio/l16;rate=%d;channels=1;endianness=little-endian", sampleRate)
+		return deinterleavePCM(audio, channels), monoContentType, nil
+	}
+	return nil, "", fmt.Errorf("audio of type '%s' cannot be separated into channels; convert it to WAV first", contentType)
+}
+
+// RecognizeChannels : Recognize each channel of a multichannel recording separately
+// Separates the channels of the audio, such as the agent and customer channels of a call-center recording, recognizes
+// them concurrently, and merges their final results into a timeline attributed to the speakers. For telephony, this
+// is more accurate than the `speaker_labels` parameter. The speakers name the channels in order; channels without a
+// name are called `channel 0`, `channel 1`, and so on. Timestamps are requested for each channel. The content type is
+// taken from recognizeOptions, or detected if it is not set; Audio of recognizeOptions is ignored.
+func (speechToText *SpeechToTextV1) RecognizeChannels(audio io.Reader, recognizeOptions *RecognizeOptions, speakers []string) (*ChannelTranscript, error) {
+	return speechToText.RecognizeChannelsWithContext(context.Background(), audio, recognizeOptions, speakers)
+}
+
+// RecognizeChannelsWithContext is an alternate form of the RecognizeChannels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RecognizeChannelsWithContext(ctx context.Context, audio io.Reader, recognizeOptions *RecognizeOptions, speakers []string) (*ChannelTranscript, error) {
+	data, err := ioutil.ReadAll(audio)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := DetectAudioContentType(data, "")
+	if recognizeOptions != nil && recognizeOptions.ContentType != nil {
+		contentType = *recognizeOptions.ContentType
+	}
+	channels, channelContentType, err := SplitAudioChannels(data, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	transcript := &ChannelTranscript{Channels: make([]*SpeechRecognitionResults, len(channels))}
+	errs := make([]error, len(channels))
+	var recognitions sync.WaitGroup
+	for i := range channels {
+		channelOptions := speechToText.NewRecognizeOptions(nil)
+		if recognizeOptions != nil {
+			*channelOptions = *recognizeOptions
+		}
+		channelOptions.Audio = ioutil.NopCloser(bytes.NewReader(channels[i]))
+		channelOptions.ContentType = core.StringPtr(channelContentType)
+		channelOptions.Timestamps = core.BoolPtr(true)
+
+		recognitions.Add(1)
+		go func(channel int) {
+			defer recognitions.Done()
+			transcript.Channels[channel], _, errs[channel] = speechToText.RecognizeWithContext(ctx, channelOptions)
+		}(i)
+	}
+	recognitions.Wait()
+
+	for channel, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("channel %d: %v", channel, err)
+		}
+	}
+	transcript.Timeline = MergeChannelResults(transcript.Channels, speakers)
+	return transcript, nil
+}
+
+// MergeChannelResults : Merges the final results of separately recognized channels into a timeline ordered by start
+// time. The speakers name the channels in order. Results without timestamps are placed at the start of the audio.
+func MergeChannelResults(channels []*SpeechRecognitionResults, speakers []string) []ChannelUtterance {
+	var timeline []ChannelUtterance
+	for channel, results := range channels {
+		if results == nil {
+			continue
+		}
+		speaker := fmt.Sprintf("channel %d", channel)
+		if channel < len(speakers) && speakers[channel] != "" {
+			speaker = speakers[channel]
+		}
+		for _, result := range results.Results {
+			if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+				continue
+			}
+			alternative := result.Alternatives[0]
+			utterance := ChannelUtterance{
+				Channel:    channel,
+				Speaker:    speaker,
+				Transcript: strings.TrimSpace(stringOrEmpty(alternative.Transcript)),
+			}
+			if alternative.Confidence != nil {
+				utterance.Confidence = *alternative.Confidence
+			}
+			if len(alternative.Timestamps) > 0 {
+				utterance.Start = alternative.Timestamps[0].Start
+				utterance.End = alternative.Timestamps[len(alternative.Timestamps)-1].End
+			}
+			timeline = append(timeline, utterance)
+		}
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Start < timeline[j].Start
+	})
+	return timeline
+}
+
+// deinterleavePCM : Separates interleaved 16-bit samples into one buffer per channel
+func deinterleavePCM(data []byte, channels int) [][]byte {
+	frameSize := 2 * channels
+	frames := len(data) / frameSize
+	separated := make([][]byte, channels)
+	for channel := range separated {
+		separated[channel] = make([]byte, 2*frames)
+		for frame := 0; frame < frames; frame++ {
+			copy(separated[channel][2*frame:], data[frame*frameSize+2*channel:frame*frameSize+2*channel+2])
+		}
+	}
+	return separated
+}
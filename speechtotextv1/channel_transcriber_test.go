@@ -0,0 +1,77 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChannelTranscriber", func() {
+	// Stereo audio whose left channel has samples of 1000 and right channel samples of 2000
+	stereo := make([]byte, 4*800)
+	for i := 0; i < 800; i++ {
+		binary.LittleEndian.PutUint16(stereo[4*i:], uint16(1000))
+		binary.LittleEndian.PutUint16(stereo[4*i+2:], uint16(2000))
+	}
+
+	Describe("SplitAudioChannels(audio []byte, contentType string)", func() {
+		It("Separates the channels of PCM audio", func() {
+			channels, contentType, err := speechtotextv1.SplitAudioChannels(stereo, "audio/l16;rate=8000;channels=2")
+			Expect(err).To(BeNil())
+			Expect(contentType).To(Equal("audio/l16;rate=8000;channels=1;endianness=little-endian"))
+			Expect(channels).To(HaveLen(2))
+			Expect(channels[1]).To(HaveLen(2 * 800))
+			Expect(binary.LittleEndian.Uint16(channels[1][10:])).To(Equal(uint16(2000)))
+		})
+	})
+	Describe("RecognizeChannels(audio io.Reader, recognizeOptions *RecognizeOptions, speakers []string)", func() {
+		Context("Successfully - Recognize a two-party call", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Query().Get("timestamps")).To(Equal("true"))
+				Expect(req.Header.Get("Content-Type")).To(Equal("audio/wav"))
+				body, _ := ioutil.ReadAll(req.Body)
+				res.Header().Set("Content-type", "application/json")
+				if binary.LittleEndian.Uint16(body[44:]) == 1000 {
+					fmt.Fprintf(res, `{"results": [{"final": true, "alternatives": [{"transcript": "how can I help ", "confidence": 0.9, "timestamps": [["how", 0.5, 0.7], ["help", 1.0, 1.3]]}]}, {"final": true, "alternatives": [{"transcript": "sure ", "timestamps": [["sure", 4.0, 4.4]]}]}]}`)
+				} else {
+					fmt.Fprintf(res, `{"results": [{"final": true, "alternatives": [{"transcript": "my order is late ", "timestamps": [["my", 2.0, 2.2], ["late", 3.0, 3.4]]}]}]}`)
+				}
+			}))
+			It("Succeed to call RecognizeChannels", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				wav := wavFile(8000, 2, stereo)
+				recognizeOptions := testService.NewRecognizeOptions(nil).SetModel(speechtotextv1.EnUSNarrowbandModel)
+				transcript, err := testService.RecognizeChannels(bytes.NewReader(wav), recognizeOptions, []string{"agent", "customer"})
+				Expect(err).To(BeNil())
+				Expect(transcript.Channels).To(HaveLen(2))
+				Expect(transcript.Timeline).To(HaveLen(3))
+				Expect(transcript.Timeline[0].Speaker).To(Equal("agent"))
+				Expect(transcript.Timeline[1].Speaker).To(Equal("customer"))
+				Expect(transcript.Timeline[1].Transcript).To(Equal("my order is late"))
+				Expect(transcript.Timeline[2].Start).To(Equal(4.0))
+				Expect(transcript.String()).To(HavePrefix("[00:00:00.500] agent: how can I help\n"))
+			})
+		})
+	})
+})
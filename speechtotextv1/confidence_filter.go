@@ -0,0 +1,248 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DEFAULT_CONFIDENCE_THRESHOLD is the word confidence below which FilterByConfidence flags a word by default.
+const DEFAULT_CONFIDENCE_THRESHOLD = 0.5
+
+// ConfidenceFilterOptions : Options that control which words and result segments FilterByConfidence flags or drops
+type ConfidenceFilterOptions struct {
+
+	// Words whose confidence is below the threshold are flagged. Word confidence is returned only if the recognition
+	// request sets `word_confidence` to `true`. Defaults to 0.5.
+	WordThreshold float64
+
+	// Final result segments whose confidence is below the threshold are flagged. Defaults to 0, which flags no
+	// segments.
+	ResultThreshold float64
+
+	// If `true`, flagged words are removed from the transcript, word timestamps, and word confidence of their result.
+	DropWords bool
+
+	// If `true`, flagged result segments are removed from the results.
+	DropResults bool
+}
+
+// NewConfidenceFilterOptions : Instantiate ConfidenceFilterOptions with the default word threshold
+func NewConfidenceFilterOptions() *ConfidenceFilterOptions {
+	return &ConfidenceFilterOptions{
+		WordThreshold: DEFAULT_CONFIDENCE_THRESHOLD,
+	}
+}
+
+// SetWordThreshold : Allow user to set WordThreshold
+func (options *ConfidenceFilterOptions) SetWordThreshold(wordThreshold float64) *ConfidenceFilterOptions {
+	options.WordThreshold = wordThreshold
+	return options
+}
+
+// SetResultThreshold : Allow user to set ResultThreshold
+func (options *ConfidenceFilterOptions) SetResultThreshold(resultThreshold float64) *ConfidenceFilterOptions {
+	options.ResultThreshold = resultThreshold
+	return options
+}
+
+// SetDropWords : Allow user to set DropWords
+func (options *ConfidenceFilterOptions) SetDropWords(dropWords bool) *ConfidenceFilterOptions {
+	options.DropWords = dropWords
+	return options
+}
+
+// SetDropResults : Allow user to set DropResults
+func (options *ConfidenceFilterOptions) SetDropResults(dropResults bool) *ConfidenceFilterOptions {
+	options.DropResults = dropResults
+	return options
+}
+
+// LowConfidenceItem : A word or result segment whose confidence is below the threshold
+type LowConfidenceItem struct {
+
+	// The index of the result segment in the results that were filtered.
+	ResultIndex int `json:"result_index"`
+
+	// The word, or the transcript of a result segment.
+	Text string `json:"text"`
+
+	// The start time in seconds, if the results have word timestamps.
+	StartTime float64 `json:"start_time"`
+
+	// The end time in seconds, if the results have word timestamps.
+	EndTime float64 `json:"end_time"`
+
+	// The confidence score.
+	Confidence float64 `json:"confidence"`
+
+	// Whether the item is a result segment rather than a word.
+	Segment bool `json:"segment"`
+
+	// Whether the item was removed from the results.
+	Dropped bool `json:"dropped"`
+}
+
+// ConfidenceReview : The words and result segments that FilterByConfidence flagged, for a human to review
+type ConfidenceReview struct {
+
+	// The items, ordered by result segment and then by time.
+	Items []LowConfidenceItem `json:"items"`
+}
+
+// FilterByConfidence : Flags the words and final result segments whose confidence is below the thresholds
+// Returns a copy of the results, without the flagged words or segments if the options drop them, and the review list
+// of flagged items. Interim results, which have no confidence scores, are left unchanged. The options default to
+// NewConfidenceFilterOptions.
+func FilterByConfidence(results *SpeechRecognitionResults, options *ConfidenceFilterOptions) (*SpeechRecognitionResults, *ConfidenceReview) {
+	if options == nil {
+		options = NewConfidenceFilterOptions()
+	}
+	review := &ConfidenceReview{}
+	if results == nil {
+		return nil, review
+	}
+
+	filtered := *results
+	filtered.Results = make([]SpeechRecognitionResult, 0, len(results.Results))
+	for index, result := range results.Results {
+		if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+			filtered.Results = append(filtered.Results, result)
+			continue
+		}
+		alternative := result.Alternatives[0]
+
+		if alternative.Confidence != nil && *alternative.Confidence < options.ResultThreshold {
+			item := LowConfidenceItem{
+				ResultIndex: index,
+				Text:        strings.TrimSpace(stringOrEmpty(alternative.Transcript)),
+				Confidence:  *alternative.Confidence,
+				Segment:     true,
+				Dropped:     options.DropResults,
+			}
+			if len(alternative.Timestamps) > 0 {
+				item.StartTime = alternative.Timestamps[0].Start
+				item.EndTime = alternative.Timestamps[len(alternative.Timestamps)-1].End
+			}
+			review.Items = append(review.Items, item)
+			if options.DropResults {
+				continue
+			}
+		}
+
+		var dropped []bool
+		for i, wordConfidence := range alternative.WordConfidence {
+			if wordConfidence.Confidence >= options.WordThreshold {
+				continue
+			}
+			item := LowConfidenceItem{
+				ResultIndex: index,
+				Text:        wordConfidence.Word,
+				Confidence:  wordConfidence.Confidence,
+				Dropped:     options.DropWords,
+			}
+			if len(alternative.Timestamps) == len(alternative.WordConfidence) {
+				item.StartTime = alternative.Timestamps[i].Start
+				item.EndTime = alternative.Timestamps[i].End
+			}
+			review.Items = append(review.Items, item)
+			if dropped == nil {
+				dropped = make([]bool, len(alternative.WordConfidence))
+			}
+			dropped[i] = true
+		}
+		if options.DropWords && dropped != nil {
+			result.Alternatives = append([]SpeechRecognitionAlternative{dropWords(alternative, dropped)}, result.Alternatives[1:]...)
+		}
+		filtered.Results = append(filtered.Results, result)
+	}
+	return &filtered, review
+}
+
+// dropWords : Returns a copy of the alternative without the words that are marked as dropped. The marks are indexes
+// of the word confidence, which has the same words as the transcript and, if present, the word timestamps.
+func dropWords(alternative SpeechRecognitionAlternative, dropped []bool) SpeechRecognitionAlternative {
+	transcript := stringOrEmpty(alternative.Transcript)
+	words := strings.Fields(transcript)
+	if len(words) != len(dropped) {
+		words = make([]string, len(dropped))
+		for i, wordConfidence := range alternative.WordConfidence {
+			words[i] = wordConfidence.Word
+		}
+	}
+
+	var keptWords []string
+	var keptConfidence []WordConfidence
+	var keptTimestamps []WordTimestamp
+	for i := range dropped {
+		if dropped[i] {
+			continue
+		}
+		keptWords = append(keptWords, words[i])
+		keptConfidence = append(keptConfidence, alternative.WordConfidence[i])
+		if len(alternative.Timestamps) == len(dropped) {
+			keptTimestamps = append(keptTimestamps, alternative.Timestamps[i])
+		}
+	}
+
+	filteredTranscript := strings.Join(keptWords, " ")
+	if len(keptWords) > 0 && strings.HasSuffix(transcript, " ") {
+		filteredTranscript += " "
+	}
+	alternative.Transcript = &filteredTranscript
+	alternative.WordConfidence = keptConfidence
+	if len(alternative.Timestamps) == len(dropped) {
+		alternative.Timestamps = keptTimestamps
+	}
+	return alternative
+}
+
+// WriteCSV : Writes the items as CSV with a header row
+func (review *ConfidenceReview) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	csvWriter.Write([]string{"result_index", "start_time", "end_time", "text", "confidence", "segment", "dropped"})
+	for _, item := range review.Items {
+		csvWriter.Write([]string{
+			strconv.Itoa(item.ResultIndex),
+			strconv.FormatFloat(item.StartTime, 'f', -1, 64),
+			strconv.FormatFloat(item.EndTime, 'f', -1, 64),
+			item.Text,
+			strconv.FormatFloat(item.Confidence, 'f', -1, 64),
+			strconv.FormatBool(item.Segment),
+			strconv.FormatBool(item.Dropped),
+		})
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteJSON : Writes the items as an indented JSON object with the field `items`
+func (review *ConfidenceReview) WriteJSON(writer io.Writer) error {
+	items := review.Items
+	if items == nil {
+		items = []LowConfidenceItem{}
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Items []LowConfidenceItem `json:"items"`
+	}{items})
+}
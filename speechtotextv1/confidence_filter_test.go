@@ -0,0 +1,68 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConfidenceFilter", func() {
+	var results speechtotextv1.SpeechRecognitionResults
+	BeforeEach(func() {
+		err := json.Unmarshal([]byte(`{"results": [
+			{"final": true, "alternatives": [{"transcript": "the quick brown fox ", "confidence": 0.8,
+				"timestamps": [["the", 0.0, 0.2], ["quick", 0.2, 0.5], ["brown", 0.5, 0.9], ["fox", 0.9, 1.2]],
+				"word_confidence": [["the", 0.99], ["quick", 0.3], ["brown", 0.9], ["fox", 0.45]]}]},
+			{"final": true, "alternatives": [{"transcript": "jumps ", "confidence": 0.2,
+				"timestamps": [["jumps", 1.5, 1.9]], "word_confidence": [["jumps", 0.2]]}]},
+			{"final": false, "alternatives": [{"transcript": "over "}]}]}`), &results)
+		Expect(err).To(BeNil())
+	})
+
+	Describe("FilterByConfidence(results *SpeechRecognitionResults, options *ConfidenceFilterOptions)", func() {
+		It("Flag the words below the threshold", func() {
+			filtered, review := speechtotextv1.FilterByConfidence(&results, nil)
+			Expect(filtered.Results).To(HaveLen(3))
+			Expect(*filtered.Results[0].Alternatives[0].Transcript).To(Equal("the quick brown fox "))
+			Expect(review.Items).To(HaveLen(3))
+			Expect(review.Items[0]).To(Equal(speechtotextv1.LowConfidenceItem{
+				ResultIndex: 0, Text: "quick", StartTime: 0.2, EndTime: 0.5, Confidence: 0.3,
+			}))
+			Expect(review.Items[1].Text).To(Equal("fox"))
+			Expect(review.Items[2].Text).To(Equal("jumps"))
+		})
+		It("Drop the words and results below the thresholds", func() {
+			options := speechtotextv1.NewConfidenceFilterOptions().
+				SetResultThreshold(0.5).
+				SetDropWords(true).
+				SetDropResults(true)
+			filtered, review := speechtotextv1.FilterByConfidence(&results, options)
+			Expect(filtered.Results).To(HaveLen(2))
+			alternative := filtered.Results[0].Alternatives[0]
+			Expect(*alternative.Transcript).To(Equal("the brown "))
+			Expect(alternative.Timestamps).To(HaveLen(2))
+			Expect(alternative.WordConfidence).To(HaveLen(2))
+			Expect(*filtered.Results[1].Alternatives[0].Transcript).To(Equal("over "))
+			Expect(*results.Results[0].Alternatives[0].Transcript).To(Equal("the quick brown fox "))
+
+			Expect(review.Items).To(HaveLen(3))
+			Expect(review.Items[2].Segment).To(BeTrue())
+			Expect(review.Items[2].Dropped).To(BeTrue())
+		})
+	})
+
+	Describe("WriteCSV(writer io.Writer)", func() {
+		It("Succeed to call WriteCSV", func() {
+			_, review := speechtotextv1.FilterByConfidence(&results, speechtotextv1.NewConfidenceFilterOptions().SetWordThreshold(0.35))
+			var csv bytes.Buffer
+			Expect(review.WriteCSV(&csv)).To(Succeed())
+			Expect(csv.String()).To(Equal(
+				"result_index,start_time,end_time,text,confidence,segment,dropped\n" +
+					"0,0.2,0.5,quick,0.3,false,false\n" +
+					"1,1.5,1.9,jumps,0.2,false,false\n"))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+// Short names for the values of the string parameters and properties that are shared by several methods and models.
+// Each constant has the same value as the corresponding generated constant, for example EnUSBroadbandModel and
+// GetModelOptions_ModelID_EnUsBroadbandmodel, so the two can be used interchangeably.
+
+// Base models, for use as a model ID or as the base model of a custom model. Not every model can be customized.
+const (
+	ArARBroadbandModel           = GetModelOptions_ModelID_ArArBroadbandmodel
+	DeDEBroadbandModel           = GetModelOptions_ModelID_DeDeBroadbandmodel
+	DeDENarrowbandModel          = GetModelOptions_ModelID_DeDeNarrowbandmodel
+	EnGBBroadbandModel           = GetModelOptions_ModelID_EnGbBroadbandmodel
+	EnGBNarrowbandModel          = GetModelOptions_ModelID_EnGbNarrowbandmodel
+	EnUSBroadbandModel           = GetModelOptions_ModelID_EnUsBroadbandmodel
+	EnUSNarrowbandModel          = GetModelOptions_ModelID_EnUsNarrowbandmodel
+	EnUSShortFormNarrowbandModel = GetModelOptions_ModelID_EnUsShortformNarrowbandmodel
+	EsARBroadbandModel           = GetModelOptions_ModelID_EsArBroadbandmodel
+	EsARNarrowbandModel          = GetModelOptions_ModelID_EsArNarrowbandmodel
+	EsCLBroadbandModel           = GetModelOptions_ModelID_EsClBroadbandmodel
+	EsCLNarrowbandModel          = GetModelOptions_ModelID_EsClNarrowbandmodel
+	EsCOBroadbandModel           = GetModelOptions_ModelID_EsCoBroadbandmodel
+	EsCONarrowbandModel          = GetModelOptions_ModelID_EsCoNarrowbandmodel
+	EsESBroadbandModel           = GetModelOptions_ModelID_EsEsBroadbandmodel
+	EsESNarrowbandModel          = GetModelOptions_ModelID_EsEsNarrowbandmodel
+	EsMXBroadbandModel           = GetModelOptions_ModelID_EsMxBroadbandmodel
+	EsMXNarrowbandModel          = GetModelOptions_ModelID_EsMxNarrowbandmodel
+	EsPEBroadbandModel           = GetModelOptions_ModelID_EsPeBroadbandmodel
+	EsPENarrowbandModel          = GetModelOptions_ModelID_EsPeNarrowbandmodel
+	FrFRBroadbandModel           = GetModelOptions_ModelID_FrFrBroadbandmodel
+	FrFRNarrowbandModel          = GetModelOptions_ModelID_FrFrNarrowbandmodel
+	JaJPBroadbandModel           = GetModelOptions_ModelID_JaJpBroadbandmodel
+	JaJPNarrowbandModel          = GetModelOptions_ModelID_JaJpNarrowbandmodel
+	KoKRBroadbandModel           = GetModelOptions_ModelID_KoKrBroadbandmodel
+	KoKRNarrowbandModel          = GetModelOptions_ModelID_KoKrNarrowbandmodel
+	PtBRBroadbandModel           = GetModelOptions_ModelID_PtBrBroadbandmodel
+	PtBRNarrowbandModel          = GetModelOptions_ModelID_PtBrNarrowbandmodel
+	ZhCNBroadbandModel           = GetModelOptions_ModelID_ZhCnBroadbandmodel
+	ZhCNNarrowbandModel          = GetModelOptions_ModelID_ZhCnNarrowbandmodel
+)
+
+// Word types, for use when listing the words of a custom language model and when training it.
+const (
+	WordTypeAll      = ListWordsOptions_WordType_All
+	WordTypeCorpora  = ListWordsOptions_WordType_Corpora
+	WordTypeGrammars = ListWordsOptions_WordType_Grammars
+	WordTypeUser     = ListWordsOptions_WordType_User
+)
+
+// Orders in which the words of a custom language model can be listed. Prepend `+` or `-` for ascending or descending order.
+const (
+	SortAlphabetical = ListWordsOptions_Sort_Alphabetical
+	SortCount        = ListWordsOptions_Sort_Count
+)
+
+// Callback events of asynchronous recognition jobs.
+const (
+	EventsRecognitionsStarted              = CreateJobOptions_Events_RecognitionsStarted
+	EventsRecognitionsCompleted            = CreateJobOptions_Events_RecognitionsCompleted
+	EventsRecognitionsCompletedWithResults = CreateJobOptions_Events_RecognitionsCompletedWithResults
+	EventsRecognitionsFailed               = CreateJobOptions_Events_RecognitionsFailed
+)
+
+// Statuses of asynchronous recognition jobs.
+const (
+	JobStatusWaiting    = RecognitionJob_Status_Waiting
+	JobStatusProcessing = RecognitionJob_Status_Processing
+	JobStatusCompleted  = RecognitionJob_Status_Completed
+	JobStatusFailed     = RecognitionJob_Status_Failed
+)
+
+// Statuses of custom language and acoustic models.
+const (
+	ModelStatusPending   = LanguageModel_Status_Pending
+	ModelStatusReady     = LanguageModel_Status_Ready
+	ModelStatusAvailable = LanguageModel_Status_Available
+	ModelStatusFailed    = LanguageModel_Status_Failed
+)
+
+// Statuses of the corpora and grammars of custom language models.
+const (
+	ResourceStatusAnalyzed       = Corpus_Status_Analyzed
+	ResourceStatusBeingProcessed = Corpus_Status_BeingProcessed
+	ResourceStatusUndetermined   = Corpus_Status_Undetermined
+)
+
+// Statuses of the audio resources of custom acoustic models.
+const (
+	AudioStatusOk             = AudioResource_Status_Ok
+	AudioStatusBeingProcessed = AudioResource_Status_BeingProcessed
+	AudioStatusInvalid        = AudioResource_Status_Invalid
+)
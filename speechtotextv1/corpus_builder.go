@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// DEFAULT_CORPUS_MAX_SIZE is the default maximum size in bytes of each corpus produced by a CorpusBuilder.
+const DEFAULT_CORPUS_MAX_SIZE = 10 * 1024 * 1024
+
+var (
+	// markupBlock matches elements whose content is not text, such as scripts and style sheets
+	markupBlock = regexp.MustCompile(`(?is)<(script|style)\b.*?</(script|style)>`)
+
+	// markupTag matches markup tags and comments
+	markupTag = regexp.MustCompile(`(?s)<!--.*?-->|<[^>]*>`)
+)
+
+// CorpusBuilder : Prepares corpus text for AddCorpus from raw text sources
+// Text is converted to UTF-8, markup such as HTML and XML is removed, whitespace is normalized, and the text is split
+// into sentences, one per line. Sentences that were already added are skipped. The sentences are split into corpora
+// of at most MaxSize bytes.
+type CorpusBuilder struct {
+
+	// The maximum size in bytes of each corpus. Defaults to DEFAULT_CORPUS_MAX_SIZE.
+	MaxSize int
+
+	sentences []string
+	seen      map[string]bool
+	skipped   int
+}
+
+// NewCorpusBuilder : Instantiate CorpusBuilder
+func NewCorpusBuilder() *CorpusBuilder {
+	return &CorpusBuilder{
+		MaxSize: DEFAULT_CORPUS_MAX_SIZE,
+		seen:    map[string]bool{},
+	}
+}
+
+// SetMaxSize : Allow user to set MaxSize
+func (builder *CorpusBuilder) SetMaxSize(maxSize int) *CorpusBuilder {
+	builder.MaxSize = maxSize
+	return builder
+}
+
+// AddText : Adds the sentences of a text, which can contain markup
+func (builder *CorpusBuilder) AddText(text string) *CorpusBuilder {
+	text = markupBlock.ReplaceAllString(text, " ")
+	text = markupTag.ReplaceAllString(text, " ")
+	text = html.UnescapeString(text)
+	for _, sentence := range corpusSentences(text) {
+		key := strings.ToLower(sentence)
+		if builder.seen[key] {
+			builder.skipped++
+			continue
+		}
+		builder.seen[key] = true
+		builder.sentences = append(builder.sentences, sentence)
+	}
+	return builder
+}
+
+// AddReader : Adds the sentences of the text of a reader. Text that is not UTF-8 is converted: UTF-16 is detected
+// from its byte order mark, and other text is decoded as ISO-8859-1.
+func (builder *CorpusBuilder) AddReader(reader io.Reader) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	builder.AddText(decodeCorpusText(data))
+	return nil
+}
+
+// AddFiles : Adds the sentences of the text files in order
+func (builder *CorpusBuilder) AddFiles(fileNames ...string) error {
+	for _, fileName := range fileNames {
+		data, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			return err
+		}
+		builder.AddText(decodeCorpusText(data))
+	}
+	return nil
+}
+
+// Sentences : The sentences that were added, in order, without duplicates
+func (builder *CorpusBuilder) Sentences() []string {
+	return builder.sentences
+}
+
+// Duplicates : The number of sentences that were skipped because they were already added
+func (builder *CorpusBuilder) Duplicates() int {
+	return builder.skipped
+}
+
+// Corpora : The text of the corpora, each of at most MaxSize bytes with one sentence per line. A sentence that is
+// longer than MaxSize is placed in a corpus of its own.
+func (builder *CorpusBuilder) Corpora() []string {
+	maxSize := builder.MaxSize
+	if maxSize <= 0 {
+		maxSize = DEFAULT_CORPUS_MAX_SIZE
+	}
+
+	var corpora []string
+	var corpus bytes.Buffer
+	for _, sentence := range builder.sentences {
+		if corpus.Len() > 0 && corpus.Len()+len(sentence)+1 > maxSize {
+			corpora = append(corpora, corpus.String())
+			corpus.Reset()
+		}
+		corpus.WriteString(sentence)
+		corpus.WriteByte('\n')
+	}
+	if corpus.Len() > 0 {
+		corpora = append(corpora, corpus.String())
+	}
+	return corpora
+}
+
+// NewAddCorpusOptionsFromBuilder : Instantiate AddCorpusOptions for each corpus of a CorpusBuilder
+// A single corpus is named corpusName; otherwise the corpora are named `<corpusName>-1`, `<corpusName>-2`, and so on.
+func (speechToText *SpeechToTextV1) NewAddCorpusOptionsFromBuilder(customizationID string, corpusName string, builder *CorpusBuilder) []*AddCorpusOptions {
+	corpora := builder.Corpora()
+	addCorpusOptions := make([]*AddCorpusOptions, len(corpora))
+	for i, corpus := range corpora {
+		name := corpusName
+		if len(corpora) > 1 {
+			name = fmt.Sprintf("%s-%d", corpusName, i+1)
+		}
+		corpusFile := ioutil.NopCloser(strings.NewReader(corpus))
+		addCorpusOptions[i] = speechToText.NewAddCorpusOptions(customizationID, name, corpusFile)
+	}
+	return addCorpusOptions
+}
+
+// decodeCorpusText : Converts text in UTF-8, UTF-16 with a byte order mark, or ISO-8859-1 to a UTF-8 string
+func decodeCorpusText(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		data = data[3:]
+	case len(data) >= 2 && (data[0] == 0xFF && data[1] == 0xFE || data[0] == 0xFE && data[1] == 0xFF):
+		bigEndian := data[0] == 0xFE
+		units := make([]uint16, (len(data)-2)/2)
+		for i := range units {
+			high, low := data[2+2*i+1], data[2+2*i]
+			if bigEndian {
+				high, low = low, high
+			}
+			units[i] = uint16(high)<<8 | uint16(low)
+		}
+		return string(utf16.Decode(units))
+	}
+	if utf8.Valid(data) {
+		return string(data)
+	}
+
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
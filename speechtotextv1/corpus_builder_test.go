@@ -0,0 +1,53 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CorpusBuilder", func() {
+	Describe("AddText(text string)", func() {
+		It("Removes markup and duplicate sentences", func() {
+			builder := speechtotextv1.NewCorpusBuilder().
+				AddText("<html><style>p {}</style><body><p>Hello   world. Caf&eacute; time!</p><!-- note --></body></html>").
+				AddText("hello world.")
+			Expect(builder.Sentences()).To(Equal([]string{"Hello world.", "Café time!"}))
+			Expect(builder.Duplicates()).To(Equal(1))
+		})
+	})
+	Describe("AddReader(reader io.Reader)", func() {
+		It("Converts ISO-8859-1 and UTF-16 text to UTF-8", func() {
+			builder := speechtotextv1.NewCorpusBuilder()
+			Expect(builder.AddReader(bytes.NewReader([]byte("Caf\xe9 time.")))).To(Succeed())
+			Expect(builder.AddReader(bytes.NewReader([]byte("\xff\xfeN\x00e\x00w\x00.\x00")))).To(Succeed())
+			Expect(builder.Sentences()).To(Equal([]string{"Café time.", "New."}))
+		})
+	})
+	Describe("NewAddCorpusOptionsFromBuilder(customizationID string, corpusName string, builder *CorpusBuilder)", func() {
+		It("Splits the sentences into corpora under the maximum size", func() {
+			testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+				URL: "http://localhost",
+				Authenticator: &core.BasicAuthenticator{
+					Username: "user1",
+					Password: "pass1",
+				},
+			})
+			Expect(testServiceErr).To(BeNil())
+
+			builder := speechtotextv1.NewCorpusBuilder().
+				SetMaxSize(30).
+				AddText("The first sentence. The second sentence. The third sentence.")
+			addCorpusOptions := testService.NewAddCorpusOptionsFromBuilder("customization1", "corpus1", builder)
+			Expect(addCorpusOptions).To(HaveLen(3))
+			Expect(*addCorpusOptions[1].CorpusName).To(Equal("corpus1-2"))
+			corpus, _ := ioutil.ReadAll(addCorpusOptions[1].CorpusFile)
+			Expect(string(corpus)).To(Equal("The second sentence.\n"))
+		})
+	})
+})
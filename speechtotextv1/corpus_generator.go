@@ -0,0 +1,95 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+var (
+	// hesitationMarker matches the token the service emits for hesitations such as "uhm" and "uh"
+	hesitationMarker = regexp.MustCompile(`%HESITATION`)
+
+	// redactionMarker matches the runs of X characters the service substitutes for redacted numeric data. The service
+	// only redacts numbers of three or more digits, so shorter runs such as "XX" are kept as words.
+	redactionMarker = regexp.MustCompile(`\bX{3,}\b`)
+
+	// sentenceBoundary matches the end of a sentence produced by smart formatting
+	sentenceBoundary = regexp.MustCompile(`([.?!])\s+`)
+
+	// spaceBeforePunctuation matches the whitespace left before punctuation when a marker is removed
+	spaceBeforePunctuation = regexp.MustCompile(`\s+([.,?!;:])`)
+
+	// punctuationOnly matches sentences that have no words, for example when all of their words were redacted
+	punctuationOnly = regexp.MustCompile(`^[[:punct:]\s]*$`)
+
+	whitespaceRun = regexp.MustCompile(`\s+`)
+)
+
+// CorpusFromTranscripts : Converts plain transcripts into corpus text suitable for AddCorpus. Hesitation and
+// redaction markers are removed, whitespace is collapsed, and each sentence is written on its own line. Sentences
+// that are left with only punctuation are dropped.
+func CorpusFromTranscripts(transcripts ...string) string {
+	var lines []string
+	for _, transcript := range transcripts {
+		lines = append(lines, corpusSentences(transcript)...)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// CorpusFromResults : Converts recognition results into corpus text suitable for AddCorpus. Only the best
+// alternative of each final result is used.
+func CorpusFromResults(results ...SpeechRecognitionResults) string {
+	var transcripts []string
+	for _, recognitionResults := range results {
+		for _, result := range recognitionResults.Results {
+			if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+				continue
+			}
+			if result.Alternatives[0].Transcript != nil {
+				transcripts = append(transcripts, *result.Alternatives[0].Transcript)
+			}
+		}
+	}
+	return CorpusFromTranscripts(transcripts...)
+}
+
+// NewAddCorpusOptionsFromResults : Instantiate AddCorpusOptions whose corpus file is generated from recognition results
+func (speechToText *SpeechToTextV1) NewAddCorpusOptionsFromResults(customizationID string, corpusName string, results ...SpeechRecognitionResults) *AddCorpusOptions {
+	corpusFile := ioutil.NopCloser(strings.NewReader(CorpusFromResults(results...)))
+	return speechToText.NewAddCorpusOptions(customizationID, corpusName, corpusFile)
+}
+
+// corpusSentences : Cleans a transcript and splits it into sentences
+func corpusSentences(transcript string) (sentences []string) {
+	cleaned := hesitationMarker.ReplaceAllString(transcript, " ")
+	cleaned = redactionMarker.ReplaceAllString(cleaned, " ")
+	cleaned = sentenceBoundary.ReplaceAllString(cleaned, "$1\n")
+	for _, line := range strings.Split(cleaned, "\n") {
+		line = spaceBeforePunctuation.ReplaceAllString(line, "$1")
+		line = strings.TrimSpace(whitespaceRun.ReplaceAllString(line, " "))
+		if !punctuationOnly.MatchString(line) {
+			sentences = append(sentences, line)
+		}
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package speechtotextv1_test
+
+import (
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CorpusGenerator", func() {
+	Describe("CorpusFromTranscripts(transcripts ...string)", func() {
+		It("Strips hesitation and redaction markers and splits sentences", func() {
+			corpus := speechtotextv1.CorpusFromTranscripts("so %HESITATION the  account is XXXX. Thanks! ", "goodbye")
+			Expect(corpus).To(Equal("so the account is.\nThanks!\ngoodbye\n"))
+		})
+		It("Drops sentences that are only punctuation", func() {
+			corpus := speechtotextv1.CorpusFromTranscripts("XXXX. so %HESITATION my number is XXXXXX , thanks. ?")
+			Expect(corpus).To(Equal("so my number is, thanks.\n"))
+		})
+		It("Keeps words that are shorter than a redacted number", func() {
+			corpus := speechtotextv1.CorpusFromTranscripts("the XX size and the XXL size, call XXX")
+			Expect(corpus).To(Equal("the XX size and the XXL size, call\n"))
+		})
+		It("Returns an empty corpus for empty input", func() {
+			Expect(speechtotextv1.CorpusFromTranscripts("  ", "%HESITATION")).To(Equal(""))
+		})
+	})
+	Describe("CorpusFromResults(results ...SpeechRecognitionResults)", func() {
+		It("Uses only final results", func() {
+			results := speechtotextv1.SpeechRecognitionResults{
+				Results: []speechtotextv1.SpeechRecognitionResult{
+					{
+						Final:        core.BoolPtr(false),
+						Alternatives: []speechtotextv1.SpeechRecognitionAlternative{{Transcript: core.StringPtr("interim")}},
+					},
+					{
+						Final:        core.BoolPtr(true),
+						Alternatives: []speechtotextv1.SpeechRecognitionAlternative{{Transcript: core.StringPtr("final one ")}},
+					},
+				},
+			}
+			Expect(speechtotextv1.CorpusFromResults(results)).To(Equal("final one\n"))
+		})
+	})
+})
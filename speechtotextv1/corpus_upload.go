@@ -0,0 +1,45 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// NewAddCorpusOptionsFromReader : Instantiate AddCorpusOptions for corpus text read from any source, such as text
+// generated in memory or streamed from object storage. The reader is closed after the upload if it is an
+// io.ReadCloser. The filename is sent with the multipart upload and can be empty.
+func (speechToText *SpeechToTextV1) NewAddCorpusOptionsFromReader(customizationID string, corpusName string, corpus io.Reader, filename string) *AddCorpusOptions {
+	corpusFile, ok := corpus.(io.ReadCloser)
+	if !ok {
+		corpusFile = ioutil.NopCloser(corpus)
+	}
+	options := speechToText.NewAddCorpusOptions(customizationID, corpusName, corpusFile)
+	if filename != "" {
+		options.SetCorpusFilename(filename)
+	}
+	return options
+}
+
+// NewAddCorpusOptionsFromFile : Instantiate AddCorpusOptions for a corpus file, using the base name of the file as
+// the filename of the upload
+func (speechToText *SpeechToTextV1) NewAddCorpusOptionsFromFile(customizationID string, corpusName string, corpusFile *os.File) *AddCorpusOptions {
+	return speechToText.NewAddCorpusOptionsFromReader(customizationID, corpusName, corpusFile, filepath.Base(corpusFile.Name()))
+}
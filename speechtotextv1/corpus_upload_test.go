@@ -0,0 +1,54 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CorpusUpload", func() {
+	Describe("NewAddCorpusOptionsFromReader(customizationID string, corpusName string, corpus io.Reader, filename string)", func() {
+		Context("Successfully - Upload a corpus from memory", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/customizations/model1/corpora/catalog"))
+				Expect(req.ParseMultipartForm(1 << 20)).To(Succeed())
+				fileHeader := req.MultipartForm.File["corpus_file"][0]
+				Expect(fileHeader.Filename).To(Equal("catalog.txt"))
+				file, _ := fileHeader.Open()
+				content, _ := ioutil.ReadAll(file)
+				Expect(string(content)).To(Equal("Widgets and gadgets."))
+
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(http.StatusCreated)
+				fmt.Fprintf(res, `{}`)
+			}))
+			It("Succeed to call AddCorpus", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				addCorpusOptions := testService.NewAddCorpusOptionsFromReader("model1", "catalog", strings.NewReader("Widgets and gadgets."), "catalog.txt")
+				response, err := testService.AddCorpus(addCorpusOptions)
+				Expect(err).To(BeNil())
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			})
+		})
+	})
+})
@@ -0,0 +1,301 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// CustomModelCorpus : A corpus to add to the custom language model built by BuildCustomModels
+type CustomModelCorpus struct {
+
+	// The name of the corpus.
+	Name string
+
+	// The text of the corpus.
+	Corpus io.Reader
+}
+
+// CustomModelAudio : An audio resource to add to the custom acoustic model built by BuildCustomModels
+type CustomModelAudio struct {
+
+	// The name of the audio resource.
+	Name string
+
+	// The audio file or archive.
+	Audio io.Reader
+
+	// The format (MIME type) of the audio file or archive.
+	ContentType string
+}
+
+// BuildCustomModelsOptions : The BuildCustomModels options.
+type BuildCustomModelsOptions struct {
+
+	// The options used to create the custom language model. Leave nil to build only an acoustic model.
+	LanguageModel *CreateLanguageModelOptions
+
+	// The options used to create the custom acoustic model. Leave nil to build only a language model.
+	AcousticModel *CreateAcousticModelOptions
+
+	// The corpora to add to the custom language model.
+	Corpora []CustomModelCorpus
+
+	// The words to add to the custom language model.
+	Words []CustomWord
+
+	// The audio resources to add to the custom acoustic model.
+	Audio []CustomModelAudio
+
+	// Controls how the processing of resources and the training of the models are awaited.
+	WaitForTrainingOptions *WaitForTrainingOptions
+}
+
+// NewBuildCustomModelsOptions : Instantiate BuildCustomModelsOptions
+func (speechToText *SpeechToTextV1) NewBuildCustomModelsOptions() *BuildCustomModelsOptions {
+	return &BuildCustomModelsOptions{}
+}
+
+// SetLanguageModel : Allow user to set LanguageModel
+func (options *BuildCustomModelsOptions) SetLanguageModel(languageModel *CreateLanguageModelOptions) *BuildCustomModelsOptions {
+	options.LanguageModel = languageModel
+	return options
+}
+
+// SetAcousticModel : Allow user to set AcousticModel
+func (options *BuildCustomModelsOptions) SetAcousticModel(acousticModel *CreateAcousticModelOptions) *BuildCustomModelsOptions {
+	options.AcousticModel = acousticModel
+	return options
+}
+
+// AddCorpus : Add a corpus to the custom language model
+func (options *BuildCustomModelsOptions) AddCorpus(name string, corpus io.Reader) *BuildCustomModelsOptions {
+	options.Corpora = append(options.Corpora, CustomModelCorpus{Name: name, Corpus: corpus})
+	return options
+}
+
+// AddWords : Add words to the custom language model
+func (options *BuildCustomModelsOptions) AddWords(words ...CustomWord) *BuildCustomModelsOptions {
+	options.Words = append(options.Words, words...)
+	return options
+}
+
+// AddAudio : Add an audio resource to the custom acoustic model
+func (options *BuildCustomModelsOptions) AddAudio(name string, audio io.Reader, contentType string) *BuildCustomModelsOptions {
+	options.Audio = append(options.Audio, CustomModelAudio{Name: name, Audio: audio, ContentType: contentType})
+	return options
+}
+
+// SetWaitForTrainingOptions : Allow user to set WaitForTrainingOptions
+func (options *BuildCustomModelsOptions) SetWaitForTrainingOptions(waitForTrainingOptions *WaitForTrainingOptions) *BuildCustomModelsOptions {
+	options.WaitForTrainingOptions = waitForTrainingOptions
+	return options
+}
+
+// CustomModelsSummary : The outcome of BuildCustomModels
+type CustomModelsSummary struct {
+
+	// The custom language model, as last reported by the service. Nil if no language model was created.
+	LanguageModel *LanguageModel
+
+	// The custom acoustic model, as last reported by the service. Nil if no acoustic model was created.
+	AcousticModel *AcousticModel
+
+	// The names of the corpora that were added to the language model.
+	Corpora []string
+
+	// The number of words that were added to the language model.
+	WordsAdded int
+
+	// The names of the audio resources that were added to the acoustic model.
+	Audio []string
+
+	// The time taken to train the language model.
+	LanguageModelTrainingTime time.Duration
+
+	// The time taken to train the acoustic model.
+	AcousticModelTrainingTime time.Duration
+
+	// The total time taken to build the models.
+	Elapsed time.Duration
+}
+
+// BuildCustomModels : Create and train custom models
+// Creates a custom language model and/or a custom acoustic model, adds the corpora and words to the language model
+// and the audio to the acoustic model, and trains the models, waiting for the service to finish each step before the
+// next. When both models are built, the language model is trained first and is used to train the acoustic model, so
+// the two must have the same base model.
+//
+// The acoustic model is trained once the service has analyzed its audio, which requires at least 10 minutes of
+// speech; set a timeout in the WaitForTrainingOptions to bound the wait. If a step fails, the summary of the steps
+// that completed is returned with the error, so that the models that were created can be deleted or reused.
+func (speechToText *SpeechToTextV1) BuildCustomModels(buildCustomModelsOptions *BuildCustomModelsOptions) (*CustomModelsSummary, error) {
+	return speechToText.BuildCustomModelsWithContext(context.Background(), buildCustomModelsOptions)
+}
+
+// BuildCustomModelsWithContext is an alternate form of the BuildCustomModels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) BuildCustomModelsWithContext(ctx context.Context, buildCustomModelsOptions *BuildCustomModelsOptions) (*CustomModelsSummary, error) {
+	err := core.ValidateNotNil(buildCustomModelsOptions, "buildCustomModelsOptions cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+	err = buildCustomModelsOptions.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	summary := new(CustomModelsSummary)
+	defer func() {
+		summary.Elapsed = time.Since(start)
+	}()
+
+	if buildCustomModelsOptions.LanguageModel != nil {
+		if err = speechToText.buildLanguageModel(ctx, buildCustomModelsOptions, summary); err != nil {
+			return summary, err
+		}
+	}
+	if buildCustomModelsOptions.AcousticModel != nil {
+		if err = speechToText.buildAcousticModel(ctx, buildCustomModelsOptions, summary); err != nil {
+			return summary, err
+		}
+	}
+	return summary, nil
+}
+
+func (options *BuildCustomModelsOptions) validate() error {
+	if options.LanguageModel == nil && options.AcousticModel == nil {
+		return fmt.Errorf("a language model or an acoustic model must be specified")
+	}
+	if options.LanguageModel == nil && (len(options.Corpora) > 0 || len(options.Words) > 0) {
+		return fmt.Errorf("corpora and words require a language model")
+	}
+	if options.AcousticModel == nil && len(options.Audio) > 0 {
+		return fmt.Errorf("audio requires an acoustic model")
+	}
+	if options.LanguageModel != nil && options.AcousticModel != nil &&
+		stringOrEmpty(options.LanguageModel.BaseModelName) != stringOrEmpty(options.AcousticModel.BaseModelName) {
+		return fmt.Errorf("the language model and the acoustic model must have the same base model")
+	}
+	return nil
+}
+
+func (speechToText *SpeechToTextV1) buildLanguageModel(ctx context.Context, options *BuildCustomModelsOptions, summary *CustomModelsSummary) (err error) {
+	summary.LanguageModel, _, err = speechToText.CreateLanguageModelWithContext(ctx, options.LanguageModel)
+	if err != nil {
+		return
+	}
+	customizationID := *summary.LanguageModel.CustomizationID
+
+	// The service processes one addition to a language model at a time
+	waitUntilReady := func() (err error) {
+		model, err := speechToText.waitForLanguageModelStatus(ctx, customizationID, LanguageModel_Status_Ready, options.WaitForTrainingOptions)
+		if model != nil {
+			summary.LanguageModel = model
+		}
+		return
+	}
+
+	for _, corpus := range options.Corpora {
+		corpusFile, ok := corpus.Corpus.(io.ReadCloser)
+		if !ok {
+			corpusFile = ioutil.NopCloser(corpus.Corpus)
+		}
+		addCorpusOptions := speechToText.NewAddCorpusOptions(customizationID, corpus.Name, corpusFile)
+		if _, err = speechToText.AddCorpusWithContext(ctx, addCorpusOptions); err != nil {
+			return
+		}
+		if err = waitUntilReady(); err != nil {
+			return
+		}
+		summary.Corpora = append(summary.Corpora, corpus.Name)
+	}
+	if len(options.Words) > 0 {
+		addWordsOptions := speechToText.NewAddWordsOptions(customizationID, options.Words)
+		if _, err = speechToText.AddWordsWithContext(ctx, addWordsOptions); err != nil {
+			return
+		}
+		if err = waitUntilReady(); err != nil {
+			return
+		}
+		summary.WordsAdded = len(options.Words)
+	}
+
+	trainingStart := time.Now()
+	trainLanguageModelOptions := speechToText.NewTrainLanguageModelOptions(customizationID)
+	if _, _, err = speechToText.TrainLanguageModelWithContext(ctx, trainLanguageModelOptions); err != nil {
+		return
+	}
+	model, err := speechToText.WaitForLanguageModelTrainingWithContext(ctx, customizationID, options.WaitForTrainingOptions)
+	if model != nil {
+		summary.LanguageModel = model
+	}
+	summary.LanguageModelTrainingTime = time.Since(trainingStart)
+	return
+}
+
+func (speechToText *SpeechToTextV1) buildAcousticModel(ctx context.Context, options *BuildCustomModelsOptions, summary *CustomModelsSummary) (err error) {
+	summary.AcousticModel, _, err = speechToText.CreateAcousticModelWithContext(ctx, options.AcousticModel)
+	if err != nil {
+		return
+	}
+	customizationID := *summary.AcousticModel.CustomizationID
+
+	// Audio resources can be added while others are being analyzed
+	for _, audio := range options.Audio {
+		audioResource, ok := audio.Audio.(io.ReadCloser)
+		if !ok {
+			audioResource = ioutil.NopCloser(audio.Audio)
+		}
+		addAudioOptions := speechToText.NewAddAudioOptions(customizationID, audio.Name, audioResource)
+		if audio.ContentType != "" {
+			addAudioOptions.SetContentType(audio.ContentType)
+		}
+		if _, err = speechToText.AddAudioWithContext(ctx, addAudioOptions); err != nil {
+			return
+		}
+		summary.Audio = append(summary.Audio, audio.Name)
+	}
+	model, err := speechToText.waitForAcousticModelStatus(ctx, customizationID, AcousticModel_Status_Ready, options.WaitForTrainingOptions)
+	if model != nil {
+		summary.AcousticModel = model
+	}
+	if err != nil {
+		return
+	}
+
+	trainingStart := time.Now()
+	trainAcousticModelOptions := speechToText.NewTrainAcousticModelOptions(customizationID)
+	if summary.LanguageModel != nil {
+		trainAcousticModelOptions.SetCustomLanguageModelID(*summary.LanguageModel.CustomizationID)
+	}
+	if _, _, err = speechToText.TrainAcousticModelWithContext(ctx, trainAcousticModelOptions); err != nil {
+		return
+	}
+	model, err = speechToText.WaitForAcousticModelTrainingWithContext(ctx, customizationID, options.WaitForTrainingOptions)
+	if model != nil {
+		summary.AcousticModel = model
+	}
+	summary.AcousticModelTrainingTime = time.Since(trainingStart)
+	return
+}
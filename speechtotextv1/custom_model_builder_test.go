@@ -0,0 +1,104 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomModelBuilder", func() {
+	Describe("BuildCustomModels(buildCustomModelsOptions *BuildCustomModelsOptions)", func() {
+		Context("Successfully - Build a language model and an acoustic model", func() {
+			languageStatus, acousticStatus := "pending", "pending"
+			var steps []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				steps = append(steps, req.Method+" "+req.URL.Path)
+				res.Header().Set("Content-type", "application/json")
+				switch req.Method + " " + req.URL.Path {
+				case "POST /v1/customizations":
+					res.WriteHeader(http.StatusCreated)
+					fmt.Fprintf(res, `{"customization_id": "lm1"}`)
+				case "POST /v1/customizations/lm1/corpora/catalog", "POST /v1/customizations/lm1/words":
+					languageStatus = "ready"
+					res.WriteHeader(http.StatusCreated)
+					fmt.Fprintf(res, `{}`)
+				case "POST /v1/customizations/lm1/train":
+					languageStatus = "available"
+					fmt.Fprintf(res, `{}`)
+				case "GET /v1/customizations/lm1":
+					fmt.Fprintf(res, `{"customization_id": "lm1", "status": "%s"}`, languageStatus)
+				case "POST /v1/acoustic_customizations":
+					res.WriteHeader(http.StatusCreated)
+					fmt.Fprintf(res, `{"customization_id": "am1"}`)
+				case "POST /v1/acoustic_customizations/am1/audio/call1":
+					acousticStatus = "ready"
+					res.WriteHeader(http.StatusCreated)
+					fmt.Fprintf(res, `{}`)
+				case "POST /v1/acoustic_customizations/am1/train":
+					Expect(req.URL.Query().Get("custom_language_model_id")).To(Equal("lm1"))
+					acousticStatus = "available"
+					fmt.Fprintf(res, `{}`)
+				case "GET /v1/acoustic_customizations/am1":
+					fmt.Fprintf(res, `{"customization_id": "am1", "status": "%s"}`, acousticStatus)
+				default:
+					res.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			It("Succeed to call BuildCustomModels", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				buildCustomModelsOptions := testService.NewBuildCustomModelsOptions().
+					SetLanguageModel(testService.NewCreateLanguageModelOptions("Products", "en-US_NarrowbandModel")).
+					SetAcousticModel(testService.NewCreateAcousticModelOptions("Calls", "en-US_NarrowbandModel")).
+					AddCorpus("catalog", strings.NewReader("Widgets and gadgets.")).
+					AddWords(speechtotextv1.CustomWord{Word: core.StringPtr("IEEE")}).
+					AddAudio("call1", strings.NewReader("RIFF"), "audio/wav").
+					SetWaitForTrainingOptions(testService.NewWaitForTrainingOptions().SetPollInterval(time.Millisecond))
+				summary, err := testService.BuildCustomModels(buildCustomModelsOptions)
+				Expect(err).To(BeNil())
+				Expect(*summary.LanguageModel.Status).To(Equal("available"))
+				Expect(*summary.AcousticModel.Status).To(Equal("available"))
+				Expect(summary.Corpora).To(Equal([]string{"catalog"}))
+				Expect(summary.WordsAdded).To(Equal(1))
+				Expect(summary.Audio).To(Equal([]string{"call1"}))
+				Expect(steps[0]).To(Equal("POST /v1/customizations"))
+				Expect(steps[len(steps)-1]).To(Equal("GET /v1/acoustic_customizations/am1"))
+			})
+		})
+		It("Fails for models with different base models", func() {
+			testService := &speechtotextv1.SpeechToTextV1{}
+			buildCustomModelsOptions := testService.NewBuildCustomModelsOptions().
+				SetLanguageModel(testService.NewCreateLanguageModelOptions("Products", "en-US_NarrowbandModel")).
+				SetAcousticModel(testService.NewCreateAcousticModelOptions("Calls", "en-US_BroadbandModel"))
+			_, err := testService.BuildCustomModels(buildCustomModelsOptions)
+			Expect(err).NotTo(BeNil())
+		})
+		It("Fails for audio without an acoustic model", func() {
+			testService := &speechtotextv1.SpeechToTextV1{}
+			buildCustomModelsOptions := testService.NewBuildCustomModelsOptions().
+				SetLanguageModel(testService.NewCreateLanguageModelOptions("Products", "en-US_NarrowbandModel")).
+				AddAudio("call1", strings.NewReader("RIFF"), "audio/wav")
+			_, err := testService.BuildCustomModels(buildCustomModelsOptions)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
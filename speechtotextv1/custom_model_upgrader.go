@@ -0,0 +1,267 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+)
+
+// Kinds of custom models reported by UpgradeAllCustomModels.
+const (
+	CUSTOM_MODEL_KIND_LANGUAGE = "language"
+	CUSTOM_MODEL_KIND_ACOUSTIC = "acoustic"
+)
+
+// UpgradeAllCustomModelsOptions : Options that control which custom models are upgraded by UpgradeAllCustomModels
+type UpgradeAllCustomModelsOptions struct {
+
+	// If set, only the custom models of this language, such as `en-US`, are upgraded.
+	Language *string
+
+	// The latest version of each base model, by base model name, such as `en-US_BroadbandModel.v2018-07-31`. For base
+	// models that are not listed, the latest version is the newest version of any custom model of the base model.
+	LatestVersions map[string]string
+
+	// The custom language model that each custom acoustic model was trained with, by the customization ID of the
+	// acoustic model. The service requires it to upgrade such acoustic models.
+	AcousticLanguageModels map[string]string
+
+	// If true, the outdated models are reported but not upgraded.
+	DryRun bool
+
+	// Controls how each upgrade is awaited. Can be nil.
+	WaitForTrainingOptions *WaitForTrainingOptions
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewUpgradeAllCustomModelsOptions : Instantiate UpgradeAllCustomModelsOptions
+func (speechToText *SpeechToTextV1) NewUpgradeAllCustomModelsOptions() *UpgradeAllCustomModelsOptions {
+	return &UpgradeAllCustomModelsOptions{}
+}
+
+// SetLanguage : Allow user to set Language
+func (options *UpgradeAllCustomModelsOptions) SetLanguage(language string) *UpgradeAllCustomModelsOptions {
+	options.Language = &language
+	return options
+}
+
+// SetLatestVersion : Allow user to set the latest version of a base model
+func (options *UpgradeAllCustomModelsOptions) SetLatestVersion(baseModelName string, version string) *UpgradeAllCustomModelsOptions {
+	if options.LatestVersions == nil {
+		options.LatestVersions = map[string]string{}
+	}
+	options.LatestVersions[baseModelName] = version
+	return options
+}
+
+// SetAcousticLanguageModel : Allow user to set the custom language model that a custom acoustic model was trained with
+func (options *UpgradeAllCustomModelsOptions) SetAcousticLanguageModel(acousticCustomizationID string, languageCustomizationID string) *UpgradeAllCustomModelsOptions {
+	if options.AcousticLanguageModels == nil {
+		options.AcousticLanguageModels = map[string]string{}
+	}
+	options.AcousticLanguageModels[acousticCustomizationID] = languageCustomizationID
+	return options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (options *UpgradeAllCustomModelsOptions) SetDryRun(dryRun bool) *UpgradeAllCustomModelsOptions {
+	options.DryRun = dryRun
+	return options
+}
+
+// SetWaitForTrainingOptions : Allow user to set WaitForTrainingOptions
+func (options *UpgradeAllCustomModelsOptions) SetWaitForTrainingOptions(waitForTrainingOptions *WaitForTrainingOptions) *UpgradeAllCustomModelsOptions {
+	options.WaitForTrainingOptions = waitForTrainingOptions
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpgradeAllCustomModelsOptions) SetHeaders(param map[string]string) *UpgradeAllCustomModelsOptions {
+	options.Headers = param
+	return options
+}
+
+// CustomModelUpgrade : The upgrade of one custom model by UpgradeAllCustomModels
+type CustomModelUpgrade struct {
+
+	// The customization ID of the model.
+	CustomizationID string
+
+	// The name of the model.
+	Name string
+
+	// The kind of the model, `language` or `acoustic`.
+	Kind string
+
+	// The newest base model version of the model before the upgrade.
+	FromVersion string
+
+	// The base model version that the model is upgraded to.
+	ToVersion string
+
+	// The reason the model could not be upgraded, if it failed or was skipped.
+	Err error
+}
+
+// CustomModelUpgradeReport : A summary of UpgradeAllCustomModels
+type CustomModelUpgradeReport struct {
+
+	// The models that were upgraded, or that would be upgraded in a dry run.
+	Upgraded []CustomModelUpgrade
+
+	// The outdated models that were not upgraded, because they were not `ready` or `available`, the upgrade failed,
+	// or the custom language model they depend on was not upgraded.
+	Failed []CustomModelUpgrade
+
+	// The number of models that are up to date.
+	UpToDate int
+}
+
+// UpgradeAllCustomModels : Upgrade every outdated custom model
+// Lists the custom language and acoustic models, determines which are not based on the latest version of their base
+// model, and upgrades them one at a time, waiting for each upgrade to complete. Language models are upgraded before
+// acoustic models, because the service requires a custom language model to be upgraded before an acoustic model that
+// was trained with it. Failures are reported per model rather than as an error; an error is returned only if the
+// models cannot be listed or the context is done. The options can be nil.
+func (speechToText *SpeechToTextV1) UpgradeAllCustomModels(upgradeAllCustomModelsOptions *UpgradeAllCustomModelsOptions) (*CustomModelUpgradeReport, error) {
+	return speechToText.UpgradeAllCustomModelsWithContext(context.Background(), upgradeAllCustomModelsOptions)
+}
+
+// UpgradeAllCustomModelsWithContext is an alternate form of the UpgradeAllCustomModels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) UpgradeAllCustomModelsWithContext(ctx context.Context, upgradeAllCustomModelsOptions *UpgradeAllCustomModelsOptions) (*CustomModelUpgradeReport, error) {
+	options := upgradeAllCustomModelsOptions
+	if options == nil {
+		options = speechToText.NewUpgradeAllCustomModelsOptions()
+	}
+
+	listLanguageModelsOptions := speechToText.NewListLanguageModelsOptions()
+	listLanguageModelsOptions.Language = options.Language
+	listLanguageModelsOptions.Headers = options.Headers
+	languageModels, _, err := speechToText.ListLanguageModelsWithContext(ctx, listLanguageModelsOptions)
+	if err != nil {
+		return nil, err
+	}
+	listAcousticModelsOptions := speechToText.NewListAcousticModelsOptions()
+	listAcousticModelsOptions.Language = options.Language
+	listAcousticModelsOptions.Headers = options.Headers
+	acousticModels, _, err := speechToText.ListAcousticModelsWithContext(ctx, listAcousticModelsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersions := map[string]string{}
+	for _, model := range languageModels.Customizations {
+		updateLatestVersion(latestVersions, stringOrEmpty(model.BaseModelName), model.Versions)
+	}
+	for _, model := range acousticModels.Customizations {
+		updateLatestVersion(latestVersions, stringOrEmpty(model.BaseModelName), model.Versions)
+	}
+	for baseModelName, version := range options.LatestVersions {
+		latestVersions[baseModelName] = version
+	}
+
+	report := &CustomModelUpgradeReport{}
+	failedLanguageModels := map[string]bool{}
+	upgrade := func(kind string, customizationID string, name string, baseModelName string, versions []string, status string, run func() error) {
+		latest, current := latestVersions[baseModelName], newestVersion(versions)
+		if latest == "" || current >= latest {
+			report.UpToDate++
+			return
+		}
+		upgraded := CustomModelUpgrade{
+			CustomizationID: customizationID,
+			Name:            name,
+			Kind:            kind,
+			FromVersion:     current,
+			ToVersion:       latest,
+		}
+		if status != LanguageModel_Status_Ready && status != LanguageModel_Status_Available {
+			upgraded.Err = fmt.Errorf("the model has the status '%s'; only ready and available models can be upgraded", status)
+		} else if !options.DryRun {
+			upgraded.Err = run()
+		}
+		if upgraded.Err != nil {
+			if kind == CUSTOM_MODEL_KIND_LANGUAGE {
+				failedLanguageModels[customizationID] = true
+			}
+			report.Failed = append(report.Failed, upgraded)
+		} else {
+			report.Upgraded = append(report.Upgraded, upgraded)
+		}
+	}
+
+	for _, model := range languageModels.Customizations {
+		customizationID, status := stringOrEmpty(model.CustomizationID), stringOrEmpty(model.Status)
+		upgrade(CUSTOM_MODEL_KIND_LANGUAGE, customizationID, stringOrEmpty(model.Name), stringOrEmpty(model.BaseModelName), model.Versions, status, func() error {
+			upgradeLanguageModelOptions := speechToText.NewUpgradeLanguageModelOptions(customizationID)
+			upgradeLanguageModelOptions.Headers = options.Headers
+			if _, err := speechToText.UpgradeLanguageModelWithContext(ctx, upgradeLanguageModelOptions); err != nil {
+				return err
+			}
+			_, err := speechToText.waitForLanguageModelStatus(ctx, customizationID, status, options.WaitForTrainingOptions)
+			return err
+		})
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+	}
+
+	for _, model := range acousticModels.Customizations {
+		customizationID, status := stringOrEmpty(model.CustomizationID), stringOrEmpty(model.Status)
+		upgrade(CUSTOM_MODEL_KIND_ACOUSTIC, customizationID, stringOrEmpty(model.Name), stringOrEmpty(model.BaseModelName), model.Versions, status, func() error {
+			upgradeAcousticModelOptions := speechToText.NewUpgradeAcousticModelOptions(customizationID)
+			upgradeAcousticModelOptions.Headers = options.Headers
+			if languageCustomizationID, ok := options.AcousticLanguageModels[customizationID]; ok {
+				if failedLanguageModels[languageCustomizationID] {
+					return fmt.Errorf("custom language model %s was not upgraded", languageCustomizationID)
+				}
+				upgradeAcousticModelOptions.SetCustomLanguageModelID(languageCustomizationID)
+			}
+			if _, err := speechToText.UpgradeAcousticModelWithContext(ctx, upgradeAcousticModelOptions); err != nil {
+				return err
+			}
+			_, err := speechToText.waitForAcousticModelStatus(ctx, customizationID, status, options.WaitForTrainingOptions)
+			return err
+		})
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+	}
+	return report, nil
+}
+
+// updateLatestVersion : Records the newest of the versions as the latest version of the base model, if it is newer
+func updateLatestVersion(latestVersions map[string]string, baseModelName string, versions []string) {
+	if newest := newestVersion(versions); newest > latestVersions[baseModelName] {
+		latestVersions[baseModelName] = newest
+	}
+}
+
+// newestVersion : Returns the newest of the versions of a base model, such as `en-US_BroadbandModel.v2018-07-31`,
+// which end with their date and therefore sort in the order of their release
+func newestVersion(versions []string) string {
+	newest := ""
+	for _, version := range versions {
+		if version > newest {
+			newest = version
+		}
+	}
+	return newest
+}
@@ -0,0 +1,71 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomModelUpgrader", func() {
+	Describe("UpgradeAllCustomModels(upgradeAllCustomModelsOptions *UpgradeAllCustomModelsOptions)", func() {
+		Context("Successfully - Upgrade the outdated models, language models first", func() {
+			var upgrades []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				switch req.Method + " " + req.URL.Path {
+				case "GET /v1/customizations":
+					fmt.Fprint(res, `{"customizations": [
+						{"customization_id": "lm1", "name": "old", "base_model_name": "en-US_BroadbandModel", "status": "available", "versions": ["en-US_BroadbandModel.v2017-11-15"]},
+						{"customization_id": "lm2", "name": "new", "base_model_name": "en-US_BroadbandModel", "status": "available", "versions": ["en-US_BroadbandModel.v2017-11-15", "en-US_BroadbandModel.v2018-07-31"]}]}`)
+				case "GET /v1/acoustic_customizations":
+					fmt.Fprint(res, `{"customizations": [
+						{"customization_id": "am1", "name": "acoustic", "base_model_name": "en-US_BroadbandModel", "status": "ready", "versions": ["en-US_BroadbandModel.v2017-11-15"]}]}`)
+				case "POST /v1/customizations/lm1/upgrade_model":
+					upgrades = append(upgrades, "lm1")
+				case "POST /v1/acoustic_customizations/am1/upgrade_model":
+					Expect(req.URL.Query().Get("custom_language_model_id")).To(Equal("lm1"))
+					upgrades = append(upgrades, "am1")
+				case "GET /v1/customizations/lm1":
+					fmt.Fprint(res, `{"customization_id": "lm1", "status": "available"}`)
+				case "GET /v1/acoustic_customizations/am1":
+					fmt.Fprint(res, `{"customization_id": "am1", "status": "ready"}`)
+				default:
+					Fail("unexpected request " + req.Method + " " + req.URL.Path)
+				}
+			}))
+			It("Succeed to call UpgradeAllCustomModels", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				upgradeAllCustomModelsOptions := testService.NewUpgradeAllCustomModelsOptions().
+					SetAcousticLanguageModel("am1", "lm1").
+					SetWaitForTrainingOptions(testService.NewWaitForTrainingOptions().SetPollInterval(time.Millisecond))
+				report, err := testService.UpgradeAllCustomModels(upgradeAllCustomModelsOptions)
+				Expect(err).To(BeNil())
+				Expect(upgrades).To(Equal([]string{"lm1", "am1"}))
+				Expect(report.Upgraded).To(HaveLen(2))
+				Expect(report.Upgraded[0].Kind).To(Equal(speechtotextv1.CUSTOM_MODEL_KIND_LANGUAGE))
+				Expect(report.Upgraded[0].ToVersion).To(Equal("en-US_BroadbandModel.v2018-07-31"))
+				Expect(report.Failed).To(BeEmpty())
+				Expect(report.UpToDate).To(Equal(1))
+			})
+		})
+	})
+})
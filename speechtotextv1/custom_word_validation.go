@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Limits of the service on custom words.
+const (
+	MAX_SOUNDS_LIKE_PER_WORD = 5
+	MAX_SOUNDS_LIKE_LENGTH   = 40
+)
+
+// CustomWordError : A field of a custom word that breaks a rule of the service. AddWord and AddWords return it before
+// sending the request, instead of the less specific 400 response of the service.
+type CustomWordError struct {
+
+	// The custom word.
+	Word string
+
+	// The field that breaks the rule: `word`, `sounds_like`, or `display_as`.
+	Field string
+
+	// The index of the sounds-like pronunciation that breaks the rule, or -1 for the other fields.
+	Index int
+
+	// The rule that is broken.
+	Message string
+}
+
+// Error : Names the word and the field, and describes the rule that is broken
+func (err *CustomWordError) Error() string {
+	field := err.Field
+	if err.Index >= 0 {
+		field = fmt.Sprintf("%s[%d]", err.Field, err.Index)
+	}
+	return fmt.Sprintf("custom word '%s': %s: %s", err.Word, field, err.Message)
+}
+
+// ValidateCustomWord : Checks a custom word against the rules of the service. The word must not be empty or contain
+// spaces. It can have at most five sounds-like pronunciations, each checked with ValidateSoundsLike. The display-as
+// spelling must not be empty if it is set. The error is a *CustomWordError.
+func ValidateCustomWord(word CustomWord) error {
+	text := stringOrEmpty(word.Word)
+	newError := func(field string, index int, format string, args ...interface{}) error {
+		return &CustomWordError{Word: text, Field: field, Index: index, Message: fmt.Sprintf(format, args...)}
+	}
+
+	if text == "" {
+		return newError("word", -1, "cannot be empty")
+	}
+	if strings.IndexFunc(text, unicode.IsSpace) >= 0 {
+		return newError("word", -1, "cannot contain spaces; connect the tokens of compound words with '-' or '_'")
+	}
+	if len(word.SoundsLike) > MAX_SOUNDS_LIKE_PER_WORD {
+		return newError("sounds_like", -1, "has %d pronunciations; at most %d are allowed", len(word.SoundsLike), MAX_SOUNDS_LIKE_PER_WORD)
+	}
+	for i, soundsLike := range word.SoundsLike {
+		if err := ValidateSoundsLike(soundsLike); err != nil {
+			return newError("sounds_like", i, "%v", err)
+		}
+	}
+	if word.DisplayAs != nil && strings.TrimSpace(*word.DisplayAs) == "" {
+		return newError("display_as", -1, "cannot be empty if it is set")
+	}
+	return nil
+}
+
+// ValidateSoundsLike : Checks a sounds-like pronunciation against the rules of the service. A pronunciation consists of
+// letters, spaces, periods, apostrophes, and hyphens, and can include at most 40 characters, not including spaces.
+// Numbers must be spelled out, and acronyms spelled with periods, such as `I. B. M.`.
+func ValidateSoundsLike(soundsLike string) error {
+	length := 0
+	for _, r := range soundsLike {
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case unicode.IsDigit(r):
+			return fmt.Errorf("'%s' contains the digit '%c'; spell out numbers", soundsLike, r)
+		case !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r) && r != '.' && r != '\'' && r != '-':
+			return fmt.Errorf("'%s' contains the character '%c'; use only letters, spaces, periods, apostrophes, and hyphens", soundsLike, r)
+		}
+		length++
+	}
+	if length == 0 {
+		return fmt.Errorf("cannot be empty")
+	}
+	if length > MAX_SOUNDS_LIKE_LENGTH {
+		return fmt.Errorf("'%s' has %d characters, not including spaces; at most %d are allowed", soundsLike, length, MAX_SOUNDS_LIKE_LENGTH)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package speechtotextv1_test
+
+import (
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomWordValidation", func() {
+	Describe("ValidateSoundsLike(soundsLike string)", func() {
+		It("Accepts letters, spaces, periods, apostrophes, and hyphens", func() {
+			Expect(speechtotextv1.ValidateSoundsLike("I. triple E.")).To(Succeed())
+			Expect(speechtotextv1.ValidateSoundsLike("o'reilly")).To(Succeed())
+			Expect(speechtotextv1.ValidateSoundsLike("café au-lait")).To(Succeed())
+			Expect(speechtotextv1.ValidateSoundsLike(strings.Repeat("a ", 40))).To(Succeed())
+		})
+		It("Rejects digits, other characters, and long pronunciations", func() {
+			Expect(speechtotextv1.ValidateSoundsLike("route 66").Error()).To(ContainSubstring("spell out numbers"))
+			Expect(speechtotextv1.ValidateSoundsLike("AT&T")).ToNot(Succeed())
+			Expect(speechtotextv1.ValidateSoundsLike(strings.Repeat("a", 41))).ToNot(Succeed())
+			Expect(speechtotextv1.ValidateSoundsLike("  ")).ToNot(Succeed())
+		})
+	})
+	Describe("ValidateCustomWord(word CustomWord)", func() {
+		It("Reports the field that breaks a rule", func() {
+			err := speechtotextv1.ValidateCustomWord(speechtotextv1.CustomWord{
+				Word:       core.StringPtr("NCAA"),
+				SoundsLike: []string{"N. C. A. A.", "N. C. 2 A."},
+			})
+			Expect(err).ToNot(BeNil())
+			wordErr, ok := err.(*speechtotextv1.CustomWordError)
+			Expect(ok).To(BeTrue())
+			Expect(wordErr.Field).To(Equal("sounds_like"))
+			Expect(wordErr.Index).To(Equal(1))
+			Expect(err.Error()).To(HavePrefix("custom word 'NCAA': sounds_like[1]: "))
+		})
+	})
+	Describe("AddWord(addWordOptions *AddWordOptions)", func() {
+		It("Fails before sending an invalid word", func() {
+			testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+				URL: "http://localhost:1",
+				Authenticator: &core.BasicAuthenticator{
+					Username: "user1",
+					Password: "pass1",
+				},
+			})
+			Expect(testServiceErr).To(BeNil())
+
+			addWordOptions := testService.NewAddWordOptions("customization1", "IEEE").
+				SetSoundsLike([]string{"I. 3 E."})
+			_, err := testService.AddWord(addWordOptions)
+			Expect(err).To(BeAssignableToTypeOf(&speechtotextv1.CustomWordError{}))
+		})
+	})
+})
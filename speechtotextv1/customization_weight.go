@@ -0,0 +1,56 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"fmt"
+	"math"
+)
+
+// Presets of the customization weight of a custom language model, for SetCustomizationWeight of RecognizeOptions,
+// CreateJobOptions, and TrainLanguageModelOptions. The weight tells the service how much weight to give to words from
+// the custom model compared to those from the base model.
+const (
+	// CUSTOMIZATION_WEIGHT_DEFAULT is the weight that the service uses unless another was specified when the custom
+	// model was trained. It yields the best performance in general.
+	CUSTOMIZATION_WEIGHT_DEFAULT = 0.3
+
+	// CUSTOMIZATION_WEIGHT_DOMAIN_HEAVY favors the words of the custom model, for audio that makes frequent use of its
+	// out-of-vocabulary words. It improves the accuracy of phrases from the domain of the model, but can reduce the
+	// accuracy of phrases from outside of it.
+	CUSTOMIZATION_WEIGHT_DOMAIN_HEAVY = 0.6
+
+	// CUSTOMIZATION_WEIGHT_CONSERVATIVE favors the base model, for audio that only occasionally uses the words of the
+	// custom model. It keeps the accuracy of general speech close to that of the base model.
+	CUSTOMIZATION_WEIGHT_CONSERVATIVE = 0.1
+)
+
+// ValidateCustomizationWeight : Returns an error if the customization weight is not between 0.0 and 1.0
+func ValidateCustomizationWeight(customizationWeight float64) error {
+	if math.IsNaN(customizationWeight) || customizationWeight < 0.0 || customizationWeight > 1.0 {
+		return fmt.Errorf("customization weight must be between 0.0 and 1.0, found %g", customizationWeight)
+	}
+	return nil
+}
+
+// validateCustomizationWeightPtr : Validates a customization weight of a request, if it is set
+func validateCustomizationWeightPtr(customizationWeight *float64) error {
+	if customizationWeight == nil {
+		return nil
+	}
+	return ValidateCustomizationWeight(*customizationWeight)
+}
@@ -0,0 +1,60 @@
+package speechtotextv1_test
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomizationWeight", func() {
+	Describe("ValidateCustomizationWeight(customizationWeight float64)", func() {
+		It("Succeed to call ValidateCustomizationWeight", func() {
+			Expect(speechtotextv1.ValidateCustomizationWeight(speechtotextv1.CUSTOMIZATION_WEIGHT_DEFAULT)).To(Succeed())
+			Expect(speechtotextv1.ValidateCustomizationWeight(speechtotextv1.CUSTOMIZATION_WEIGHT_DOMAIN_HEAVY)).To(Succeed())
+			Expect(speechtotextv1.ValidateCustomizationWeight(speechtotextv1.CUSTOMIZATION_WEIGHT_CONSERVATIVE)).To(Succeed())
+			Expect(speechtotextv1.ValidateCustomizationWeight(0.0)).To(Succeed())
+			Expect(speechtotextv1.ValidateCustomizationWeight(1.0)).To(Succeed())
+			Expect(speechtotextv1.ValidateCustomizationWeight(-0.1)).ToNot(Succeed())
+			Expect(speechtotextv1.ValidateCustomizationWeight(1.5)).ToNot(Succeed())
+		})
+	})
+
+	Describe("Validate the customization weight of requests", func() {
+		testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+			URL: "http://localhost",
+			Authenticator: &core.BasicAuthenticator{
+				Username: "user1",
+				Password: "pass1",
+			},
+		})
+		It("Fail to call Recognize", func() {
+			Expect(testServiceErr).To(BeNil())
+			recognizeOptions := testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader("audio"))).
+				SetContentType("audio/wav").
+				SetCustomizationWeight(2)
+			_, _, err := testService.Recognize(recognizeOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("customization weight"))
+		})
+		It("Fail to call CreateJob", func() {
+			Expect(testServiceErr).To(BeNil())
+			createJobOptions := testService.NewCreateJobOptions(ioutil.NopCloser(strings.NewReader("audio"))).
+				SetContentType("audio/wav").
+				SetCustomizationWeight(-1)
+			_, _, err := testService.CreateJob(createJobOptions)
+			Expect(err).ToNot(BeNil())
+		})
+		It("Fail to call TrainLanguageModel", func() {
+			Expect(testServiceErr).To(BeNil())
+			trainLanguageModelOptions := testService.NewTrainLanguageModelOptions("customization1").
+				SetCustomizationWeight(1.2)
+			_, _, err := testService.TrainLanguageModel(trainLanguageModelOptions)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
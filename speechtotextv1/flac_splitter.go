@@ -0,0 +1,256 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/bits"
+)
+
+const (
+	// flacSegmentHeaderSize is the size of the `fLaC` marker and the STREAMINFO block that start each FLAC segment
+	flacSegmentHeaderSize = 4 + 4 + 34
+
+	// maxFLACFrameHeaderSize is the size of the longest FLAC frame header, with a seven-byte frame number and a
+	// 16-bit block size and sampling rate
+	maxFLACFrameHeaderSize = 16
+)
+
+// SplitFLAC : Divides FLAC audio into FLAC segments that do not exceed the maximum segment size. The SDK does not
+// decode FLAC, so segments end at the last frame that fits rather than at a silence, and a word can be cut in two.
+// Each segment starts with the STREAMINFO block of the audio; other metadata, such as tags and seek tables, is dropped.
+func SplitFLAC(audio []byte, options *SplitAudioOptions) ([]AudioSegment, error) {
+	splitter, err := newFLACSegmentReader(bytes.NewReader(audio), options)
+	if err != nil {
+		return nil, err
+	}
+	return readSegments(splitter)
+}
+
+// flacSegmentReader : Reads FLAC audio one segment of whole frames at a time
+type flacSegmentReader struct {
+	reader        io.Reader
+	streamInfo    []byte
+	sampleRate    int
+	maxFrameBytes int
+	buffer        []byte
+	eof           bool
+	offset        int64
+	emitted       bool
+}
+
+// newFLACSegmentReader : Reads the metadata of FLAC audio, up to its first frame
+func newFLACSegmentReader(reader io.Reader, options *SplitAudioOptions) (*flacSegmentReader, error) {
+	options = splitOptionsWithDefaults(options)
+
+	marker := make([]byte, 4)
+	if _, err := io.ReadFull(reader, marker); err != nil || string(marker) != "fLaC" {
+		return nil, fmt.Errorf("the audio is not FLAC audio")
+	}
+
+	var streamInfo []byte
+	for last := false; !last; {
+		blockHeader := make([]byte, 4)
+		if _, err := io.ReadFull(reader, blockHeader); err != nil {
+			return nil, fmt.Errorf("the FLAC metadata is truncated")
+		}
+		last = blockHeader[0]&0x80 != 0
+		blockSize := int64(blockHeader[1])<<16 | int64(blockHeader[2])<<8 | int64(blockHeader[3])
+		if blockHeader[0]&0x7F == 0 {
+			if blockSize != 34 {
+				return nil, fmt.Errorf("the FLAC STREAMINFO block has %d bytes instead of 34", blockSize)
+			}
+			streamInfo = make([]byte, blockSize)
+			if _, err := io.ReadFull(reader, streamInfo); err != nil {
+				return nil, fmt.Errorf("the FLAC metadata is truncated")
+			}
+		} else if _, err := io.CopyN(ioutil.Discard, reader, blockSize); err != nil {
+			return nil, fmt.Errorf("the FLAC metadata is truncated")
+		}
+	}
+	if streamInfo == nil {
+		return nil, fmt.Errorf("the FLAC audio has no STREAMINFO block")
+	}
+
+	sampleRate := int(streamInfo[10])<<12 | int(streamInfo[11])<<4 | int(streamInfo[12])>>4
+	if sampleRate == 0 {
+		return nil, fmt.Errorf("the FLAC STREAMINFO block has no sampling rate")
+	}
+	return &flacSegmentReader{
+		reader:        reader,
+		streamInfo:    streamInfo,
+		sampleRate:    sampleRate,
+		maxFrameBytes: int(options.MaxSegmentSize) - flacSegmentHeaderSize,
+	}, nil
+}
+
+// next : Returns the next segment, which holds as many frames as fit in the maximum segment size, and at least one
+func (splitter *flacSegmentReader) next() (*AudioSegment, error) {
+	// Read past the limit by a frame header, so that a frame that starts right at the limit is recognized
+	size := splitter.maxFrameBytes + maxFLACFrameHeaderSize
+	if size < 2*maxFLACFrameHeaderSize {
+		size = 2 * maxFLACFrameHeaderSize
+	}
+	for {
+		var err error
+		splitter.buffer, splitter.eof, err = fillBuffer(splitter.reader, splitter.buffer, size)
+		if err != nil {
+			return nil, err
+		}
+		if len(splitter.buffer) == 0 && splitter.emitted {
+			return nil, io.EOF
+		}
+
+		end, samples, err := splitter.framesEnd()
+		if err != nil {
+			return nil, err
+		}
+		if end == 0 && len(splitter.buffer) > 0 {
+			// The first frame is larger than the segment; read on until it ends
+			size *= 2
+			continue
+		}
+
+		segment := &AudioSegment{
+			Audio:       append(splitter.segmentHeader(samples), splitter.buffer[:end]...),
+			ContentType: "audio/flac",
+			Offset:      float64(splitter.offset) / float64(splitter.sampleRate),
+		}
+		splitter.buffer = splitter.buffer[end:]
+		splitter.offset += samples
+		splitter.emitted = true
+		return segment, nil
+	}
+}
+
+// framesEnd : Returns the end of the last whole frame in the buffer that fits in a segment, or of the first frame if
+// it is larger, and the number of samples per channel up to that end. The end is zero if the first frame does not
+// end in the buffer.
+func (splitter *flacSegmentReader) framesEnd() (end int, samples int64, err error) {
+	for end < len(splitter.buffer) {
+		headerSize, blockSize, ok := parseFLACFrameHeader(splitter.buffer[end:])
+		if !ok {
+			return 0, 0, fmt.Errorf("the FLAC audio has no valid frame header at sample %d", splitter.offset+samples)
+		}
+		next := nextFLACFrame(splitter.buffer, end, headerSize)
+		if next < 0 {
+			if !splitter.eof {
+				return
+			}
+			next = len(splitter.buffer)
+		}
+		if next > splitter.maxFrameBytes && end > 0 {
+			return
+		}
+		end, samples = next, samples+int64(blockSize)
+		if end > splitter.maxFrameBytes {
+			return
+		}
+	}
+	return
+}
+
+// segmentHeader : Returns the `fLaC` marker and the STREAMINFO block of a segment with the number of samples. The frame
+// sizes and the MD5 signature of the samples are left unknown.
+func (splitter *flacSegmentReader) segmentHeader(samples int64) []byte {
+	header := make([]byte, flacSegmentHeaderSize)
+	copy(header, "fLaC")
+	header[4] = 1 << 7 // Last metadata block, STREAMINFO
+	header[7] = 34
+
+	streamInfo := header[8:]
+	copy(streamInfo[0:4], splitter.streamInfo[0:4])
+	copy(streamInfo[10:13], splitter.streamInfo[10:13])
+	streamInfo[13] = splitter.streamInfo[13]&0xF0 | byte(samples>>32)&0x0F
+	binary.BigEndian.PutUint32(streamInfo[14:], uint32(samples))
+	return header
+}
+
+// parseFLACFrameHeader : Returns the size of the FLAC frame header at the start of the data and the number of samples
+// per channel of the frame. The header is only valid if its sync code, its reserved values, and its CRC-8 are.
+func parseFLACFrameHeader(data []byte) (size int, blockSize int, ok bool) {
+	if len(data) < 6 || data[0] != 0xFF || data[1]&0xFE != 0xF8 {
+		return
+	}
+	blockSizeCode, sampleRateCode := data[2]>>4, data[2]&0x0F
+	channelAssignment, sampleSizeCode := data[3]>>4, data[3]>>1&0x07
+	if blockSizeCode == 0 || sampleRateCode == 15 || channelAssignment > 10 || sampleSizeCode == 3 || data[3]&1 != 0 {
+		return
+	}
+
+	// The frame or sample number is coded like UTF-8 in one to seven bytes
+	position := 4
+	length := bits.LeadingZeros8(^data[position])
+	if length == 0 {
+		length = 1
+	} else if length == 1 || length > 7 {
+		return
+	}
+	if position+length+4 >= len(data) {
+		return
+	}
+	for i := 1; i < length; i++ {
+		if data[position+i]&0xC0 != 0x80 {
+			return
+		}
+	}
+	position += length
+
+	switch {
+	case blockSizeCode == 1:
+		blockSize = 192
+	case blockSizeCode <= 5:
+		blockSize = 576 << (blockSizeCode - 2)
+	case blockSizeCode == 6:
+		blockSize = int(data[position]) + 1
+		position++
+	case blockSizeCode == 7:
+		blockSize = int(binary.BigEndian.Uint16(data[position:])) + 1
+		position += 2
+	default:
+		blockSize = 256 << (blockSizeCode - 8)
+	}
+	switch sampleRateCode {
+	case 12:
+		position++
+	case 13, 14:
+		position += 2
+	}
+
+	if flacCRC8(data[:position]) != data[position] {
+		return
+	}
+	return position + 1, blockSize, true
+}
+
+// nextFLACFrame : Returns the start of the frame that follows the frame at start, or -1 if the data ends first. The
+// next frame starts at the first valid frame header that the CRC-16 of the frame at start ends before.
+func nextFLACFrame(data []byte, start int, headerSize int) int {
+	for position := start + headerSize + 2; position < len(data); position++ {
+		if data[position] != 0xFF {
+			continue
+		}
+		if _, _, ok := parseFLACFrameHeader(data[position:]); ok && flacCRC16(data[start:position]) == 0 {
+			return position
+		}
+	}
+	return -1
+}
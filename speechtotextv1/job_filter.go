@@ -0,0 +1,76 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"time"
+)
+
+// IsTerminal : Returns true if the job is `completed` or `failed`, after which its status no longer changes
+func (job *RecognitionJob) IsTerminal() bool {
+	status := stringOrEmpty(job.Status)
+	return status == RecognitionJob_Status_Completed || status == RecognitionJob_Status_Failed
+}
+
+// Succeeded : Returns true if the job is `completed`, so that its results are available
+func (job *RecognitionJob) Succeeded() bool {
+	return stringOrEmpty(job.Status) == RecognitionJob_Status_Completed
+}
+
+// filterRecognitionJobs : Returns the jobs that are selected by the client-side filters of the options, in order
+// Jobs whose creation time cannot be parsed are only selected when OlderThan and NewerThan are zero.
+func filterRecognitionJobs(jobs []RecognitionJob, checkJobsOptions *CheckJobsOptions) []RecognitionJob {
+	if len(checkJobsOptions.Statuses) == 0 && checkJobsOptions.UserToken == nil &&
+		checkJobsOptions.OlderThan <= 0 && checkJobsOptions.NewerThan <= 0 {
+		return jobs
+	}
+
+	now := time.Now()
+	selected := []RecognitionJob{}
+	for _, job := range jobs {
+		if len(checkJobsOptions.Statuses) > 0 && !containsString(checkJobsOptions.Statuses, stringOrEmpty(job.Status)) {
+			continue
+		}
+		if checkJobsOptions.UserToken != nil && stringOrEmpty(job.UserToken) != *checkJobsOptions.UserToken {
+			continue
+		}
+		if checkJobsOptions.OlderThan > 0 || checkJobsOptions.NewerThan > 0 {
+			created, err := time.Parse(time.RFC3339, stringOrEmpty(job.Created))
+			if err != nil {
+				continue
+			}
+			age := now.Sub(created)
+			if checkJobsOptions.OlderThan > 0 && age < checkJobsOptions.OlderThan {
+				continue
+			}
+			if checkJobsOptions.NewerThan > 0 && age > checkJobsOptions.NewerThan {
+				continue
+			}
+		}
+		selected = append(selected, job)
+	}
+	return selected
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobFilter", func() {
+	Describe("CheckJobs(checkJobsOptions *CheckJobsOptions)", func() {
+		Context("Successfully - Filter the jobs by status, user token, and age", func() {
+			now := time.Now().UTC()
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognitions"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"recognitions": [
+					{"id": "old", "status": "completed", "created": "%s", "user_token": "batch1"},
+					{"id": "new", "status": "completed", "created": "%s", "user_token": "batch2"},
+					{"id": "running", "status": "processing", "created": "%s", "user_token": "batch1"}]}`,
+					now.Add(-48*time.Hour).Format(time.RFC3339),
+					now.Add(-time.Minute).Format(time.RFC3339),
+					now.Add(-time.Minute).Format(time.RFC3339))
+			}))
+			It("Succeed to call CheckJobs", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				ids := func(checkJobsOptions *speechtotextv1.CheckJobsOptions) []string {
+					result, _, err := testService.CheckJobs(checkJobsOptions)
+					Expect(err).To(BeNil())
+					var jobIDs []string
+					for _, job := range result.Recognitions {
+						jobIDs = append(jobIDs, *job.ID)
+					}
+					return jobIDs
+				}
+
+				Expect(ids(testService.NewCheckJobsOptions())).To(Equal([]string{"old", "new", "running"}))
+				Expect(ids(testService.NewCheckJobsOptions().
+					SetStatuses([]string{speechtotextv1.RecognitionJob_Status_Completed}))).To(Equal([]string{"old", "new"}))
+				Expect(ids(testService.NewCheckJobsOptions().SetUserToken("batch1"))).To(Equal([]string{"old", "running"}))
+				Expect(ids(testService.NewCheckJobsOptions().SetOlderThan(24 * time.Hour))).To(Equal([]string{"old"}))
+				Expect(ids(testService.NewCheckJobsOptions().
+					SetNewerThan(time.Hour).
+					SetUserToken("batch1"))).To(Equal([]string{"running"}))
+			})
+		})
+	})
+	Describe("RecognitionJob status helpers", func() {
+		It("Succeed to call IsTerminal and Succeeded", func() {
+			job := &speechtotextv1.RecognitionJob{Status: core.StringPtr(speechtotextv1.RecognitionJob_Status_Completed)}
+			Expect(job.IsTerminal()).To(BeTrue())
+			Expect(job.Succeeded()).To(BeTrue())
+
+			job.Status = core.StringPtr(speechtotextv1.RecognitionJob_Status_Failed)
+			Expect(job.IsTerminal()).To(BeTrue())
+			Expect(job.Succeeded()).To(BeFalse())
+
+			job.Status = core.StringPtr(speechtotextv1.RecognitionJob_Status_Processing)
+			Expect(job.IsTerminal()).To(BeFalse())
+			Expect(job.Succeeded()).To(BeFalse())
+		})
+	})
+})
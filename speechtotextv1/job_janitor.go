@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// DEFAULT_JOB_JANITOR_INTERVAL is the interval at which StartJobJanitor deletes old jobs by default.
+const DEFAULT_JOB_JANITOR_INTERVAL = time.Hour
+
+// DeleteJobsOptions : Options that select the recognition jobs that are deleted by DeleteAllJobs
+type DeleteJobsOptions struct {
+
+	// Only jobs with one of these statuses are selected. Defaults to `completed` and `failed`; jobs that are
+	// `processing` cannot be deleted.
+	Statuses []string
+
+	// Only jobs that were created at least this long ago are selected. Zero selects jobs of any age.
+	OlderThan time.Duration
+
+	// If set, the records of the deleted jobs are removed from this store.
+	JobStore JobStore
+}
+
+// NewDeleteJobsOptions : Instantiate DeleteJobsOptions with the default values
+func (speechToText *SpeechToTextV1) NewDeleteJobsOptions() *DeleteJobsOptions {
+	return &DeleteJobsOptions{
+		Statuses: []string{RecognitionJob_Status_Completed, RecognitionJob_Status_Failed},
+	}
+}
+
+// SetStatuses : Allow user to set Statuses
+func (options *DeleteJobsOptions) SetStatuses(statuses []string) *DeleteJobsOptions {
+	options.Statuses = statuses
+	return options
+}
+
+// SetOlderThan : Allow user to set OlderThan
+func (options *DeleteJobsOptions) SetOlderThan(olderThan time.Duration) *DeleteJobsOptions {
+	options.OlderThan = olderThan
+	return options
+}
+
+// SetJobStore : Allow user to set JobStore
+func (options *DeleteJobsOptions) SetJobStore(jobStore JobStore) *DeleteJobsOptions {
+	options.JobStore = jobStore
+	return options
+}
+
+// ListJobs : Returns the jobs reported by the **Check jobs** method that are selected by the options
+// The jobs are selected with the client-side filters of CheckJobsOptions, so jobs whose creation time cannot be parsed
+// are only selected when OlderThan is zero. The options can be nil.
+func (speechToText *SpeechToTextV1) ListJobs(deleteJobsOptions *DeleteJobsOptions) ([]RecognitionJob, error) {
+	return speechToText.ListJobsWithContext(context.Background(), deleteJobsOptions)
+}
+
+// ListJobsWithContext is an alternate form of the ListJobs method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListJobsWithContext(ctx context.Context, deleteJobsOptions *DeleteJobsOptions) ([]RecognitionJob, error) {
+	if deleteJobsOptions == nil {
+		deleteJobsOptions = speechToText.NewDeleteJobsOptions()
+	}
+	statuses := deleteJobsOptions.Statuses
+	if len(statuses) == 0 {
+		statuses = speechToText.NewDeleteJobsOptions().Statuses
+	}
+	checkJobsOptions := speechToText.NewCheckJobsOptions().
+		SetStatuses(statuses).
+		SetOlderThan(deleteJobsOptions.OlderThan)
+	jobs, _, err := speechToText.CheckJobsWithContext(ctx, checkJobsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []RecognitionJob
+	for _, job := range jobs.Recognitions {
+		if job.ID != nil {
+			selected = append(selected, job)
+		}
+	}
+	return selected, nil
+}
+
+// DeleteAllJobs : Deletes the jobs that are selected by the options and returns their IDs
+// Replaces the usual loop over the **Check jobs** and **Delete a job** methods. Every selected job is attempted; if
+// any deletion fails, the IDs of the jobs that were deleted are returned with the first error. The options can be nil.
+func (speechToText *SpeechToTextV1) DeleteAllJobs(deleteJobsOptions *DeleteJobsOptions) ([]string, error) {
+	return speechToText.DeleteAllJobsWithContext(context.Background(), deleteJobsOptions)
+}
+
+// DeleteAllJobsWithContext is an alternate form of the DeleteAllJobs method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteAllJobsWithContext(ctx context.Context, deleteJobsOptions *DeleteJobsOptions) ([]string, error) {
+	if deleteJobsOptions == nil {
+		deleteJobsOptions = speechToText.NewDeleteJobsOptions()
+	}
+	jobs, err := speechToText.ListJobsWithContext(ctx, deleteJobsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []string
+	var firstErr error
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return deleted, ctx.Err()
+		}
+		_, err := speechToText.DeleteJobWithContext(ctx, speechToText.NewDeleteJobOptions(*job.ID))
+		if err == nil && deleteJobsOptions.JobStore != nil {
+			err = deleteJobsOptions.JobStore.RemoveJob(ctx, *job.ID)
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("job %s: %v", *job.ID, err)
+			}
+			continue
+		}
+		deleted = append(deleted, *job.ID)
+	}
+	return deleted, firstErr
+}
+
+// JobCleanup : The outcome of one run of a job janitor
+type JobCleanup struct {
+
+	// The IDs of the jobs that were deleted.
+	Deleted []string
+
+	// The first error of the run, if any.
+	Err error
+}
+
+// StartJobJanitor : Deletes the jobs that are selected by the options with DeleteAllJobs immediately and then at each
+// interval, until the context is cancelled. The outcome of each run is sent on the returned channel, which must be
+// drained, and which is closed when the context is cancelled. An interval of zero uses the default of one hour.
+func (speechToText *SpeechToTextV1) StartJobJanitor(ctx context.Context, deleteJobsOptions *DeleteJobsOptions, interval time.Duration) <-chan JobCleanup {
+	if interval <= 0 {
+		interval = DEFAULT_JOB_JANITOR_INTERVAL
+	}
+	cleanups := make(chan JobCleanup)
+
+	go func() {
+		defer close(cleanups)
+
+		common.Poll(ctx, interval, 0, func() (bool, error) {
+			deleted, err := speechToText.DeleteAllJobsWithContext(ctx, deleteJobsOptions)
+			if ctx.Err() != nil {
+				return true, nil
+			}
+			select {
+			case cleanups <- JobCleanup{Deleted: deleted, Err: err}:
+				return false, nil
+			case <-ctx.Done():
+				return true, nil
+			}
+		})
+	}()
+
+	return cleanups
+}
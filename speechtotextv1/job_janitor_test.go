@@ -0,0 +1,131 @@
+package speechtotextv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobJanitor", func() {
+	recent := time.Now().UTC().Format(time.RFC3339)
+	jobs := fmt.Sprintf(`{"recognitions": [
+		{"id": "old-completed", "status": "completed", "created": "2019-01-01T12:00:00.000Z", "updated": "2019-01-01T12:05:00.000Z"},
+		{"id": "old-failed", "status": "failed", "created": "2019-01-01T12:00:00.000Z"},
+		{"id": "old-processing", "status": "processing", "created": "2019-01-01T12:00:00.000Z"},
+		{"id": "new-completed", "status": "completed", "created": "%s"}]}`, recent)
+
+	Describe("DeleteAllJobs(deleteJobsOptions *DeleteJobsOptions)", func() {
+		Context("Successfully - Delete the old completed and failed jobs", func() {
+			var deletedPaths []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				if req.Method == "GET" {
+					Expect(req.URL.Path).To(Equal("/v1/recognitions"))
+					res.Header().Set("Content-type", "application/json")
+					fmt.Fprint(res, jobs)
+					return
+				}
+				Expect(req.Method).To(Equal("DELETE"))
+				deletedPaths = append(deletedPaths, req.URL.Path)
+				res.WriteHeader(http.StatusNoContent)
+			}))
+			It("Succeed to call DeleteAllJobs", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				store := speechtotextv1.NewMemoryJobStore()
+				store.SaveJob(context.Background(), speechtotextv1.JobRecord{ID: "old-completed", Status: "completed"})
+
+				deleteJobsOptions := testService.NewDeleteJobsOptions().
+					SetOlderThan(24 * time.Hour).
+					SetJobStore(store)
+				deleted, err := testService.DeleteAllJobs(deleteJobsOptions)
+				Expect(err).To(BeNil())
+				Expect(deleted).To(Equal([]string{"old-completed", "old-failed"}))
+				Expect(deletedPaths).To(Equal([]string{"/v1/recognitions/old-completed", "/v1/recognitions/old-failed"}))
+
+				record, _ := store.LoadJob(context.Background(), "old-completed")
+				Expect(record).To(BeNil())
+			})
+		})
+	})
+	Describe("ListJobs(deleteJobsOptions *DeleteJobsOptions)", func() {
+		Context("Successfully - List the jobs with the default statuses", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, jobs)
+			}))
+			It("Succeed to call ListJobs", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				listed, err := testService.ListJobs(testService.NewDeleteJobsOptions().SetStatuses(nil))
+				Expect(err).To(BeNil())
+				var ids []string
+				for _, job := range listed {
+					ids = append(ids, *job.ID)
+				}
+				Expect(ids).To(Equal([]string{"old-completed", "old-failed", "new-completed"}))
+			})
+		})
+	})
+	Describe("StartJobJanitor(ctx context.Context, deleteJobsOptions *DeleteJobsOptions, interval time.Duration)", func() {
+		Context("Successfully - Run the janitor until it is cancelled", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				if req.Method == "GET" {
+					res.Header().Set("Content-type", "application/json")
+					fmt.Fprint(res, `{"recognitions": [{"id": "job1", "status": "failed", "created": "2019-01-01T12:00:00.000Z"}]}`)
+					return
+				}
+				res.WriteHeader(http.StatusNoContent)
+			}))
+			It("Succeed to call StartJobJanitor", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				cleanups := testService.StartJobJanitor(ctx, nil, time.Millisecond)
+				for i := 0; i < 2; i++ {
+					cleanup := <-cleanups
+					Expect(cleanup.Err).To(BeNil())
+					Expect(cleanup.Deleted).To(Equal([]string{"job1"}))
+				}
+				cancel()
+				Eventually(cleanups).Should(BeClosed())
+			})
+		})
+	})
+})
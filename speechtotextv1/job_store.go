@@ -0,0 +1,324 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// JobRecord : The last known state of an asynchronous recognition job that was submitted by this process
+type JobRecord struct {
+
+	// The ID of the job.
+	ID string `json:"id"`
+
+	// The last known status of the job, such as `waiting` or `completed`.
+	Status string `json:"status"`
+
+	// A label that identifies the job to the application, such as the name of the audio file.
+	Label string `json:"label,omitempty"`
+
+	// The date and time at which the service created the job, as returned by the service.
+	Created string `json:"created,omitempty"`
+
+	// The time at which the record was last saved.
+	Updated time.Time `json:"updated"`
+}
+
+// Done : Whether the job is `completed` or `failed`, as reported by RecognitionJob.IsTerminal
+func (record JobRecord) Done() bool {
+	return (&RecognitionJob{Status: &record.Status}).IsTerminal()
+}
+
+// JobStore : Records submitted recognition jobs and their last known status, so that a restarted process can resume
+// monitoring them with ResumeJobs. Implementations must be safe for concurrent use.
+type JobStore interface {
+
+	// SaveJob : Creates or replaces the record of a job
+	SaveJob(ctx context.Context, record JobRecord) error
+
+	// LoadJob : Returns the record of a job, or nil if there is none
+	LoadJob(ctx context.Context, ID string) (*JobRecord, error)
+
+	// ListJobs : Returns the records of all jobs, ordered by ID
+	ListJobs(ctx context.Context) ([]JobRecord, error)
+
+	// RemoveJob : Removes the record of a job; removing a job without a record is not an error
+	RemoveJob(ctx context.Context, ID string) error
+}
+
+// MemoryJobStore : A JobStore that keeps its records in memory. It does not survive a restart, but is useful for tests
+// and for processes that only need the bookkeeping of ResumeJobs.
+type MemoryJobStore struct {
+	mutex   sync.Mutex
+	records map[string]JobRecord
+}
+
+// NewMemoryJobStore : Instantiate MemoryJobStore
+func NewMemoryJobStore() *MemoryJobStore {
+	return &MemoryJobStore{records: map[string]JobRecord{}}
+}
+
+// SaveJob : Creates or replaces the record of a job
+func (store *MemoryJobStore) SaveJob(ctx context.Context, record JobRecord) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.records[record.ID] = record
+	return nil
+}
+
+// LoadJob : Returns the record of a job, or nil if there is none
+func (store *MemoryJobStore) LoadJob(ctx context.Context, ID string) (*JobRecord, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	record, ok := store.records[ID]
+	if !ok {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+// ListJobs : Returns the records of all jobs, ordered by ID
+func (store *MemoryJobStore) ListJobs(ctx context.Context) ([]JobRecord, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return sortedJobRecords(store.records), nil
+}
+
+// RemoveJob : Removes the record of a job
+func (store *MemoryJobStore) RemoveJob(ctx context.Context, ID string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.records, ID)
+	return nil
+}
+
+// FileJobStore : A JobStore that keeps its records in a JSON file. The file is replaced atomically on every change, so
+// that it is not corrupted if the process stops while writing it. Only one process should use a file at a time.
+type FileJobStore struct {
+
+	// The path of the JSON file. The file and its directory are created when the first record is saved.
+	Path string
+
+	// The permissions of the file. Defaults to 0644.
+	Perm os.FileMode
+
+	mutex sync.Mutex
+}
+
+// NewFileJobStore : Instantiate FileJobStore
+func NewFileJobStore(path string) *FileJobStore {
+	return &FileJobStore{
+		Path: path,
+		Perm: 0644,
+	}
+}
+
+// SaveJob : Creates or replaces the record of a job
+func (store *FileJobStore) SaveJob(ctx context.Context, record JobRecord) error {
+	return store.update(ctx, func(records map[string]JobRecord) {
+		records[record.ID] = record
+	})
+}
+
+// LoadJob : Returns the record of a job, or nil if there is none
+func (store *FileJobStore) LoadJob(ctx context.Context, ID string) (*JobRecord, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	records, err := store.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := records[ID]
+	if !ok {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+// ListJobs : Returns the records of all jobs, ordered by ID
+func (store *FileJobStore) ListJobs(ctx context.Context) ([]JobRecord, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	records, err := store.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sortedJobRecords(records), nil
+}
+
+// RemoveJob : Removes the record of a job
+func (store *FileJobStore) RemoveJob(ctx context.Context, ID string) error {
+	return store.update(ctx, func(records map[string]JobRecord) {
+		delete(records, ID)
+	})
+}
+
+func (store *FileJobStore) read(ctx context.Context) (map[string]JobRecord, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	records := map[string]JobRecord{}
+	data, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var list []JobRecord
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("invalid job store %s: %v", store.Path, err)
+	}
+	for _, record := range list {
+		records[record.ID] = record
+	}
+	return records, nil
+}
+
+func (store *FileJobStore) update(ctx context.Context, change func(records map[string]JobRecord)) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	records, err := store.read(ctx)
+	if err != nil {
+		return err
+	}
+	change(records)
+
+	data, err := json.MarshalIndent(sortedJobRecords(records), "", "  ")
+	if err != nil {
+		return err
+	}
+	directory := filepath.Dir(store.Path)
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return err
+	}
+	tempFile, err := ioutil.TempFile(directory, filepath.Base(store.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+	_, err = tempFile.Write(data)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	perm := store.Perm
+	if perm == 0 {
+		perm = 0644
+	}
+	if err := os.Chmod(tempFile.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tempFile.Name(), store.Path)
+}
+
+// CreateTrackedJob : Creates an asynchronous recognition job and saves its record in the store, so that it can be
+// resumed with ResumeJobs after a restart. The label identifies the job to the application. If the job is created but
+// cannot be saved, the job is returned with the error.
+func (speechToText *SpeechToTextV1) CreateTrackedJob(createJobOptions *CreateJobOptions, store JobStore, label string) (*RecognitionJob, error) {
+	return speechToText.CreateTrackedJobWithContext(context.Background(), createJobOptions, store, label)
+}
+
+// CreateTrackedJobWithContext is an alternate form of the CreateTrackedJob method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CreateTrackedJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions, store JobStore, label string) (*RecognitionJob, error) {
+	job, _, err := speechToText.CreateJobWithContext(ctx, createJobOptions)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil || job.ID == nil {
+		return nil, fmt.Errorf("the service did not return a job ID")
+	}
+
+	err = store.SaveJob(ctx, JobRecord{
+		ID:      *job.ID,
+		Status:  stringOrEmpty(job.Status),
+		Label:   label,
+		Created: stringOrEmpty(job.Created),
+		Updated: time.Now(),
+	})
+	return job, err
+}
+
+// ResumeJobs : Resume monitoring the jobs of a store that are not `completed` or `failed`
+// Watches each of the jobs with WatchJob and sends their status changes on the returned channel, saving each new
+// status in the store. Errors name the job they are about. The channel is closed when every job is done or its watch
+// has ended, or when the context is cancelled. The options can be nil.
+func (speechToText *SpeechToTextV1) ResumeJobs(ctx context.Context, store JobStore, options *WatchJobOptions) (<-chan JobStatusUpdate, error) {
+	records, err := store.ListJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan JobStatusUpdate)
+	var watchers sync.WaitGroup
+	for _, record := range records {
+		if record.Done() {
+			continue
+		}
+		watchers.Add(1)
+		go func(record JobRecord) {
+			defer watchers.Done()
+			for update := range speechToText.WatchJob(ctx, record.ID, options) {
+				if update.Err == nil {
+					record.Status = stringOrEmpty(update.Job.Status)
+					record.Updated = time.Now()
+					if err := store.SaveJob(ctx, record); err != nil {
+						update.Err = err
+					}
+				}
+				if update.Err != nil {
+					update.Err = fmt.Errorf("job %s: %v", record.ID, update.Err)
+				}
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(record)
+	}
+
+	go func() {
+		watchers.Wait()
+		close(updates)
+	}()
+	return updates, nil
+}
+
+func sortedJobRecords(records map[string]JobRecord) []JobRecord {
+	sorted := make([]JobRecord, 0, len(records))
+	for _, record := range records {
+		sorted = append(sorted, record)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
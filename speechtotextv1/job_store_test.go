@@ -0,0 +1,86 @@
+package speechtotextv1_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobStore", func() {
+	Describe("FileJobStore", func() {
+		It("Keeps its records across instances", func() {
+			directory, err := ioutil.TempDir("", "jobstore")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(directory)
+			path := filepath.Join(directory, "jobs", "jobs.json")
+
+			store := speechtotextv1.NewFileJobStore(path)
+			Expect(store.SaveJob(context.Background(), speechtotextv1.JobRecord{ID: "job2", Status: "waiting", Label: "b.wav"})).To(Succeed())
+			Expect(store.SaveJob(context.Background(), speechtotextv1.JobRecord{ID: "job1", Status: "completed", Label: "a.wav"})).To(Succeed())
+
+			reopened := speechtotextv1.NewFileJobStore(path)
+			records, err := reopened.ListJobs(context.Background())
+			Expect(err).To(BeNil())
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].ID).To(Equal("job1"))
+			Expect(records[1].Label).To(Equal("b.wav"))
+			Expect(records[0].Done()).To(BeTrue())
+			Expect(records[1].Done()).To(BeFalse())
+
+			Expect(reopened.RemoveJob(context.Background(), "job1")).To(Succeed())
+			record, err := store.LoadJob(context.Background(), "job1")
+			Expect(err).To(BeNil())
+			Expect(record).To(BeNil())
+		})
+	})
+	Describe("ResumeJobs(ctx context.Context, store JobStore, options *WatchJobOptions)", func() {
+		Context("Successfully - Resume the jobs that are not done", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognitions/job2"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"id": "job2", "status": "completed", "created": "2019-01-01T12:00:00.000Z"}`)
+			}))
+			It("Succeed to call ResumeJobs", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				store := speechtotextv1.NewMemoryJobStore()
+				store.SaveJob(context.Background(), speechtotextv1.JobRecord{ID: "job1", Status: "completed"})
+				store.SaveJob(context.Background(), speechtotextv1.JobRecord{ID: "job2", Status: "processing"})
+
+				updates, err := testService.ResumeJobs(context.Background(), store, testService.NewWatchJobOptions().SetMinPollInterval(time.Millisecond))
+				Expect(err).To(BeNil())
+				var seen []string
+				for update := range updates {
+					Expect(update.Err).To(BeNil())
+					seen = append(seen, *update.Job.ID)
+				}
+				Expect(seen).To(Equal([]string{"job2"}))
+
+				record, _ := store.LoadJob(context.Background(), "job2")
+				Expect(record.Status).To(Equal("completed"))
+			})
+		})
+	})
+})
@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// DEFAULT_JOB_POLL_INTERVAL is the interval at which WaitForJob checks the status of a job by default.
+const DEFAULT_JOB_POLL_INTERVAL = 5 * time.Second
+
+// WaitForJobOptions : Options that control how a recognition job is awaited
+type WaitForJobOptions struct {
+
+	// The interval at which the status of the job is checked. Defaults to DEFAULT_JOB_POLL_INTERVAL.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the job to complete. Zero means no limit other than that of the context.
+	Timeout time.Duration
+
+	// If set, the results of the completed job are written to the sink before they are returned.
+	ResultSink ResultSink
+}
+
+// NewWaitForJobOptions : Instantiate WaitForJobOptions
+func (speechToText *SpeechToTextV1) NewWaitForJobOptions() *WaitForJobOptions {
+	return &WaitForJobOptions{
+		PollInterval: DEFAULT_JOB_POLL_INTERVAL,
+	}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (options *WaitForJobOptions) SetPollInterval(pollInterval time.Duration) *WaitForJobOptions {
+	options.PollInterval = pollInterval
+	return options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (options *WaitForJobOptions) SetTimeout(timeout time.Duration) *WaitForJobOptions {
+	options.Timeout = timeout
+	return options
+}
+
+// SetResultSink : Allow user to set ResultSink
+func (options *WaitForJobOptions) SetResultSink(resultSink ResultSink) *WaitForJobOptions {
+	options.ResultSink = resultSink
+	return options
+}
+
+// CreateJobAndWait : Creates an asynchronous recognition job and waits until it completes, returning its results.
+// An error is returned if the job fails or the wait times out. The waitForJobOptions can be nil.
+func (speechToText *SpeechToTextV1) CreateJobAndWait(createJobOptions *CreateJobOptions, waitForJobOptions *WaitForJobOptions) (*SpeechRecognitionResults, error) {
+	return speechToText.CreateJobAndWaitWithContext(context.Background(), createJobOptions, waitForJobOptions)
+}
+
+// CreateJobAndWaitWithContext is an alternate form of the CreateJobAndWait method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CreateJobAndWaitWithContext(ctx context.Context, createJobOptions *CreateJobOptions, waitForJobOptions *WaitForJobOptions) (*SpeechRecognitionResults, error) {
+	job, _, err := speechToText.CreateJobWithContext(ctx, createJobOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err = speechToText.WaitForJobWithContext(ctx, *job.ID, waitForJobOptions)
+	if err != nil {
+		return nil, err
+	}
+	if len(job.Results) == 0 {
+		return nil, fmt.Errorf("Job %s completed without results", *job.ID)
+	}
+	return &job.Results[0], nil
+}
+
+// WaitForJob : Waits until an asynchronous recognition job completes and returns it. An error is returned if the job
+// fails or the wait times out. The waitForJobOptions can be nil.
+func (speechToText *SpeechToTextV1) WaitForJob(jobID string, waitForJobOptions *WaitForJobOptions) (*RecognitionJob, error) {
+	return speechToText.WaitForJobWithContext(context.Background(), jobID, waitForJobOptions)
+}
+
+// WaitForJobWithContext is an alternate form of the WaitForJob method which supports a Context parameter
+func (speechToText *SpeechToTextV1) WaitForJobWithContext(ctx context.Context, jobID string, waitForJobOptions *WaitForJobOptions) (*RecognitionJob, error) {
+	if waitForJobOptions == nil {
+		waitForJobOptions = speechToText.NewWaitForJobOptions()
+	}
+	pollInterval := waitForJobOptions.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DEFAULT_JOB_POLL_INTERVAL
+	}
+	if waitForJobOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, waitForJobOptions.Timeout)
+		defer cancel()
+	}
+
+	var job *RecognitionJob
+	checkJobOptions := speechToText.NewCheckJobOptions(jobID)
+	err := common.Poll(ctx, pollInterval, 0, func() (done bool, err error) {
+		checked, _, err := speechToText.CheckJobWithContext(ctx, checkJobOptions)
+		if err != nil {
+			return false, err
+		}
+		job = checked
+
+		switch stringOrEmpty(job.Status) {
+		case RecognitionJob_Status_Completed:
+			if waitForJobOptions.ResultSink != nil {
+				err = waitForJobOptions.ResultSink.WriteResults(ctx, job)
+			}
+			return true, err
+		case RecognitionJob_Status_Failed:
+			return true, fmt.Errorf("Job %s failed: %s", jobID, strings.Join(job.Warnings, "; "))
+		}
+		return false, nil
+	})
+	return job, err
+}
@@ -0,0 +1,114 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobWaiter", func() {
+	username := "user1"
+	password := "pass1"
+	Describe("CreateJobAndWait(createJobOptions *CreateJobOptions, waitForJobOptions *WaitForJobOptions)", func() {
+		Context("Successfully - Job completes", func() {
+			checks := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == "POST" {
+					Expect(req.URL.Path).To(Equal("/v1/recognitions"))
+					res.WriteHeader(http.StatusCreated)
+					fmt.Fprintf(res, `{"id":"job1", "status": "waiting", "created": "2019-01-01T00:00:00.000Z"}`)
+					return
+				}
+				Expect(req.URL.Path).To(Equal("/v1/recognitions/job1"))
+				checks++
+				if checks < 3 {
+					fmt.Fprintf(res, `{"id":"job1", "status": "processing", "created": "2019-01-01T00:00:00.000Z"}`)
+					return
+				}
+				fmt.Fprintf(res, `{"id":"job1", "status": "completed", "created": "2019-01-01T00:00:00.000Z", "results": [{"result_index": 0, "results": [{"final": true, "alternatives": [{"transcript": "hello"}]}]}]}`)
+			}))
+			It("Succeed to call CreateJobAndWait", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				createJobOptions := testService.NewCreateJobOptions(ioutil.NopCloser(strings.NewReader("audio"))).
+					SetContentType("audio/wav")
+				waitForJobOptions := testService.NewWaitForJobOptions().
+					SetPollInterval(time.Millisecond)
+
+				results, err := testService.CreateJobAndWait(createJobOptions, waitForJobOptions)
+				Expect(err).To(BeNil())
+				Expect(checks).To(Equal(3))
+				Expect(*results.Results[0].Alternatives[0].Transcript).To(Equal("hello"))
+			})
+		})
+		Context("Unsuccessfully - Job fails", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"id":"job1", "status": "failed", "created": "2019-01-01T00:00:00.000Z", "warnings": ["bad audio"]}`)
+			}))
+			It("Fail to call WaitForJob", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				job, err := testService.WaitForJob("job1", nil)
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("bad audio"))
+				Expect(*job.Status).To(Equal(speechtotextv1.RecognitionJob_Status_Failed))
+			})
+		})
+		Context("Unsuccessfully - Job has no status", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"id":"job1", "created": "2019-01-01T00:00:00.000Z"}`)
+			}))
+			It("Fail to call WaitForJob", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				waitForJobOptions := testService.NewWaitForJobOptions().
+					SetPollInterval(time.Millisecond).
+					SetTimeout(20 * time.Millisecond)
+				// The job is polled until the timeout instead of panicking on the missing status.
+				_, err := testService.WaitForJob("job1", waitForJobOptions)
+				Expect(err).NotTo(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,151 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+const (
+	DEFAULT_WATCH_JOB_MIN_POLL_INTERVAL = time.Second
+	DEFAULT_WATCH_JOB_MAX_POLL_INTERVAL = 30 * time.Second
+)
+
+// WatchJobOptions : Options that control how a recognition job is polled by WatchJob
+type WatchJobOptions struct {
+
+	// The interval between the first checks of the job, and after each change of its status. Defaults to one second.
+	MinPollInterval time.Duration
+
+	// The interval between checks doubles while the status of the job does not change, up to this interval. Defaults
+	// to 30 seconds.
+	MaxPollInterval time.Duration
+}
+
+// NewWatchJobOptions : Instantiate WatchJobOptions with the default values
+func (speechToText *SpeechToTextV1) NewWatchJobOptions() *WatchJobOptions {
+	return &WatchJobOptions{
+		MinPollInterval: DEFAULT_WATCH_JOB_MIN_POLL_INTERVAL,
+		MaxPollInterval: DEFAULT_WATCH_JOB_MAX_POLL_INTERVAL,
+	}
+}
+
+// SetMinPollInterval : Allow user to set MinPollInterval
+func (options *WatchJobOptions) SetMinPollInterval(minPollInterval time.Duration) *WatchJobOptions {
+	options.MinPollInterval = minPollInterval
+	return options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (options *WatchJobOptions) SetMaxPollInterval(maxPollInterval time.Duration) *WatchJobOptions {
+	options.MaxPollInterval = maxPollInterval
+	return options
+}
+
+// JobStatusUpdate : A change of the status of a recognition job, sent by WatchJob
+type JobStatusUpdate struct {
+
+	// The job as returned by the service, if it was checked successfully.
+	Job *RecognitionJob
+
+	// The error that ended the watch, if any.
+	Err error
+}
+
+// WatchJob : Watch the status of an asynchronous recognition job
+// Polls the job and sends it on the returned channel each time its status changes, starting with its current status.
+// The channel is closed after the job is `completed` or `failed`, or after an update with an error. Checks that fail
+// with a transport error or a status code of 429 or 5xx are retried; other errors end the watch. The channel is
+// closed without an update when the context is cancelled. Polling is adaptive: the interval between checks grows
+// while the status does not change. The options can be nil.
+func (speechToText *SpeechToTextV1) WatchJob(ctx context.Context, ID string, options *WatchJobOptions) <-chan JobStatusUpdate {
+	options = watchJobOptionsWithDefaults(options)
+	updates := make(chan JobStatusUpdate)
+
+	go func() {
+		defer close(updates)
+
+		checkJobOptions := speechToText.NewCheckJobOptions(ID)
+		pollInterval := options.MinPollInterval
+		lastStatus := ""
+		for {
+			job, _, err := speechToText.CheckJobWithContext(ctx, checkJobOptions)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil && !common.IsRetryableError(ctx, err) {
+				select {
+				case updates <- JobStatusUpdate{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			if err == nil {
+				status := stringOrEmpty(job.Status)
+				if status != lastStatus {
+					lastStatus = status
+					pollInterval = options.MinPollInterval
+					select {
+					case updates <- JobStatusUpdate{Job: job}:
+					case <-ctx.Done():
+						return
+					}
+					if job.IsTerminal() {
+						return
+					}
+				}
+			}
+
+			timer := time.NewTimer(pollInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			if pollInterval *= 2; pollInterval > options.MaxPollInterval {
+				pollInterval = options.MaxPollInterval
+			}
+		}
+	}()
+
+	return updates
+}
+
+func watchJobOptionsWithDefaults(options *WatchJobOptions) *WatchJobOptions {
+	withDefaults := &WatchJobOptions{
+		MinPollInterval: DEFAULT_WATCH_JOB_MIN_POLL_INTERVAL,
+		MaxPollInterval: DEFAULT_WATCH_JOB_MAX_POLL_INTERVAL,
+	}
+	if options == nil {
+		return withDefaults
+	}
+	if options.MinPollInterval > 0 {
+		withDefaults.MinPollInterval = options.MinPollInterval
+	}
+	if options.MaxPollInterval > 0 {
+		withDefaults.MaxPollInterval = options.MaxPollInterval
+	}
+	if withDefaults.MaxPollInterval < withDefaults.MinPollInterval {
+		withDefaults.MaxPollInterval = withDefaults.MinPollInterval
+	}
+	return withDefaults
+}
@@ -0,0 +1,83 @@
+package speechtotextv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobWatcher", func() {
+	Describe("WatchJob(ctx context.Context, ID string, options *WatchJobOptions)", func() {
+		Context("Successfully - Watch a job until it completes", func() {
+			statuses := []string{"waiting", "waiting", "processing", "processing", "completed"}
+			checks := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognitions/job1"))
+				status := statuses[checks]
+				checks++
+				if checks == 2 {
+					res.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"id": "job1", "status": "%s", "created": "2019-01-01T12:00:00.000Z"}`, status)
+			}))
+			It("Succeed to call WatchJob", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				watchJobOptions := testService.NewWatchJobOptions().
+					SetMinPollInterval(time.Millisecond).
+					SetMaxPollInterval(4 * time.Millisecond)
+				var seen []string
+				for update := range testService.WatchJob(context.Background(), "job1", watchJobOptions) {
+					Expect(update.Err).To(BeNil())
+					seen = append(seen, *update.Job.Status)
+				}
+				Expect(seen).To(Equal([]string{"waiting", "processing", "completed"}))
+			})
+		})
+		Context("Unsuccessfully - Watch a job that does not exist", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(res, `{"error": "Not found", "code": 404}`)
+			}))
+			It("Fail to call WatchJob", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				updates := testService.WatchJob(context.Background(), "job1", nil)
+				update := <-updates
+				Expect(update.Err).NotTo(BeNil())
+				Eventually(updates).Should(BeClosed())
+			})
+		})
+	})
+})
@@ -0,0 +1,201 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// KeywordOccurrence : A match of a keyword in the audio of a file
+type KeywordOccurrence struct {
+
+	// The name of the file, or of whatever source the results came from.
+	FileName string `json:"file_name"`
+
+	// The keyword that was specified with the recognition request.
+	Keyword string `json:"keyword"`
+
+	// The spoken phrase that matched the keyword.
+	NormalizedText string `json:"normalized_text"`
+
+	// The start time of the match in seconds.
+	StartTime float64 `json:"start_time"`
+
+	// The end time of the match in seconds.
+	EndTime float64 `json:"end_time"`
+
+	// The confidence score of the match.
+	Confidence float64 `json:"confidence"`
+}
+
+// KeywordSummary : The matches of a keyword across all files of a KeywordReport
+type KeywordSummary struct {
+
+	// The keyword.
+	Keyword string `json:"keyword"`
+
+	// The number of matches.
+	Count int `json:"count"`
+
+	// The average confidence score of the matches.
+	AverageConfidence float64 `json:"average_confidence"`
+
+	// The number of files in which the keyword was matched.
+	Files int `json:"files"`
+}
+
+// KeywordReport : The keyword matches of a batch of transcriptions
+type KeywordReport struct {
+
+	// The matches, in the order in which they were added.
+	Occurrences []KeywordOccurrence `json:"occurrences"`
+}
+
+// NewKeywordReport : Instantiate KeywordReport
+func NewKeywordReport() *KeywordReport {
+	return &KeywordReport{}
+}
+
+// NewKeywordReportFromBatch : Instantiate KeywordReport with the keyword matches of the files that were transcribed
+func NewKeywordReportFromBatch(batchReport *BatchTranscriptionReport) *KeywordReport {
+	report := NewKeywordReport()
+	for _, result := range batchReport.Succeeded {
+		report.AddResults(result.FileName, result.Results)
+	}
+	return report
+}
+
+// AddResults : Adds the keyword matches of the final results of a file to the report, ordered by time
+func (report *KeywordReport) AddResults(fileName string, results *SpeechRecognitionResults) *KeywordReport {
+	if results == nil {
+		return report
+	}
+	var occurrences []KeywordOccurrence
+	for _, result := range results.Results {
+		if result.Final == nil || !*result.Final {
+			continue
+		}
+		for keyword, matches := range result.KeywordsResult {
+			for _, match := range matches {
+				occurrence := KeywordOccurrence{
+					FileName:       fileName,
+					Keyword:        keyword,
+					NormalizedText: stringOrEmpty(match.NormalizedText),
+				}
+				if match.StartTime != nil {
+					occurrence.StartTime = *match.StartTime
+				}
+				if match.EndTime != nil {
+					occurrence.EndTime = *match.EndTime
+				}
+				if match.Confidence != nil {
+					occurrence.Confidence = *match.Confidence
+				}
+				occurrences = append(occurrences, occurrence)
+			}
+		}
+	}
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		if occurrences[i].StartTime != occurrences[j].StartTime {
+			return occurrences[i].StartTime < occurrences[j].StartTime
+		}
+		return occurrences[i].Keyword < occurrences[j].Keyword
+	})
+	report.Occurrences = append(report.Occurrences, occurrences...)
+	return report
+}
+
+// Summaries : The number of matches, average confidence, and number of files of each keyword, ordered by keyword
+func (report *KeywordReport) Summaries() []KeywordSummary {
+	summaries := map[string]*KeywordSummary{}
+	files := map[string]map[string]bool{}
+	for _, occurrence := range report.Occurrences {
+		summary, ok := summaries[occurrence.Keyword]
+		if !ok {
+			summary = &KeywordSummary{Keyword: occurrence.Keyword}
+			summaries[occurrence.Keyword] = summary
+			files[occurrence.Keyword] = map[string]bool{}
+		}
+		summary.Count++
+		summary.AverageConfidence += occurrence.Confidence
+		files[occurrence.Keyword][occurrence.FileName] = true
+	}
+
+	sorted := make([]KeywordSummary, 0, len(summaries))
+	for keyword, summary := range summaries {
+		summary.AverageConfidence /= float64(summary.Count)
+		summary.Files = len(files[keyword])
+		sorted = append(sorted, *summary)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Keyword < sorted[j].Keyword
+	})
+	return sorted
+}
+
+// WriteCSV : Writes the matches as CSV with a header row
+func (report *KeywordReport) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	csvWriter.Write([]string{"file_name", "keyword", "normalized_text", "start_time", "end_time", "confidence"})
+	for _, occurrence := range report.Occurrences {
+		csvWriter.Write([]string{
+			occurrence.FileName,
+			occurrence.Keyword,
+			occurrence.NormalizedText,
+			strconv.FormatFloat(occurrence.StartTime, 'f', -1, 64),
+			strconv.FormatFloat(occurrence.EndTime, 'f', -1, 64),
+			strconv.FormatFloat(occurrence.Confidence, 'f', -1, 64),
+		})
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteSummaryCSV : Writes the summaries of the keywords as CSV with a header row
+func (report *KeywordReport) WriteSummaryCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	csvWriter.Write([]string{"keyword", "count", "average_confidence", "files"})
+	for _, summary := range report.Summaries() {
+		csvWriter.Write([]string{
+			summary.Keyword,
+			strconv.Itoa(summary.Count),
+			strconv.FormatFloat(summary.AverageConfidence, 'f', 3, 64),
+			strconv.Itoa(summary.Files),
+		})
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteJSON : Writes the summaries and the matches as an indented JSON object with the fields `keywords` and
+// `occurrences`
+func (report *KeywordReport) WriteJSON(writer io.Writer) error {
+	occurrences := report.Occurrences
+	if occurrences == nil {
+		occurrences = []KeywordOccurrence{}
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Keywords    []KeywordSummary    `json:"keywords"`
+		Occurrences []KeywordOccurrence `json:"occurrences"`
+	}{report.Summaries(), occurrences})
+}
@@ -0,0 +1,63 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KeywordReport", func() {
+	parse := func(data string) *speechtotextv1.SpeechRecognitionResults {
+		results := new(speechtotextv1.SpeechRecognitionResults)
+		Expect(json.Unmarshal([]byte(data), results)).To(Succeed())
+		return results
+	}
+	var call1, call2 *speechtotextv1.SpeechRecognitionResults
+	BeforeEach(func() {
+		call1 = parse(`{"results": [{"final": true, "alternatives": [{"transcript": "cancel my refund "}], "keywords_result": {
+			"refund": [{"normalized_text": "refund", "start_time": 1.5, "end_time": 2.0, "confidence": 0.9}],
+			"cancel": [{"normalized_text": "cancel", "start_time": 0.2, "end_time": 0.6, "confidence": 0.8}]}}]}`)
+		call2 = parse(`{"results": [{"final": true, "alternatives": [{"transcript": "refund "}], "keywords_result": {
+			"refund": [{"normalized_text": "refund", "start_time": 3.0, "end_time": 3.5, "confidence": 0.7}]}}]}`)
+	})
+
+	Describe("Summaries()", func() {
+		It("Aggregates the matches of each keyword", func() {
+			report := speechtotextv1.NewKeywordReport().
+				AddResults("call1.wav", call1).
+				AddResults("call2.wav", call2)
+			Expect(report.Occurrences).To(HaveLen(3))
+			Expect(report.Occurrences[0].Keyword).To(Equal("cancel"))
+
+			summaries := report.Summaries()
+			Expect(summaries).To(HaveLen(2))
+			Expect(summaries[1].Keyword).To(Equal("refund"))
+			Expect(summaries[1].Count).To(Equal(2))
+			Expect(summaries[1].Files).To(Equal(2))
+			Expect(summaries[1].AverageConfidence).To(BeNumerically("~", 0.8, 0.0001))
+		})
+	})
+	Describe("WriteCSV(writer io.Writer)", func() {
+		It("Writes one row per match", func() {
+			var buffer bytes.Buffer
+			Expect(speechtotextv1.NewKeywordReport().AddResults("call2.wav", call2).WriteCSV(&buffer)).To(Succeed())
+			Expect(buffer.String()).To(Equal("file_name,keyword,normalized_text,start_time,end_time,confidence\ncall2.wav,refund,refund,3,3.5,0.7\n"))
+		})
+	})
+	Describe("NewKeywordReportFromBatch(batchReport *BatchTranscriptionReport)", func() {
+		It("Includes the files that were transcribed", func() {
+			batchReport := &speechtotextv1.BatchTranscriptionReport{
+				Succeeded: []speechtotextv1.BatchTranscriptionResult{{FileName: "call1.wav", Results: call1}},
+			}
+			var buffer bytes.Buffer
+			Expect(speechtotextv1.NewKeywordReportFromBatch(batchReport).WriteJSON(&buffer)).To(Succeed())
+			var decoded map[string][]map[string]interface{}
+			Expect(json.Unmarshal(buffer.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded["keywords"]).To(HaveLen(2))
+			Expect(decoded["occurrences"][1]["file_name"]).To(Equal("call1.wav"))
+		})
+	})
+})
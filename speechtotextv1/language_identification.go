@@ -0,0 +1,256 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// DEFAULT_LANGUAGE_SAMPLE_DURATION is the duration of the sample of the audio that IdentifyLanguage transcribes by
+// default.
+const DEFAULT_LANGUAGE_SAMPLE_DURATION = 30 * time.Second
+
+// IdentifyLanguageOptions : The IdentifyLanguage options.
+type IdentifyLanguageOptions struct {
+
+	// The candidate models, such as `en-US_BroadbandModel` and `es-ES_BroadbandModel`.
+	Models []string `validate:"required"`
+
+	// The duration of the sample that is transcribed with each model. WAV and audio/l16 audio is cut to the sample;
+	// audio in other formats cannot be cut and is transcribed in full. Defaults to 30 seconds.
+	SampleDuration time.Duration
+
+	// The content type of the audio. If not set, it is detected from the audio.
+	ContentType *string
+
+	// Allows users to set headers to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewIdentifyLanguageOptions : Instantiate IdentifyLanguageOptions
+func (speechToText *SpeechToTextV1) NewIdentifyLanguageOptions(models []string) *IdentifyLanguageOptions {
+	return &IdentifyLanguageOptions{
+		Models:         models,
+		SampleDuration: DEFAULT_LANGUAGE_SAMPLE_DURATION,
+	}
+}
+
+// SetModels : Allow user to set Models
+func (options *IdentifyLanguageOptions) SetModels(models []string) *IdentifyLanguageOptions {
+	options.Models = models
+	return options
+}
+
+// SetSampleDuration : Allow user to set SampleDuration
+func (options *IdentifyLanguageOptions) SetSampleDuration(sampleDuration time.Duration) *IdentifyLanguageOptions {
+	options.SampleDuration = sampleDuration
+	return options
+}
+
+// SetContentType : Allow user to set ContentType
+func (options *IdentifyLanguageOptions) SetContentType(contentType string) *IdentifyLanguageOptions {
+	options.ContentType = core.StringPtr(contentType)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *IdentifyLanguageOptions) SetHeaders(param map[string]string) *IdentifyLanguageOptions {
+	options.Headers = param
+	return options
+}
+
+// LanguageCandidate : The transcription of the sample with one candidate model
+type LanguageCandidate struct {
+
+	// The candidate model.
+	Model string
+
+	// The confidence of the transcription, averaged over its words. Zero if no words were recognized.
+	Confidence float64
+
+	// The number of words that were recognized, not including hesitations.
+	Words int
+
+	// The transcript of the sample.
+	Transcript string
+
+	// The reason the sample could not be transcribed with the model, if any.
+	Err error
+}
+
+// LanguageIdentification : The outcome of IdentifyLanguage
+type LanguageIdentification struct {
+
+	// The model with the most confident transcription.
+	Model string
+
+	// The candidates, from the most to the least confident. Candidates that failed are last.
+	Candidates []LanguageCandidate
+}
+
+// IdentifyLanguage : Select the model that best matches the language of the audio
+// Transcribes a sample from the start of the audio with each candidate model and selects the model whose transcription
+// has the highest confidence, averaged over its words. Models with which the audio is transcribed in the wrong
+// language typically recognize fewer words with lower confidence. If the sample cannot be transcribed with a model, the
+// failure is reported with its candidate; an error is returned only if no model succeeds.
+func (speechToText *SpeechToTextV1) IdentifyLanguage(audio []byte, identifyLanguageOptions *IdentifyLanguageOptions) (*LanguageIdentification, error) {
+	return speechToText.IdentifyLanguageWithContext(context.Background(), audio, identifyLanguageOptions)
+}
+
+// IdentifyLanguageWithContext is an alternate form of the IdentifyLanguage method which supports a Context parameter
+func (speechToText *SpeechToTextV1) IdentifyLanguageWithContext(ctx context.Context, audio []byte, identifyLanguageOptions *IdentifyLanguageOptions) (*LanguageIdentification, error) {
+	err := core.ValidateNotNil(identifyLanguageOptions, "identifyLanguageOptions cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+	err = core.ValidateStruct(identifyLanguageOptions, "identifyLanguageOptions")
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := DetectAudioContentType(audio, "")
+	if identifyLanguageOptions.ContentType != nil {
+		contentType = *identifyLanguageOptions.ContentType
+	}
+	sampleDuration := identifyLanguageOptions.SampleDuration
+	if sampleDuration <= 0 {
+		sampleDuration = DEFAULT_LANGUAGE_SAMPLE_DURATION
+	}
+	sample := audioSample(audio, contentType, sampleDuration)
+
+	identification := &LanguageIdentification{}
+	var firstErr error
+	for _, model := range identifyLanguageOptions.Models {
+		recognizeOptions := speechToText.NewRecognizeOptions(ioutil.NopCloser(bytes.NewReader(sample))).
+			SetContentType(contentType).
+			SetModel(model).
+			SetHeaders(identifyLanguageOptions.Headers)
+		result, _, err := speechToText.RecognizeWithContext(ctx, recognizeOptions)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		candidate := LanguageCandidate{Model: model, Err: err}
+		if err == nil {
+			candidate.Transcript, candidate.Words, candidate.Confidence = scoreTranscription(result)
+		} else if firstErr == nil {
+			firstErr = fmt.Errorf("model %s: %v", model, err)
+		}
+		identification.Candidates = append(identification.Candidates, candidate)
+	}
+
+	sort.SliceStable(identification.Candidates, func(i, j int) bool {
+		a, b := identification.Candidates[i], identification.Candidates[j]
+		if (a.Err == nil) != (b.Err == nil) {
+			return a.Err == nil
+		}
+		if a.Confidence != b.Confidence {
+			return a.Confidence > b.Confidence
+		}
+		return a.Words > b.Words
+	})
+	if len(identification.Candidates) == 0 || identification.Candidates[0].Err != nil {
+		return identification, firstErr
+	}
+	identification.Model = identification.Candidates[0].Model
+	return identification, nil
+}
+
+// RecognizeWithLanguageIdentification : Recognize audio with the model selected by IdentifyLanguage
+// The model of recognizeOptions is replaced with the selected model, and the audio is recognized with
+// RecognizeLargeAudio. Audio, ContentType, and Model of recognizeOptions are ignored otherwise.
+func (speechToText *SpeechToTextV1) RecognizeWithLanguageIdentification(audio []byte, recognizeOptions *RecognizeOptions, identifyLanguageOptions *IdentifyLanguageOptions) (*SpeechRecognitionResults, *LanguageIdentification, error) {
+	return speechToText.RecognizeWithLanguageIdentificationWithContext(context.Background(), audio, recognizeOptions, identifyLanguageOptions)
+}
+
+// RecognizeWithLanguageIdentificationWithContext is an alternate form of the RecognizeWithLanguageIdentification method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RecognizeWithLanguageIdentificationWithContext(ctx context.Context, audio []byte, recognizeOptions *RecognizeOptions, identifyLanguageOptions *IdentifyLanguageOptions) (*SpeechRecognitionResults, *LanguageIdentification, error) {
+	identification, err := speechToText.IdentifyLanguageWithContext(ctx, audio, identifyLanguageOptions)
+	if err != nil {
+		return nil, identification, err
+	}
+
+	fullOptions := speechToText.NewRecognizeOptions(nil)
+	if recognizeOptions != nil {
+		*fullOptions = *recognizeOptions
+	}
+	fullOptions.Model = core.StringPtr(identification.Model)
+	fullOptions.ContentType = identifyLanguageOptions.ContentType
+	result, err := speechToText.RecognizeLargeAudioWithContext(ctx, bytes.NewReader(audio), fullOptions, nil)
+	return result, identification, err
+}
+
+// audioSample : Returns the start of WAV or audio/l16 audio, up to the duration, or the audio unchanged if it cannot
+// be cut
+func audioSample(audio []byte, contentType string, duration time.Duration) []byte {
+	mediaType, parameters := parseAudioContentType(contentType)
+	switch mediaType {
+	case "audio/wav", "audio/wave", "audio/x-wav":
+		format, data, err := parseWAV(audio)
+		if err != nil {
+			return audio
+		}
+		if length := durationBytes(duration, format); length < len(data) {
+			return buildWAV(data[:length], format)
+		}
+	case "audio/l16":
+		sampleRate, _ := strconv.Atoi(parameters["rate"])
+		channels := 1
+		if parameters["channels"] != "" {
+			channels, _ = strconv.Atoi(parameters["channels"])
+		}
+		if sampleRate <= 0 || channels <= 0 {
+			return audio
+		}
+		if length := durationBytes(duration, pcmFormat{sampleRate: sampleRate, channels: channels}); length < len(audio) {
+			return audio[:length]
+		}
+	}
+	return audio
+}
+
+// scoreTranscription : Returns the transcript of the final results, the number of words recognized, and their
+// confidence, averaged over the words
+func scoreTranscription(results *SpeechRecognitionResults) (string, int, float64) {
+	var transcripts []string
+	words, weightedConfidence := 0, 0.0
+	for _, result := range results.Results {
+		if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+			continue
+		}
+		alternative := result.Alternatives[0]
+		transcript := strings.TrimSpace(stringOrEmpty(alternative.Transcript))
+		transcripts = append(transcripts, transcript)
+		count := len(strings.Fields(hesitationMarker.ReplaceAllString(transcript, " ")))
+		words += count
+		if alternative.Confidence != nil {
+			weightedConfidence += *alternative.Confidence * float64(count)
+		}
+	}
+	if words == 0 {
+		return strings.Join(transcripts, " "), 0, 0
+	}
+	return strings.Join(transcripts, " "), words, weightedConfidence / float64(words)
+}
@@ -0,0 +1,64 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LanguageIdentification", func() {
+	Describe("IdentifyLanguage(audio []byte, identifyLanguageOptions *IdentifyLanguageOptions)", func() {
+		Context("Successfully - Select the model with the most confident transcription of a sample", func() {
+			var sampleSizes []int
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				body, _ := ioutil.ReadAll(req.Body)
+				sampleSizes = append(sampleSizes, len(body))
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.Query().Get("model") {
+				case "en-US_NarrowbandModel":
+					fmt.Fprint(res, `{"results": [{"final": true, "alternatives": [{"transcript": "oh no ", "confidence": 0.41}]}]}`)
+				case "es-ES_NarrowbandModel":
+					fmt.Fprint(res, `{"results": [{"final": true, "alternatives": [{"transcript": "buenos dias a todos ", "confidence": 0.92}]}]}`)
+				default:
+					res.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(res, `{"error": "Model not found", "code": 404}`)
+				}
+			}))
+			It("Succeed to call IdentifyLanguage", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				identifyLanguageOptions := testService.NewIdentifyLanguageOptions([]string{
+					"en-US_NarrowbandModel", "xx-XX_NarrowbandModel", "es-ES_NarrowbandModel",
+				}).SetSampleDuration(time.Second)
+				identification, err := testService.IdentifyLanguage(wavFile(8000, 1, pcmTone(3*8000, 1000)), identifyLanguageOptions)
+				Expect(err).To(BeNil())
+				Expect(identification.Model).To(Equal("es-ES_NarrowbandModel"))
+				Expect(identification.Candidates).To(HaveLen(3))
+				Expect(identification.Candidates[0].Words).To(Equal(4))
+				Expect(identification.Candidates[1].Model).To(Equal("en-US_NarrowbandModel"))
+				Expect(identification.Candidates[2].Err).ToNot(BeNil())
+				Expect(sampleSizes).To(Equal([]int{44 + 16000, 44 + 16000, 44 + 16000}))
+			})
+		})
+	})
+})
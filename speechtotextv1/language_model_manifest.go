@@ -0,0 +1,255 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// LANGUAGE_MODEL_MANIFEST_VERSION is the version of the manifest format written by WriteLanguageModelManifest.
+const LANGUAGE_MODEL_MANIFEST_VERSION = 1
+
+// LanguageModelManifest : A portable definition of a custom language model, which can be used to recreate the model
+// in another service instance or region.
+type LanguageModelManifest struct {
+
+	// The version of the manifest format.
+	Version int `json:"version"`
+
+	// The name of the custom language model.
+	Name string `json:"name"`
+
+	// The name of the base language model that the custom model is based on.
+	BaseModelName string `json:"base_model_name"`
+
+	// The dialect of the custom model.
+	Dialect string `json:"dialect,omitempty"`
+
+	// The description of the custom model.
+	Description string `json:"description,omitempty"`
+
+	// The corpora of the custom model.
+	Corpora []LanguageModelManifestCorpus `json:"corpora,omitempty"`
+
+	// The grammars of the custom model.
+	Grammars []LanguageModelManifestGrammar `json:"grammars,omitempty"`
+
+	// The words that were added to the custom model by the user.
+	Words []CustomWord `json:"words,omitempty"`
+}
+
+// LanguageModelManifestCorpus : A corpus of a LanguageModelManifest.
+type LanguageModelManifestCorpus struct {
+
+	// The name of the corpus.
+	Name string `json:"name"`
+
+	// The text of the corpus.
+	Text string `json:"text,omitempty"`
+}
+
+// LanguageModelManifestGrammar : A grammar of a LanguageModelManifest.
+type LanguageModelManifestGrammar struct {
+
+	// The name of the grammar.
+	Name string `json:"name"`
+
+	// The format of the grammar, `application/srgs` or `application/srgs+xml`.
+	ContentType string `json:"content_type,omitempty"`
+
+	// The rules of the grammar.
+	Grammar string `json:"grammar,omitempty"`
+}
+
+// SetCorpusText : Sets the text of a corpus of the manifest, adding the corpus if the manifest does not have it
+func (manifest *LanguageModelManifest) SetCorpusText(corpusName string, text string) *LanguageModelManifest {
+	for i := range manifest.Corpora {
+		if manifest.Corpora[i].Name == corpusName {
+			manifest.Corpora[i].Text = text
+			return manifest
+		}
+	}
+	manifest.Corpora = append(manifest.Corpora, LanguageModelManifestCorpus{Name: corpusName, Text: text})
+	return manifest
+}
+
+// SetGrammar : Sets the rules of a grammar of the manifest, adding the grammar if the manifest does not have it
+func (manifest *LanguageModelManifest) SetGrammar(grammarName string, contentType string, grammar string) *LanguageModelManifest {
+	for i := range manifest.Grammars {
+		if manifest.Grammars[i].Name == grammarName {
+			manifest.Grammars[i].ContentType = contentType
+			manifest.Grammars[i].Grammar = grammar
+			return manifest
+		}
+	}
+	manifest.Grammars = append(manifest.Grammars, LanguageModelManifestGrammar{Name: grammarName, ContentType: contentType, Grammar: grammar})
+	return manifest
+}
+
+// WriteLanguageModelManifest : Writes a manifest as indented JSON
+func WriteLanguageModelManifest(writer io.Writer, manifest *LanguageModelManifest) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(manifest)
+}
+
+// ReadLanguageModelManifest : Reads a manifest written by WriteLanguageModelManifest
+func ReadLanguageModelManifest(reader io.Reader) (*LanguageModelManifest, error) {
+	manifest := new(LanguageModelManifest)
+	if err := json.NewDecoder(reader).Decode(manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Version < 1 || manifest.Version > LANGUAGE_MODEL_MANIFEST_VERSION {
+		return nil, fmt.Errorf("unsupported language model manifest version %d", manifest.Version)
+	}
+	return manifest, nil
+}
+
+// ExportLanguageModel : Export a custom language model as a manifest
+// The manifest includes the metadata and the user-added words of the model, and the names of its corpora and
+// grammars. The service does not return the contents of corpora and grammars, so they must be set with SetCorpusText
+// and SetGrammar before the manifest can be imported.
+func (speechToText *SpeechToTextV1) ExportLanguageModel(customizationID string) (*LanguageModelManifest, error) {
+	return speechToText.ExportLanguageModelWithContext(context.Background(), customizationID)
+}
+
+// ExportLanguageModelWithContext is an alternate form of the ExportLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ExportLanguageModelWithContext(ctx context.Context, customizationID string) (*LanguageModelManifest, error) {
+	model, _, err := speechToText.GetLanguageModelWithContext(ctx, speechToText.NewGetLanguageModelOptions(customizationID))
+	if err != nil {
+		return nil, err
+	}
+	corpora, _, err := speechToText.ListCorporaWithContext(ctx, speechToText.NewListCorporaOptions(customizationID))
+	if err != nil {
+		return nil, err
+	}
+	grammars, _, err := speechToText.ListGrammarsWithContext(ctx, speechToText.NewListGrammarsOptions(customizationID))
+	if err != nil {
+		return nil, err
+	}
+	listWordsOptions := speechToText.NewListWordsOptions(customizationID).
+		SetWordType(ListWordsOptions_WordType_User)
+	words, _, err := speechToText.ListWordsWithContext(ctx, listWordsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &LanguageModelManifest{
+		Version:       LANGUAGE_MODEL_MANIFEST_VERSION,
+		Name:          stringOrEmpty(model.Name),
+		BaseModelName: stringOrEmpty(model.BaseModelName),
+		Dialect:       stringOrEmpty(model.Dialect),
+		Description:   stringOrEmpty(model.Description),
+	}
+	for _, corpus := range corpora.Corpora {
+		manifest.Corpora = append(manifest.Corpora, LanguageModelManifestCorpus{Name: stringOrEmpty(corpus.Name)})
+	}
+	for _, grammar := range grammars.Grammars {
+		manifest.Grammars = append(manifest.Grammars, LanguageModelManifestGrammar{Name: stringOrEmpty(grammar.Name)})
+	}
+	for _, word := range words.Words {
+		manifest.Words = append(manifest.Words, CustomWord{
+			Word:       word.Word,
+			SoundsLike: word.SoundsLike,
+			DisplayAs:  word.DisplayAs,
+		})
+	}
+	return manifest, nil
+}
+
+// ImportLanguageModel : Create a custom language model from a manifest
+// Creates the model and adds the corpora, grammars, and words of the manifest, waiting for the service to process
+// each addition before the next. Every corpus and grammar must have its contents set. The model is not trained; use
+// TrainLanguageModel when the import is complete. The waitForTrainingOptions can be nil.
+func (speechToText *SpeechToTextV1) ImportLanguageModel(manifest *LanguageModelManifest, waitForTrainingOptions *WaitForTrainingOptions) (*LanguageModel, error) {
+	return speechToText.ImportLanguageModelWithContext(context.Background(), manifest, waitForTrainingOptions)
+}
+
+// ImportLanguageModelWithContext is an alternate form of the ImportLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ImportLanguageModelWithContext(ctx context.Context, manifest *LanguageModelManifest, waitForTrainingOptions *WaitForTrainingOptions) (*LanguageModel, error) {
+	err := core.ValidateNotNil(manifest, "manifest cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+	for _, corpus := range manifest.Corpora {
+		if corpus.Text == "" {
+			return nil, fmt.Errorf("the manifest has no text for corpus '%s'", corpus.Name)
+		}
+	}
+	for _, grammar := range manifest.Grammars {
+		if grammar.Grammar == "" || grammar.ContentType == "" {
+			return nil, fmt.Errorf("the manifest has no rules or content type for grammar '%s'", grammar.Name)
+		}
+	}
+
+	createLanguageModelOptions := speechToText.NewCreateLanguageModelOptions(manifest.Name, manifest.BaseModelName)
+	if manifest.Dialect != "" {
+		createLanguageModelOptions.SetDialect(manifest.Dialect)
+	}
+	if manifest.Description != "" {
+		createLanguageModelOptions.SetDescription(manifest.Description)
+	}
+	model, _, err := speechToText.CreateLanguageModelWithContext(ctx, createLanguageModelOptions)
+	if err != nil {
+		return nil, err
+	}
+	customizationID := *model.CustomizationID
+
+	// The service processes one addition to a model at a time
+	waitUntilReady := func() error {
+		model, err = speechToText.waitForLanguageModelStatus(ctx, customizationID, LanguageModel_Status_Ready, waitForTrainingOptions)
+		return err
+	}
+
+	for _, corpus := range manifest.Corpora {
+		corpusFile := ioutil.NopCloser(strings.NewReader(corpus.Text))
+		addCorpusOptions := speechToText.NewAddCorpusOptions(customizationID, corpus.Name, corpusFile)
+		if _, err = speechToText.AddCorpusWithContext(ctx, addCorpusOptions); err != nil {
+			return model, err
+		}
+		if err = waitUntilReady(); err != nil {
+			return model, err
+		}
+	}
+	for _, grammar := range manifest.Grammars {
+		grammarFile := ioutil.NopCloser(strings.NewReader(grammar.Grammar))
+		addGrammarOptions := speechToText.NewAddGrammarOptions(customizationID, grammar.Name, grammarFile, grammar.ContentType)
+		if _, err = speechToText.AddGrammarWithContext(ctx, addGrammarOptions); err != nil {
+			return model, err
+		}
+		if err = waitUntilReady(); err != nil {
+			return model, err
+		}
+	}
+	if len(manifest.Words) > 0 {
+		addWordsOptions := speechToText.NewAddWordsOptions(customizationID, manifest.Words)
+		if _, err = speechToText.AddWordsWithContext(ctx, addWordsOptions); err != nil {
+			return model, err
+		}
+		if err = waitUntilReady(); err != nil {
+			return model, err
+		}
+	}
+	return model, nil
+}
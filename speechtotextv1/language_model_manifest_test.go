@@ -0,0 +1,84 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LanguageModelManifest", func() {
+	Describe("ExportLanguageModel(customizationID string)", func() {
+		Context("Successfully - Export a model", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.Path {
+				case "/v1/customizations/model1":
+					fmt.Fprintf(res, `{"customization_id": "model1", "name": "Products", "base_model_name": "en-US_BroadbandModel", "dialect": "en-US"}`)
+				case "/v1/customizations/model1/corpora":
+					fmt.Fprintf(res, `{"corpora": [{"name": "catalog", "total_words": 10, "out_of_vocabulary_words": 1, "status": "analyzed"}]}`)
+				case "/v1/customizations/model1/grammars":
+					fmt.Fprintf(res, `{"grammars": []}`)
+				case "/v1/customizations/model1/words":
+					Expect(req.URL.Query().Get("word_type")).To(Equal("user"))
+					fmt.Fprintf(res, `{"words": [{"word": "IEEE", "sounds_like": ["I. triple E."], "display_as": "IEEE", "count": 1, "source": ["user"]}]}`)
+				}
+			}))
+			It("Succeed to call ExportLanguageModel", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				manifest, err := testService.ExportLanguageModel("model1")
+				Expect(err).To(BeNil())
+				Expect(manifest.Name).To(Equal("Products"))
+				Expect(manifest.BaseModelName).To(Equal("en-US_BroadbandModel"))
+				Expect(manifest.Corpora).To(Equal([]speechtotextv1.LanguageModelManifestCorpus{{Name: "catalog"}}))
+				Expect(*manifest.Words[0].Word).To(Equal("IEEE"))
+
+				var buffer bytes.Buffer
+				Expect(speechtotextv1.WriteLanguageModelManifest(&buffer, manifest.SetCorpusText("catalog", "Widgets and gadgets."))).To(Succeed())
+				readManifest, err := speechtotextv1.ReadLanguageModelManifest(&buffer)
+				Expect(err).To(BeNil())
+				Expect(readManifest).To(Equal(manifest))
+				Expect(readManifest.Corpora[0].Text).To(Equal("Widgets and gadgets."))
+			})
+		})
+	})
+	Describe("ImportLanguageModel(manifest *LanguageModelManifest, waitForTrainingOptions *WaitForTrainingOptions)", func() {
+		It("Fails for a corpus without text", func() {
+			testService := &speechtotextv1.SpeechToTextV1{}
+			manifest := &speechtotextv1.LanguageModelManifest{
+				Version:       speechtotextv1.LANGUAGE_MODEL_MANIFEST_VERSION,
+				Name:          "Products",
+				BaseModelName: "en-US_BroadbandModel",
+				Corpora:       []speechtotextv1.LanguageModelManifestCorpus{{Name: "catalog"}},
+			}
+			_, err := testService.ImportLanguageModel(manifest, nil)
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("catalog"))
+		})
+	})
+	Describe("ReadLanguageModelManifest(reader io.Reader)", func() {
+		It("Rejects unsupported versions", func() {
+			_, err := speechtotextv1.ReadLanguageModelManifest(strings.NewReader(`{"version": 99, "name": "x"}`))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+)
+
+// LanguageModelReport : A summary of the contents of a custom language model, combining the model, its corpora, and
+// its words resource.
+type LanguageModelReport struct {
+
+	// The custom language model.
+	Model *LanguageModel `json:"model"`
+
+	// The number of words in the custom model's words resource.
+	TotalWords int64 `json:"total_words"`
+
+	// The number of words added by each source. A source is either `user` or the name of a corpus or grammar; a word
+	// added by several sources is counted once for each of them.
+	WordsBySource map[string]int64 `json:"words_by_source"`
+
+	// The total number of words across all corpora.
+	CorporaTotalWords int64 `json:"corpora_total_words"`
+
+	// The number of OOV words across all corpora.
+	CorporaOutOfVocabularyWords int64 `json:"corpora_out_of_vocabulary_words"`
+
+	// The ratio of OOV words to total words across all corpora. The value is `0` if the corpora contain no words.
+	OutOfVocabularyRatio float64 `json:"out_of_vocabulary_ratio"`
+
+	// The status of each corpus, keyed by corpus name.
+	CorporaStatus map[string]string `json:"corpora_status"`
+
+	// The words whose definitions contain errors that need to be corrected before training.
+	WordsWithErrors []Word `json:"words_with_errors,omitempty"`
+}
+
+// GetLanguageModelReport : Builds a LanguageModelReport for a custom language model
+func (speechToText *SpeechToTextV1) GetLanguageModelReport(customizationID string) (*LanguageModelReport, error) {
+	return speechToText.GetLanguageModelReportWithContext(context.Background(), customizationID)
+}
+
+// GetLanguageModelReportWithContext is an alternate form of the GetLanguageModelReport method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetLanguageModelReportWithContext(ctx context.Context, customizationID string) (*LanguageModelReport, error) {
+	model, _, err := speechToText.GetLanguageModelWithContext(ctx, speechToText.NewGetLanguageModelOptions(customizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	corpora, _, err := speechToText.ListCorporaWithContext(ctx, speechToText.NewListCorporaOptions(customizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	listWordsOptions := speechToText.NewListWordsOptions(customizationID).
+		SetWordType(ListWordsOptions_WordType_All)
+	words, _, err := speechToText.ListWordsWithContext(ctx, listWordsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLanguageModelReport(model, corpora, words), nil
+}
+
+// NewLanguageModelReport : Builds a LanguageModelReport from previously retrieved resources of a custom language model
+func NewLanguageModelReport(model *LanguageModel, corpora *Corpora, words *Words) *LanguageModelReport {
+	report := &LanguageModelReport{
+		Model:         model,
+		WordsBySource: make(map[string]int64),
+		CorporaStatus: make(map[string]string),
+	}
+
+	if corpora != nil {
+		for _, corpus := range corpora.Corpora {
+			if corpus.TotalWords != nil {
+				report.CorporaTotalWords += *corpus.TotalWords
+			}
+			if corpus.OutOfVocabularyWords != nil {
+				report.CorporaOutOfVocabularyWords += *corpus.OutOfVocabularyWords
+			}
+			if corpus.Name != nil && corpus.Status != nil {
+				report.CorporaStatus[*corpus.Name] = *corpus.Status
+			}
+		}
+		if report.CorporaTotalWords > 0 {
+			report.OutOfVocabularyRatio = float64(report.CorporaOutOfVocabularyWords) / float64(report.CorporaTotalWords)
+		}
+	}
+
+	if words != nil {
+		report.TotalWords = int64(len(words.Words))
+		for _, word := range words.Words {
+			for _, source := range word.Source {
+				report.WordsBySource[source]++
+			}
+			if len(word.Error) > 0 {
+				report.WordsWithErrors = append(report.WordsWithErrors, word)
+			}
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,36 @@
+package speechtotextv1_test
+
+import (
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LanguageModelReport", func() {
+	Describe("NewLanguageModelReport(model *LanguageModel, corpora *Corpora, words *Words)", func() {
+		It("Summarizes corpora and words", func() {
+			corpora := &speechtotextv1.Corpora{
+				Corpora: []speechtotextv1.Corpus{
+					{Name: core.StringPtr("corpus1"), TotalWords: core.Int64Ptr(80), OutOfVocabularyWords: core.Int64Ptr(8), Status: core.StringPtr("analyzed")},
+					{Name: core.StringPtr("corpus2"), TotalWords: core.Int64Ptr(20), OutOfVocabularyWords: core.Int64Ptr(2), Status: core.StringPtr("being_processed")},
+				},
+			}
+			words := &speechtotextv1.Words{
+				Words: []speechtotextv1.Word{
+					{Word: core.StringPtr("hhonors"), Source: []string{"corpus1", "user"}},
+					{Word: core.StringPtr("ieee"), Source: []string{"corpus1"}, Error: []speechtotextv1.WordError{{Element: core.StringPtr("bad")}}},
+				},
+			}
+
+			report := speechtotextv1.NewLanguageModelReport(&speechtotextv1.LanguageModel{}, corpora, words)
+			Expect(report.TotalWords).To(Equal(int64(2)))
+			Expect(report.WordsBySource["corpus1"]).To(Equal(int64(2)))
+			Expect(report.WordsBySource["user"]).To(Equal(int64(1)))
+			Expect(report.OutOfVocabularyRatio).To(BeNumerically("~", 0.1))
+			Expect(report.CorporaStatus["corpus2"]).To(Equal("being_processed"))
+			Expect(report.WordsWithErrors).To(HaveLen(1))
+		})
+	})
+})
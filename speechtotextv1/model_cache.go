@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// DEFAULT_MODEL_CACHE_TTL is a suggested time to live for EnableModelCache. Base model metadata rarely changes.
+const DEFAULT_MODEL_CACHE_TTL = time.Hour
+
+// modelCache : Holds the results of ListModels and GetModel until they expire. A ttl of zero disables the cache.
+type modelCache struct {
+	mutex  sync.Mutex
+	ttl    time.Duration
+	list   *modelCacheEntry
+	models map[string]*modelCacheEntry
+}
+
+type modelCacheEntry struct {
+	result   interface{}
+	response *core.DetailedResponse
+	expires  time.Time
+}
+
+// EnableModelCache : Cache the results of ListModels and GetModel in memory
+// Successful results are kept for the ttl and returned, with the response they were received with, instead of
+// sending a request. A model that is in a cached ListModels result is also returned by GetModel, with a copy of the
+// ListModels response whose result is the model. Cached results are shared between callers and must not be modified.
+// A ttl of zero or less disables the cache. The cache can be enabled, disabled and cleared while requests are sent
+// from other goroutines; changing the ttl discards the cached results.
+func (speechToText *SpeechToTextV1) EnableModelCache(ttl time.Duration) {
+	speechToText.modelCache.reset(ttl)
+}
+
+// ClearModelCache : Discard the cached results of ListModels and GetModel, so that the next calls send requests
+func (speechToText *SpeechToTextV1) ClearModelCache() {
+	speechToText.modelCache.clear()
+}
+
+// reset : Sets the ttl of the cache and discards the cached results
+func (cache *modelCache) reset(ttl time.Duration) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.ttl = ttl
+	cache.list = nil
+	cache.models = nil
+}
+
+func (cache *modelCache) clear() {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.list = nil
+	cache.models = nil
+}
+
+// getList : Returns the cached result of ListModels, if there is one that has not expired
+func (cache *modelCache) getList() (*SpeechModels, *core.DetailedResponse, bool) {
+	if cache == nil {
+		return nil, nil, false
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.list == nil || time.Now().After(cache.list.expires) {
+		return nil, nil, false
+	}
+	return cache.list.result.(*SpeechModels), cache.list.response, true
+}
+
+func (cache *modelCache) putList(result *SpeechModels, response *core.DetailedResponse) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.ttl <= 0 {
+		return
+	}
+	cache.list = &modelCacheEntry{result: result, response: response, expires: time.Now().Add(cache.ttl)}
+}
+
+// getModel : Returns the cached result of GetModel for the model, or the model from the cached result of ListModels,
+// if there is one that has not expired
+func (cache *modelCache) getModel(modelID string) (*SpeechModel, *core.DetailedResponse, bool) {
+	if cache == nil {
+		return nil, nil, false
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	now := time.Now()
+	if entry, ok := cache.models[modelID]; ok && !now.After(entry.expires) {
+		return entry.result.(*SpeechModel), entry.response, true
+	}
+	if cache.list != nil && !now.After(cache.list.expires) {
+		models := cache.list.result.(*SpeechModels).Models
+		for i := range models {
+			if stringOrEmpty(models[i].Name) == modelID {
+				return &models[i], modelResponse(cache.list.response, &models[i]), true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+func (cache *modelCache) putModel(modelID string, result *SpeechModel, response *core.DetailedResponse) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.ttl <= 0 {
+		return
+	}
+	if cache.models == nil {
+		cache.models = map[string]*modelCacheEntry{}
+	}
+	cache.models[modelID] = &modelCacheEntry{result: result, response: response, expires: time.Now().Add(cache.ttl)}
+}
+
+// modelResponse : Returns a copy of the response of ListModels whose result is one of the listed models, as GetModel
+// returns it
+func modelResponse(listResponse *core.DetailedResponse, model *SpeechModel) *core.DetailedResponse {
+	if listResponse == nil {
+		return nil
+	}
+	return &core.DetailedResponse{
+		StatusCode: listResponse.StatusCode,
+		Headers:    listResponse.Headers,
+		Result:     model,
+	}
+}
@@ -0,0 +1,110 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ModelCache", func() {
+	Describe("EnableModelCache(ttl time.Duration)", func() {
+		Context("Successfully - Serve ListModels and GetModel from the cache", func() {
+			requests := map[string]int{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				requests[req.URL.Path]++
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.Path {
+				case "/v1/models":
+					fmt.Fprint(res, `{"models": [{"name": "en-US_BroadbandModel", "language": "en-US", "rate": 16000}]}`)
+				case "/v1/models/fr-FR_BroadbandModel":
+					fmt.Fprint(res, `{"name": "fr-FR_BroadbandModel", "language": "fr-FR", "rate": 16000}`)
+				default:
+					Fail("unexpected request " + req.URL.Path)
+				}
+			}))
+			It("Succeed to call ListModels and GetModel", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				testService.EnableModelCache(time.Hour)
+
+				for i := 0; i < 3; i++ {
+					result, _, err := testService.ListModels(testService.NewListModelsOptions())
+					Expect(err).To(BeNil())
+					Expect(result.Models).To(HaveLen(1))
+				}
+				Expect(requests["/v1/models"]).To(Equal(1))
+
+				model, response, err := testService.GetModel(testService.NewGetModelOptions("en-US_BroadbandModel"))
+				Expect(err).To(BeNil())
+				Expect(*model.Rate).To(Equal(int64(16000)))
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Result).To(Equal(model))
+				Expect(requests).To(HaveLen(1))
+
+				for i := 0; i < 2; i++ {
+					model, _, err = testService.GetModel(testService.NewGetModelOptions("fr-FR_BroadbandModel"))
+					Expect(err).To(BeNil())
+					Expect(*model.Language).To(Equal("fr-FR"))
+				}
+				Expect(requests["/v1/models/fr-FR_BroadbandModel"]).To(Equal(1))
+
+				testService.ClearModelCache()
+				_, _, err = testService.ListModels(testService.NewListModelsOptions())
+				Expect(err).To(BeNil())
+				Expect(requests["/v1/models"]).To(Equal(2))
+
+				testService.EnableModelCache(0)
+				_, _, err = testService.ListModels(testService.NewListModelsOptions())
+				Expect(err).To(BeNil())
+				_, _, err = testService.ListModels(testService.NewListModelsOptions())
+				Expect(err).To(BeNil())
+				Expect(requests["/v1/models"]).To(Equal(4))
+			})
+		})
+		Context("Successfully - Send requests again after the results expire", func() {
+			requests := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				requests++
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"models": []}`)
+			}))
+			It("Succeed to call ListModels", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				testService.EnableModelCache(time.Millisecond)
+
+				_, _, err := testService.ListModels(testService.NewListModelsOptions())
+				Expect(err).To(BeNil())
+				time.Sleep(5 * time.Millisecond)
+				_, _, err = testService.ListModels(testService.NewListModelsOptions())
+				Expect(err).To(BeNil())
+				Expect(requests).To(Equal(2))
+			})
+		})
+	})
+})
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// Intervals at which the status of a training custom model is checked by default, as recommended by the service
+// documentation.
+const (
+	DEFAULT_TRAINING_POLL_INTERVAL          = 10 * time.Second
+	DEFAULT_ACOUSTIC_TRAINING_POLL_INTERVAL = time.Minute
+)
+
+// TrainingProgressFunc : Called with the status and progress of a custom model each time it is checked. The progress
+// is a percentage, and is only reported by the service for some operations.
+type TrainingProgressFunc func(status string, progress int64)
+
+// WaitForTrainingOptions : Options that control how the training of a custom model is awaited
+type WaitForTrainingOptions struct {
+
+	// The interval at which the status of the model is checked. Defaults to DEFAULT_TRAINING_POLL_INTERVAL for
+	// language models and DEFAULT_ACOUSTIC_TRAINING_POLL_INTERVAL for acoustic models.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the training to finish. Zero means no limit other than that of the context.
+	Timeout time.Duration
+
+	// If set, called after each check of the model.
+	Progress TrainingProgressFunc
+}
+
+// NewWaitForTrainingOptions : Instantiate WaitForTrainingOptions
+func (speechToText *SpeechToTextV1) NewWaitForTrainingOptions() *WaitForTrainingOptions {
+	return &WaitForTrainingOptions{}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (options *WaitForTrainingOptions) SetPollInterval(pollInterval time.Duration) *WaitForTrainingOptions {
+	options.PollInterval = pollInterval
+	return options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (options *WaitForTrainingOptions) SetTimeout(timeout time.Duration) *WaitForTrainingOptions {
+	options.Timeout = timeout
+	return options
+}
+
+// SetProgress : Allow user to set Progress
+func (options *WaitForTrainingOptions) SetProgress(progress TrainingProgressFunc) *WaitForTrainingOptions {
+	options.Progress = progress
+	return options
+}
+
+// WaitForLanguageModelTraining : Waits until a custom language model has the status `available` after it was
+// trained or upgraded, and returns it. An error is returned if the model has the status `failed` or the wait times
+// out. The waitForTrainingOptions can be nil.
+func (speechToText *SpeechToTextV1) WaitForLanguageModelTraining(customizationID string, waitForTrainingOptions *WaitForTrainingOptions) (*LanguageModel, error) {
+	return speechToText.WaitForLanguageModelTrainingWithContext(context.Background(), customizationID, waitForTrainingOptions)
+}
+
+// WaitForLanguageModelTrainingWithContext is an alternate form of the WaitForLanguageModelTraining method which supports a Context parameter
+func (speechToText *SpeechToTextV1) WaitForLanguageModelTrainingWithContext(ctx context.Context, customizationID string, waitForTrainingOptions *WaitForTrainingOptions) (*LanguageModel, error) {
+	return speechToText.waitForLanguageModelStatus(ctx, customizationID, LanguageModel_Status_Available, waitForTrainingOptions)
+}
+
+// WaitForAcousticModelTraining : Waits until a custom acoustic model has the status `available` after it was
+// trained or upgraded, and returns it. An error is returned if the model has the status `failed` or the wait times
+// out. The waitForTrainingOptions can be nil.
+func (speechToText *SpeechToTextV1) WaitForAcousticModelTraining(customizationID string, waitForTrainingOptions *WaitForTrainingOptions) (*AcousticModel, error) {
+	return speechToText.WaitForAcousticModelTrainingWithContext(context.Background(), customizationID, waitForTrainingOptions)
+}
+
+// WaitForAcousticModelTrainingWithContext is an alternate form of the WaitForAcousticModelTraining method which supports a Context parameter
+func (speechToText *SpeechToTextV1) WaitForAcousticModelTrainingWithContext(ctx context.Context, customizationID string, waitForTrainingOptions *WaitForTrainingOptions) (*AcousticModel, error) {
+	return speechToText.waitForAcousticModelStatus(ctx, customizationID, AcousticModel_Status_Available, waitForTrainingOptions)
+}
+
+// waitForLanguageModelStatus : Waits until a custom language model has the status, or fails
+func (speechToText *SpeechToTextV1) waitForLanguageModelStatus(ctx context.Context, customizationID string, doneStatus string, waitForTrainingOptions *WaitForTrainingOptions) (*LanguageModel, error) {
+	getLanguageModelOptions := speechToText.NewGetLanguageModelOptions(customizationID)
+
+	var languageModel *LanguageModel
+	err := speechToText.waitForModelStatus(ctx, waitForTrainingOptions, func(ctx context.Context) (status string, progress int64, err error) {
+		languageModel, _, err = speechToText.GetLanguageModelWithContext(ctx, getLanguageModelOptions)
+		if err != nil {
+			return
+		}
+		status, progress = stringOrEmpty(languageModel.Status), int64OrZero(languageModel.Progress)
+		if status == LanguageModel_Status_Failed {
+			err = fmt.Errorf("Custom language model %s failed: %s", customizationID, stringOrEmpty(languageModel.Error))
+		}
+		return
+	}, doneStatus, DEFAULT_TRAINING_POLL_INTERVAL)
+	return languageModel, err
+}
+
+// waitForAcousticModelStatus : Waits until a custom acoustic model has the status, or fails
+func (speechToText *SpeechToTextV1) waitForAcousticModelStatus(ctx context.Context, customizationID string, doneStatus string, waitForTrainingOptions *WaitForTrainingOptions) (*AcousticModel, error) {
+	getAcousticModelOptions := speechToText.NewGetAcousticModelOptions(customizationID)
+
+	var acousticModel *AcousticModel
+	err := speechToText.waitForModelStatus(ctx, waitForTrainingOptions, func(ctx context.Context) (status string, progress int64, err error) {
+		acousticModel, _, err = speechToText.GetAcousticModelWithContext(ctx, getAcousticModelOptions)
+		if err != nil {
+			return
+		}
+		status, progress = stringOrEmpty(acousticModel.Status), int64OrZero(acousticModel.Progress)
+		if status == AcousticModel_Status_Failed {
+			err = fmt.Errorf("Custom acoustic model %s failed: %s", customizationID, stringOrEmpty(acousticModel.Warnings))
+		}
+		return
+	}, doneStatus, DEFAULT_ACOUSTIC_TRAINING_POLL_INTERVAL)
+	return acousticModel, err
+}
+
+// waitForModelStatus : Checks the status of a model until it reaches the done status, the check fails, or the context
+// is done
+func (speechToText *SpeechToTextV1) waitForModelStatus(ctx context.Context, options *WaitForTrainingOptions, check func(ctx context.Context) (string, int64, error), doneStatus string, defaultPollInterval time.Duration) error {
+	if options == nil {
+		options = speechToText.NewWaitForTrainingOptions()
+	}
+	pollInterval := options.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	return common.Poll(ctx, pollInterval, 0, func() (bool, error) {
+		status, progress, err := check(ctx)
+		if status != "" && options.Progress != nil {
+			options.Progress(status, progress)
+		}
+		return status == doneStatus, err
+	})
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func int64OrZero(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,87 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ModelTrainingWaiter", func() {
+	username := "user1"
+	password := "pass1"
+	Describe("WaitForLanguageModelTraining(customizationID string, waitForTrainingOptions *WaitForTrainingOptions)", func() {
+		Context("Successfully - Training finishes", func() {
+			checks := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/customizations/model1"))
+				res.Header().Set("Content-type", "application/json")
+				checks++
+				if checks < 3 {
+					fmt.Fprintf(res, `{"customization_id": "model1", "status": "training", "progress": %d}`, checks*40)
+					return
+				}
+				fmt.Fprintf(res, `{"customization_id": "model1", "status": "available", "progress": 100}`)
+			}))
+			It("Succeed to call WaitForLanguageModelTraining", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				var progress []int64
+				waitForTrainingOptions := testService.NewWaitForTrainingOptions().
+					SetPollInterval(time.Millisecond).
+					SetProgress(func(status string, percent int64) {
+						progress = append(progress, percent)
+					})
+				languageModel, err := testService.WaitForLanguageModelTraining("model1", waitForTrainingOptions)
+				Expect(err).To(BeNil())
+				Expect(*languageModel.Status).To(Equal(speechtotextv1.LanguageModel_Status_Available))
+				Expect(progress).To(Equal([]int64{40, 80, 100}))
+			})
+		})
+	})
+	Describe("WaitForAcousticModelTraining(customizationID string, waitForTrainingOptions *WaitForTrainingOptions)", func() {
+		Context("Unsuccessfully - Training fails", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/acoustic_customizations/model1"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"customization_id": "model1", "status": "failed", "warnings": "not enough audio"}`)
+			}))
+			It("Fail to call WaitForAcousticModelTraining", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				acousticModel, err := testService.WaitForAcousticModelTraining("model1", nil)
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("not enough audio"))
+				Expect(*acousticModel.Status).To(Equal(speechtotextv1.AcousticModel_Status_Failed))
+			})
+		})
+	})
+})
@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// DEFAULT_PROFANITY_MASK is the character with which a ProfanityFilter masks each character of a word, like the
+// service-side profanity filter.
+const DEFAULT_PROFANITY_MASK = '*'
+
+// ProfanityFilter : Masks the words of a user-supplied list in recognition results
+// The service-side `profanity_filter` parameter applies only to US English. A ProfanityFilter works for any language:
+// words are matched case-insensitively as whole tokens of letters, digits, and apostrophes, and each of their
+// characters is replaced with Mask. Install it with UseProfanityFilter to apply it to the results of Recognize,
+// RecognizeStream, CheckJob, and WriteJobResultsAsJSONLines.
+type ProfanityFilter struct {
+
+	// The character that replaces each character of a masked word. Defaults to DEFAULT_PROFANITY_MASK.
+	Mask rune
+
+	words map[string]bool
+}
+
+// NewProfanityFilter : Instantiate ProfanityFilter with the words to mask
+func NewProfanityFilter(words []string) *ProfanityFilter {
+	filter := &ProfanityFilter{
+		Mask:  DEFAULT_PROFANITY_MASK,
+		words: map[string]bool{},
+	}
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			filter.words[strings.ToLower(word)] = true
+		}
+	}
+	return filter
+}
+
+// ReadProfanityFilter : Instantiate ProfanityFilter with the words of a list, one per line. Blank lines and lines
+// that start with `#` are ignored.
+func ReadProfanityFilter(reader io.Reader) (*ProfanityFilter, error) {
+	var words []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			words = append(words, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewProfanityFilter(words), nil
+}
+
+// SetMask : Allow user to set Mask
+func (filter *ProfanityFilter) SetMask(mask rune) *ProfanityFilter {
+	filter.Mask = mask
+	return filter
+}
+
+// MaskText : Returns the text with the words of the list masked
+func (filter *ProfanityFilter) MaskText(text string) string {
+	mask := filter.Mask
+	if mask == 0 {
+		mask = DEFAULT_PROFANITY_MASK
+	}
+
+	var masked strings.Builder
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		if !isProfanityWordRune(runes[start]) {
+			masked.WriteRune(runes[start])
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && isProfanityWordRune(runes[end]) {
+			end++
+		}
+		word := string(runes[start:end])
+		if filter.words[strings.ToLower(word)] {
+			word = strings.Repeat(string(mask), end-start)
+		}
+		masked.WriteString(word)
+		start = end
+	}
+	return masked.String()
+}
+
+// FilterResult : Returns a copy of the result with the words of the list masked in its transcripts, word timestamps,
+// word confidences, and word alternatives
+func (filter *ProfanityFilter) FilterResult(result SpeechRecognitionResult) SpeechRecognitionResult {
+	filtered := result
+	filtered.Alternatives = make([]SpeechRecognitionAlternative, len(result.Alternatives))
+	for i, alternative := range result.Alternatives {
+		if alternative.Transcript != nil {
+			transcript := filter.MaskText(*alternative.Transcript)
+			alternative.Transcript = &transcript
+		}
+		timestamps := make([]WordTimestamp, len(alternative.Timestamps))
+		for j, timestamp := range alternative.Timestamps {
+			timestamp.Word = filter.MaskText(timestamp.Word)
+			timestamps[j] = timestamp
+		}
+		alternative.Timestamps = timestamps
+		wordConfidences := make([]WordConfidence, len(alternative.WordConfidence))
+		for j, wordConfidence := range alternative.WordConfidence {
+			wordConfidence.Word = filter.MaskText(wordConfidence.Word)
+			wordConfidences[j] = wordConfidence
+		}
+		alternative.WordConfidence = wordConfidences
+		filtered.Alternatives[i] = alternative
+	}
+
+	filtered.WordAlternatives = nil
+	for _, wordAlternatives := range result.WordAlternatives {
+		alternatives := make([]WordAlternativeResult, len(wordAlternatives.Alternatives))
+		for j, alternative := range wordAlternatives.Alternatives {
+			if alternative.Word != nil {
+				word := filter.MaskText(*alternative.Word)
+				alternative.Word = &word
+			}
+			alternatives[j] = alternative
+		}
+		wordAlternatives.Alternatives = alternatives
+		filtered.WordAlternatives = append(filtered.WordAlternatives, wordAlternatives)
+	}
+	return filtered
+}
+
+// FilterResults : Masks the words of the list in every result of the results
+func (filter *ProfanityFilter) FilterResults(results *SpeechRecognitionResults) {
+	if results == nil {
+		return
+	}
+	for i, result := range results.Results {
+		results.Results[i] = filter.FilterResult(result)
+	}
+}
+
+// FilterJob : Masks the words of the list in every result of the job
+func (filter *ProfanityFilter) FilterJob(job *RecognitionJob) {
+	if job == nil {
+		return
+	}
+	for i := range job.Results {
+		filter.FilterResults(&job.Results[i])
+	}
+}
+
+// Handler : Returns a RecognitionResultHandler that masks the words of the list in each result before passing it to
+// the handler
+func (filter *ProfanityFilter) Handler(handler RecognitionResultHandler) RecognitionResultHandler {
+	return func(result SpeechRecognitionResult) error {
+		return handler(filter.FilterResult(result))
+	}
+}
+
+// UseProfanityFilter : Mask the words of the filter in the results of Recognize, RecognizeStream, CheckJob, and
+// WriteJobResultsAsJSONLines. A nil filter removes the filter.
+func (speechToText *SpeechToTextV1) UseProfanityFilter(filter *ProfanityFilter) {
+	speechToText.profanityFilter = filter
+}
+
+func isProfanityWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || r == '\''
+}
@@ -0,0 +1,105 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProfanityFilter", func() {
+	Describe("MaskText(text string)", func() {
+		It("Succeed to call MaskText", func() {
+			filter, err := speechtotextv1.ReadProfanityFilter(strings.NewReader("# French\nmerde\n\nputain\n"))
+			Expect(err).To(BeNil())
+
+			Expect(filter.MaskText("Merde, c'est le putain de train. Merdeux.")).To(Equal("*****, c'est le ****** de train. Merdeux."))
+			Expect(filter.SetMask('#').MaskText("MERDE")).To(Equal("#####"))
+		})
+	})
+	Describe("UseProfanityFilter(filter *ProfanityFilter)", func() {
+		Context("Successfully - Mask the words in the results of Recognize", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"results": [{"final": true, "alternatives": [{
+					"transcript": "so verdammt kalt ",
+					"timestamps": [["so", 0.1, 0.3], ["verdammt", 0.3, 0.9], ["kalt", 0.9, 1.2]],
+					"word_confidence": [["so", 0.9], ["verdammt", 0.8], ["kalt", 0.95]]}]}]}`)
+			}))
+			It("Succeed to call Recognize", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				testService.UseProfanityFilter(speechtotextv1.NewProfanityFilter([]string{"Verdammt"}))
+
+				recognizeOptions := testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader("audio")))
+				recognizeOptions.SetContentType("audio/wav")
+				result, _, err := testService.Recognize(recognizeOptions)
+				Expect(err).To(BeNil())
+
+				alternative := result.Results[0].Alternatives[0]
+				Expect(*alternative.Transcript).To(Equal("so ******** kalt "))
+				Expect(alternative.Timestamps[1].Word).To(Equal("********"))
+				Expect(alternative.Timestamps[1].End).To(Equal(0.9))
+				Expect(alternative.WordConfidence[1].Word).To(Equal("********"))
+			})
+		})
+		Context("Successfully - Mask the words in the results of Recognize with many keywords", func() {
+			keywords := make([]string, 1000)
+			for i := range keywords {
+				keywords[i] = fmt.Sprintf("keyword%d", i)
+			}
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				Expect(req.Header.Get("Content-Type")).To(HavePrefix("multipart/form-data"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"results": [{"final": true, "alternatives": [{
+					"transcript": "so verdammt kalt ",
+					"timestamps": [["so", 0.1, 0.3], ["verdammt", 0.3, 0.9], ["kalt", 0.9, 1.2]]}]}]}`)
+			}))
+			It("Succeed to call Recognize", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				testService.UseProfanityFilter(speechtotextv1.NewProfanityFilter([]string{"Verdammt"}))
+
+				recognizeOptions := testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader("audio"))).
+					SetContentType("audio/wav").
+					SetKeywords(keywords).
+					SetKeywordsThreshold(0.5)
+				result, _, err := testService.Recognize(recognizeOptions)
+				Expect(err).To(BeNil())
+
+				alternative := result.Results[0].Alternatives[0]
+				Expect(*alternative.Transcript).To(Equal("so ******** kalt "))
+				Expect(alternative.Timestamps[1].Word).To(Equal("********"))
+			})
+		})
+	})
+})
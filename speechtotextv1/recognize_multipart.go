@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// MAX_RECOGNIZE_QUERY_LENGTH is the length of the encoded keywords above which Recognize sends a multipart request,
+// leaving room for the other parameters and headers within the 8 KB limit of most HTTP servers and proxies.
+const MAX_RECOGNIZE_QUERY_LENGTH = 6 * 1024
+
+// RecognizeMultipart : Recognize audio with a multipart request
+// Sends the recognition parameters as a JSON metadata part and the audio as a data part of multipart form data,
+// instead of as query parameters. The model and customization parameters are still sent as query parameters, as the
+// service requires. Use multipart requests when the parameters are larger than the 8 KB limit of most HTTP servers
+// and proxies, for example to spot a very large number of keywords. Recognize switches to a multipart request
+// automatically when the keywords are too long for the query string.
+//
+// **See also:** [Making a multipart HTTP
+// request](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-http#HTTP-multi).
+func (speechToText *SpeechToTextV1) RecognizeMultipart(recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	return speechToText.RecognizeMultipartWithContext(context.Background(), recognizeOptions)
+}
+
+// RecognizeMultipartWithContext is an alternate form of the RecognizeMultipart method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RecognizeMultipartWithContext(ctx context.Context, recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(recognizeOptions, "recognizeOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(recognizeOptions, "recognizeOptions")
+	if err != nil {
+		return
+	}
+	err = validateCustomizationWeightPtr(recognizeOptions.CustomizationWeight)
+	if err != nil {
+		return
+	}
+
+	pathSegments := []string{"v1/recognize"}
+	pathParameters := []string{}
+
+	builder := core.NewRequestBuilder(core.POST)
+	_, err = builder.ConstructHTTPURL(speechToText.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return
+	}
+
+	for headerName, headerValue := range recognizeOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("speech_to_text", "V1", "RecognizeMultipart")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+
+	if recognizeOptions.Model != nil {
+		builder.AddQuery("model", fmt.Sprint(*recognizeOptions.Model))
+	}
+	if recognizeOptions.LanguageCustomizationID != nil {
+		builder.AddQuery("language_customization_id", fmt.Sprint(*recognizeOptions.LanguageCustomizationID))
+	}
+	if recognizeOptions.AcousticCustomizationID != nil {
+		builder.AddQuery("acoustic_customization_id", fmt.Sprint(*recognizeOptions.AcousticCustomizationID))
+	}
+	if recognizeOptions.BaseModelVersion != nil {
+		builder.AddQuery("base_model_version", fmt.Sprint(*recognizeOptions.BaseModelVersion))
+	}
+	if recognizeOptions.CustomizationWeight != nil {
+		builder.AddQuery("customization_weight", fmt.Sprint(*recognizeOptions.CustomizationWeight))
+	}
+	if recognizeOptions.CustomizationID != nil {
+		builder.AddQuery("customization_id", fmt.Sprint(*recognizeOptions.CustomizationID))
+	}
+
+	builder.AddFormData("metadata", "", "application/json", newRecognizeMultipartMetadata(recognizeOptions))
+	builder.AddFormData("upload", "audio",
+		core.StringNilMapper(recognizeOptions.ContentType), recognizeOptions.Audio)
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+	request = request.WithContext(ctx)
+
+	response, err = speechToText.Service.Request(request, new(SpeechRecognitionResults))
+	err = common.NewServiceError(response, err)
+	if err == nil {
+		var ok bool
+		result, ok = response.Result.(*SpeechRecognitionResults)
+		if !ok {
+			err = fmt.Errorf("An error occurred while processing the operation response.")
+		} else if speechToText.profanityFilter != nil {
+			speechToText.profanityFilter.FilterResults(result)
+		}
+	}
+
+	return
+}
+
+// recognizeMultipartMetadata : The JSON metadata part of a multipart recognition request
+type recognizeMultipartMetadata struct {
+	PartContentType           *string  `json:"part_content_type,omitempty"`
+	DataPartsCount            int64    `json:"data_parts_count"`
+	InactivityTimeout         *int64   `json:"inactivity_timeout,omitempty"`
+	Keywords                  []string `json:"keywords,omitempty"`
+	KeywordsThreshold         *float32 `json:"keywords_threshold,omitempty"`
+	MaxAlternatives           *int64   `json:"max_alternatives,omitempty"`
+	WordAlternativesThreshold *float32 `json:"word_alternatives_threshold,omitempty"`
+	WordConfidence            *bool    `json:"word_confidence,omitempty"`
+	Timestamps                *bool    `json:"timestamps,omitempty"`
+	ProfanityFilter           *bool    `json:"profanity_filter,omitempty"`
+	SmartFormatting           *bool    `json:"smart_formatting,omitempty"`
+	SpeakerLabels             *bool    `json:"speaker_labels,omitempty"`
+	GrammarName               *string  `json:"grammar_name,omitempty"`
+	Redaction                 *bool    `json:"redaction,omitempty"`
+	AudioMetrics              *bool    `json:"audio_metrics,omitempty"`
+}
+
+func newRecognizeMultipartMetadata(recognizeOptions *RecognizeOptions) *recognizeMultipartMetadata {
+	return &recognizeMultipartMetadata{
+		PartContentType:           recognizeOptions.ContentType,
+		DataPartsCount:            1,
+		InactivityTimeout:         recognizeOptions.InactivityTimeout,
+		Keywords:                  recognizeOptions.Keywords,
+		KeywordsThreshold:         recognizeOptions.KeywordsThreshold,
+		MaxAlternatives:           recognizeOptions.MaxAlternatives,
+		WordAlternativesThreshold: recognizeOptions.WordAlternativesThreshold,
+		WordConfidence:            recognizeOptions.WordConfidence,
+		Timestamps:                recognizeOptions.Timestamps,
+		ProfanityFilter:           recognizeOptions.ProfanityFilter,
+		SmartFormatting:           recognizeOptions.SmartFormatting,
+		SpeakerLabels:             recognizeOptions.SpeakerLabels,
+		GrammarName:               recognizeOptions.GrammarName,
+		Redaction:                 recognizeOptions.Redaction,
+		AudioMetrics:              recognizeOptions.AudioMetrics,
+	}
+}
+
+// requiresMultipartRecognize : Reports whether the keywords are too long to be sent as a query parameter
+func requiresMultipartRecognize(recognizeOptions *RecognizeOptions) bool {
+	if recognizeOptions == nil || len(recognizeOptions.Keywords) == 0 {
+		return false
+	}
+	return len(url.QueryEscape(strings.Join(recognizeOptions.Keywords, ","))) > MAX_RECOGNIZE_QUERY_LENGTH
+}
@@ -0,0 +1,70 @@
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RecognizeMultipart", func() {
+	Describe("Recognize(recognizeOptions *RecognizeOptions) with many keywords", func() {
+		keywords := make([]string, 1000)
+		for i := range keywords {
+			keywords[i] = fmt.Sprintf("keyword%d", i)
+		}
+		Context("Successfully - Switch to a multipart request", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				Expect(req.URL.Query().Get("model")).To(Equal("en-US_BroadbandModel"))
+				Expect(req.URL.Query().Get("keywords")).To(BeEmpty())
+				Expect(req.ParseMultipartForm(1 << 20)).To(Succeed())
+
+				var metadata map[string]interface{}
+				Expect(json.Unmarshal([]byte(req.MultipartForm.Value["metadata"][0]), &metadata)).To(Succeed())
+				Expect(metadata["part_content_type"]).To(Equal("audio/wav"))
+				Expect(metadata["data_parts_count"]).To(Equal(float64(1)))
+				Expect(metadata["keywords"]).To(HaveLen(1000))
+
+				file, _ := req.MultipartForm.File["upload"][0].Open()
+				audio, _ := ioutil.ReadAll(file)
+				Expect(string(audio)).To(Equal("RIFF"))
+
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"results": [], "result_index": 0}`)
+			}))
+			It("Succeed to call Recognize", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				recognizeOptions := testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader("RIFF"))).
+					SetContentType("audio/wav").
+					SetModel("en-US_BroadbandModel").
+					SetKeywords(keywords).
+					SetKeywordsThreshold(0.5)
+				result, response, err := testService.Recognize(recognizeOptions)
+				Expect(err).To(BeNil())
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(result).NotTo(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// NewRecognizeStreamOptions : Instantiate RecognizeOptions for a live source of audio, such as a microphone pipe.
+// The content type is required because the format of live audio cannot be detected in advance.
+func (speechToText *SpeechToTextV1) NewRecognizeStreamOptions(audio io.Reader, contentType string) *RecognizeOptions {
+	audioReadCloser, ok := audio.(io.ReadCloser)
+	if !ok {
+		audioReadCloser = ioutil.NopCloser(audio)
+	}
+	return speechToText.NewRecognizeOptions(audioReadCloser).SetContentType(contentType)
+}
+
+// RecognizeStream : Recognize audio in streaming mode
+// Sends the audio with chunked transfer encoding, so each chunk is passed to the service as soon as it is read
+// from Audio instead of after the whole body has been buffered. The request ends when Audio returns io.EOF.
+//
+// While the audio is streaming, the service closes the connection (status code 400) if it detects no speech for
+// `inactivity_timeout` seconds; set InactivityTimeout to change the default of 30 seconds, or to -1 for no timeout.
+// Cancel the context of RecognizeStreamWithContext to stop a stream that never reaches the end of its audio.
+//
+// The service also closes the connection if it receives no data for 30 seconds. If Audio can stall, for example while
+// a network source rebuffers, set KeepAliveInterval to send silence in the meantime. The silence counts toward
+// `inactivity_timeout`.
+func (speechToText *SpeechToTextV1) RecognizeStream(recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	return speechToText.RecognizeStreamWithContext(context.Background(), recognizeOptions)
+}
+
+// RecognizeStreamWithContext is an alternate form of the RecognizeStream method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RecognizeStreamWithContext(ctx context.Context, recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(recognizeOptions, "recognizeOptions cannot be nil")
+	if err != nil {
+		return
+	}
+
+	streamOptions := *recognizeOptions
+	streamOptions.Headers = map[string]string{}
+	for headerName, headerValue := range recognizeOptions.Headers {
+		streamOptions.Headers[headerName] = headerValue
+	}
+	streamOptions.Headers["Transfer-Encoding"] = "chunked"
+	if recognizeOptions.Audio != nil {
+		streamOptions.Audio = &streamingAudio{audio: recognizeOptions.Audio}
+		if recognizeOptions.KeepAliveInterval > 0 {
+			streamOptions.Audio, err = newKeepAliveAudio(recognizeOptions.Audio, stringOrEmpty(recognizeOptions.ContentType), recognizeOptions.KeepAliveInterval)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return speechToText.RecognizeWithContext(ctx, &streamOptions)
+}
+
+// streamingAudio : Hides the concrete type of the audio so that the HTTP client cannot determine its length in
+// advance and sends it with chunked transfer encoding
+type streamingAudio struct {
+	audio io.ReadCloser
+}
+
+func (stream *streamingAudio) Read(p []byte) (int, error) {
+	return stream.audio.Read(p)
+}
+
+func (stream *streamingAudio) Close() error {
+	return stream.audio.Close()
+}
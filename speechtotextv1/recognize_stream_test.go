@@ -0,0 +1,65 @@
+package speechtotextv1_test
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RecognizeStream", func() {
+	Describe("RecognizeStream(recognizeOptions *RecognizeOptions)", func() {
+		Context("Successfully - Stream audio from a live source", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.TransferEncoding).To(Equal([]string{"chunked"}))
+				Expect(req.Header.Get("Content-Type")).To(Equal("audio/l16;rate=16000"))
+				Expect(req.URL.Query().Get("inactivity_timeout")).To(Equal("-1"))
+
+				body, err := ioutil.ReadAll(req.Body)
+				Expect(err).To(BeNil())
+				Expect(string(body)).To(Equal("firstsecond"))
+
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"results": [{"final": true, "alternatives": [{"transcript": "hello"}]}]}`)
+			}))
+			It("Succeed to call RecognizeStream", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				audioReader, audioWriter := io.Pipe()
+				go func() {
+					audioWriter.Write([]byte("first"))
+					audioWriter.Write([]byte("second"))
+					audioWriter.Close()
+				}()
+
+				recognizeOptions := testService.NewRecognizeStreamOptions(audioReader, "audio/l16;rate=16000").
+					SetInactivityTimeout(-1)
+				result, response, err := testService.RecognizeStream(recognizeOptions)
+				Expect(err).To(BeNil())
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(*result.Results[0].Alternatives[0].Transcript).To(Equal("hello"))
+				Expect(recognizeOptions.Headers).To(BeNil())
+			})
+		})
+	})
+})
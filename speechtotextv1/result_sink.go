@@ -0,0 +1,96 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// ResultSink : Persists the results of completed asynchronous recognition jobs. Implementations can write to a local
+// file system, an object store such as Cloud Object Storage, or a database.
+type ResultSink interface {
+	WriteResults(ctx context.Context, job *RecognitionJob) error
+}
+
+// ResultSinkFunc : Adapts an ordinary function to the ResultSink interface
+type ResultSinkFunc func(ctx context.Context, job *RecognitionJob) error
+
+// WriteResults : Calls sinkFunc(ctx, job)
+func (sinkFunc ResultSinkFunc) WriteResults(ctx context.Context, job *RecognitionJob) error {
+	return sinkFunc(ctx, job)
+}
+
+// FileResultSink : A ResultSink that writes the results of each job as JSON to `<Directory>/<job ID>.json`
+type FileResultSink struct {
+
+	// The directory in which result files are created. The directory is created if it does not exist.
+	Directory string
+
+	// The permissions of the created result files. Defaults to 0644.
+	Perm os.FileMode
+}
+
+// NewFileResultSink : Instantiate FileResultSink
+func NewFileResultSink(directory string) *FileResultSink {
+	return &FileResultSink{
+		Directory: directory,
+		Perm:      0644,
+	}
+}
+
+// WriteResults : Writes the results of the job to a file named after the job ID
+func (sink *FileResultSink) WriteResults(ctx context.Context, job *RecognitionJob) error {
+	if job == nil || job.ID == nil {
+		return fmt.Errorf("job must have an ID")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(sink.Directory, 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(job.Results, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	perm := sink.Perm
+	if perm == 0 {
+		perm = 0644
+	}
+	return ioutil.WriteFile(filepath.Join(sink.Directory, *job.ID+".json"), data, perm)
+}
+
+// MultiResultSink : Returns a ResultSink that writes the results of a job to every sink in order, stopping at the
+// first error
+func MultiResultSink(sinks ...ResultSink) ResultSink {
+	return ResultSinkFunc(func(ctx context.Context, job *RecognitionJob) error {
+		for _, sink := range sinks {
+			if err := sink.WriteResults(ctx, job); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,52 @@
+package speechtotextv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResultSink", func() {
+	job := &speechtotextv1.RecognitionJob{
+		ID:     core.StringPtr("job1"),
+		Status: core.StringPtr(speechtotextv1.RecognitionJob_Status_Completed),
+		Results: []speechtotextv1.SpeechRecognitionResults{
+			{ResultIndex: core.Int64Ptr(0)},
+		},
+	}
+	Describe("FileResultSink", func() {
+		It("Writes the job results to a JSON file", func() {
+			directory, err := ioutil.TempDir("", "results")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(directory)
+
+			sink := speechtotextv1.NewFileResultSink(filepath.Join(directory, "nested"))
+			Expect(sink.WriteResults(context.Background(), job)).To(Succeed())
+
+			data, err := ioutil.ReadFile(filepath.Join(directory, "nested", "job1.json"))
+			Expect(err).To(BeNil())
+			var results []speechtotextv1.SpeechRecognitionResults
+			Expect(json.Unmarshal(data, &results)).To(Succeed())
+			Expect(results).To(HaveLen(1))
+		})
+	})
+	Describe("MultiResultSink", func() {
+		It("Writes to every sink", func() {
+			calls := 0
+			counter := speechtotextv1.ResultSinkFunc(func(ctx context.Context, job *speechtotextv1.RecognitionJob) error {
+				calls++
+				return nil
+			})
+			Expect(speechtotextv1.MultiResultSink(counter, counter).WriteResults(context.Background(), job)).To(Succeed())
+			Expect(calls).To(Equal(2))
+		})
+	})
+})
@@ -0,0 +1,252 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// RecognitionResultHandler : Called with each result segment that is decoded by DecodeRecognitionResults or
+// DecodeRecognitionJobResults. Returning an error stops the decoding.
+type RecognitionResultHandler func(result SpeechRecognitionResult) error
+
+// JSONLinesResultHandler : Returns a RecognitionResultHandler that writes each result segment to the writer as one
+// line of JSON
+func JSONLinesResultHandler(writer io.Writer) RecognitionResultHandler {
+	encoder := json.NewEncoder(writer)
+	return func(result SpeechRecognitionResult) error {
+		return encoder.Encode(result)
+	}
+}
+
+// DecodeRecognitionResults : Decodes a SpeechRecognitionResults document incrementally, calling the handler with
+// each element of its `results` array, and returns the number of elements. Only one element is held in memory at a
+// time; the other fields of the document, such as `speaker_labels`, are skipped.
+func DecodeRecognitionResults(reader io.Reader, handler RecognitionResultHandler) (int, error) {
+	decoder := json.NewDecoder(reader)
+	count := 0
+	err := decodeResultsObject(decoder, func(decoder *json.Decoder) error {
+		return decodeArray(decoder, func(decoder *json.Decoder) error {
+			var result SpeechRecognitionResult
+			if err := decoder.Decode(&result); err != nil {
+				return err
+			}
+			count++
+			return handler(result)
+		})
+	})
+	return count, err
+}
+
+// DecodeRecognitionJobResults : Decodes a RecognitionJob document, as returned by CheckJob, incrementally, calling the
+// handler with each result segment of its results, and returns the number of segments
+func DecodeRecognitionJobResults(reader io.Reader, handler RecognitionResultHandler) (int, error) {
+	decoder := json.NewDecoder(reader)
+	count := 0
+	err := decodeResultsObject(decoder, func(decoder *json.Decoder) error {
+		return decodeArray(decoder, func(decoder *json.Decoder) error {
+			return decodeResultsObject(decoder, func(decoder *json.Decoder) error {
+				return decodeArray(decoder, func(decoder *json.Decoder) error {
+					var result SpeechRecognitionResult
+					if err := decoder.Decode(&result); err != nil {
+						return err
+					}
+					count++
+					return handler(result)
+				})
+			})
+		})
+	})
+	return count, err
+}
+
+// WriteJobResultsAsJSONLines : Writes the results of a completed recognition job as JSON Lines
+// Streams the response of the **Check a job** method and writes each result segment to the writer as one line of
+// JSON, instead of decoding the entire response into memory. Returns the number of segments written.
+func (speechToText *SpeechToTextV1) WriteJobResultsAsJSONLines(checkJobOptions *CheckJobOptions, writer io.Writer) (int, error) {
+	return speechToText.WriteJobResultsAsJSONLinesWithContext(context.Background(), checkJobOptions, writer)
+}
+
+// WriteJobResultsAsJSONLinesWithContext is an alternate form of the WriteJobResultsAsJSONLines method which supports a Context parameter
+func (speechToText *SpeechToTextV1) WriteJobResultsAsJSONLinesWithContext(ctx context.Context, checkJobOptions *CheckJobOptions, writer io.Writer) (int, error) {
+	err := core.ValidateNotNil(checkJobOptions, "checkJobOptions cannot be nil")
+	if err != nil {
+		return 0, err
+	}
+	err = core.ValidateStruct(checkJobOptions, "checkJobOptions")
+	if err != nil {
+		return 0, err
+	}
+
+	pathSegments := []string{"v1/recognitions"}
+	pathParameters := []string{*checkJobOptions.ID}
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ConstructHTTPURL(speechToText.Service.Options.URL, pathSegments, pathParameters)
+	if err != nil {
+		return 0, err
+	}
+
+	for headerName, headerValue := range checkJobOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("speech_to_text", "V1", "CheckJob")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	builder.AddHeader("Accept", "application/json")
+
+	request, err := builder.Build()
+	if err != nil {
+		return 0, err
+	}
+	request = request.WithContext(ctx)
+
+	// The job is returned as JSON, which the core would decode into memory, so the body is read directly
+	body, err := speechToText.streamResponse(request)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	handler := JSONLinesResultHandler(writer)
+	if speechToText.profanityFilter != nil {
+		handler = speechToText.profanityFilter.Handler(handler)
+	}
+	return DecodeRecognitionJobResults(body, handler)
+}
+
+// streamResponse : Sends the request with the default headers and authentication of the service, and returns the
+// body of a successful response without decoding it. An error status is returned as a ServiceError.
+func (speechToText *SpeechToTextV1) streamResponse(request *http.Request) (io.ReadCloser, error) {
+	for headerName, headerValues := range speechToText.Service.DefaultHeaders {
+		for _, headerValue := range headerValues {
+			request.Header.Add(headerName, headerValue)
+		}
+	}
+	if speechToText.Service.Options.Authenticator == nil {
+		return nil, fmt.Errorf("Authentication information was not properly configured.")
+	}
+	err := speechToText.Service.Options.Authenticator.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	client := speechToText.Service.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	httpResponse, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if httpResponse.StatusCode < 200 || httpResponse.StatusCode >= 300 {
+		defer httpResponse.Body.Close()
+		errorBody, _ := ioutil.ReadAll(httpResponse.Body)
+		response := &core.DetailedResponse{
+			StatusCode: httpResponse.StatusCode,
+			Headers:    httpResponse.Header,
+			Result:     errorBody,
+		}
+		return nil, common.NewServiceError(response, fmt.Errorf("%s", http.StatusText(httpResponse.StatusCode)))
+	}
+	return httpResponse.Body, nil
+}
+
+// decodeResultsObject : Reads a JSON object, calling decodeResults for the value of its `results` field and skipping
+// the other fields
+func decodeResultsObject(decoder *json.Decoder, decodeResults func(*json.Decoder) error) error {
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if token == "results" {
+			err = decodeResults(decoder)
+		} else {
+			err = skipValue(decoder)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, '}')
+}
+
+// decodeArray : Reads a JSON array, calling decodeElement for each element. A null value is an empty array.
+func decodeArray(decoder *json.Decoder, decodeElement func(*json.Decoder) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if token != json.Delim('[') {
+		return fmt.Errorf("expected a JSON array, found %v", token)
+	}
+	for decoder.More() {
+		if err := decodeElement(decoder); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, ']')
+}
+
+// skipValue : Reads and discards the next JSON value without holding it in memory
+func skipValue(decoder *json.Decoder) error {
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected '%v' in JSON, found %v", delim, token)
+	}
+	return nil
+}
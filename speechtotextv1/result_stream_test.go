@@ -0,0 +1,120 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResultStream", func() {
+	job := `{"id": "job1", "status": "completed", "results": [{
+		"result_index": 0,
+		"speaker_labels": [{"from": 0.1, "to": 0.5, "speaker": 1, "confidence": 0.5, "final": true}],
+		"results": [
+			{"final": true, "alternatives": [{"transcript": "hello "}]},
+			{"final": true, "alternatives": [{"transcript": "world "}]}]}],
+		"created": "2019-01-01T12:00:00.000Z"}`
+
+	Describe("DecodeRecognitionJobResults(reader io.Reader, handler RecognitionResultHandler)", func() {
+		It("Writes each result segment as a JSON line", func() {
+			var lines bytes.Buffer
+			count, err := speechtotextv1.DecodeRecognitionJobResults(strings.NewReader(job), speechtotextv1.JSONLinesResultHandler(&lines))
+			Expect(err).To(BeNil())
+			Expect(count).To(Equal(2))
+			Expect(lines.String()).To(Equal(`{"final":true,"alternatives":[{"transcript":"hello "}]}` + "\n" +
+				`{"final":true,"alternatives":[{"transcript":"world "}]}` + "\n"))
+		})
+		It("Stops at the first error of the handler", func() {
+			stop := errors.New("stop")
+			count, err := speechtotextv1.DecodeRecognitionJobResults(strings.NewReader(job), func(speechtotextv1.SpeechRecognitionResult) error {
+				return stop
+			})
+			Expect(err).To(Equal(stop))
+			Expect(count).To(Equal(1))
+		})
+	})
+	Describe("WriteJobResultsAsJSONLines(checkJobOptions *CheckJobOptions, writer io.Writer)", func() {
+		Context("Successfully - Stream the results of a job", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognitions/job1"))
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.Header.Get("Authorization")).To(Equal("Bearer token1"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"id": "job1", "status": "completed", "results": [
+					{"result_index": 0, "results": [
+						{"final": true, "alternatives": [{"transcript": "hello "}]},
+						{"final": true, "alternatives": [{"transcript": "world "}]}]},
+					{"result_index": 2, "results": [
+						{"final": true, "alternatives": [{"transcript": "goodbye "}]}]}]}`)
+			}))
+			It("Succeed to call WriteJobResultsAsJSONLines", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: "token1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				var lines bytes.Buffer
+				count, err := testService.WriteJobResultsAsJSONLines(testService.NewCheckJobOptions("job1"), &lines)
+				Expect(err).To(BeNil())
+				Expect(count).To(Equal(3))
+				Expect(lines.String()).To(Equal(`{"final":true,"alternatives":[{"transcript":"hello "}]}` + "\n" +
+					`{"final":true,"alternatives":[{"transcript":"world "}]}` + "\n" +
+					`{"final":true,"alternatives":[{"transcript":"goodbye "}]}` + "\n"))
+			})
+		})
+		Context("Unsuccessfully - Stream the results of a job", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(res, `{"code": 404, "error": "Job job1 not found"}`)
+			}))
+			It("Fail to call WriteJobResultsAsJSONLines", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: "token1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				var lines bytes.Buffer
+				count, err := testService.WriteJobResultsAsJSONLines(testService.NewCheckJobOptions("job1"), &lines)
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(Equal("404: Job job1 not found"))
+				Expect(count).To(Equal(0))
+				Expect(lines.Len()).To(Equal(0))
+			})
+		})
+	})
+	Describe("DecodeRecognitionResults(reader io.Reader, handler RecognitionResultHandler)", func() {
+		It("Decodes the results of a recognition request", func() {
+			var transcripts []string
+			count, err := speechtotextv1.DecodeRecognitionResults(strings.NewReader(`{"result_index": 0, "results": [{"final": true, "alternatives": [{"transcript": "hello "}]}]}`),
+				func(result speechtotextv1.SpeechRecognitionResult) error {
+					transcripts = append(transcripts, *result.Alternatives[0].Transcript)
+					return nil
+				})
+			Expect(err).To(BeNil())
+			Expect(count).To(Equal(1))
+			Expect(transcripts).To(Equal([]string{"hello "}))
+		})
+	})
+})
@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	DEFAULT_RTTM_FILE_ID      = "audio"
+	DEFAULT_RTTM_CHANNEL      = 1
+	DEFAULT_RTTM_MAX_TURN_GAP = 0.5
+)
+
+// RTTMOptions : Options that control how speaker labels are written as RTTM
+type RTTMOptions struct {
+
+	// The identifier of the recording in the RTTM file, usually the name of the audio file without its extension.
+	// Spaces are replaced with underscores. Defaults to `audio`.
+	FileID string
+
+	// The channel of the recording, from 1. Defaults to 1.
+	Channel int
+
+	// The longest silence in seconds between two labels of the same speaker that are joined into one turn. Defaults
+	// to 0.5.
+	MaxTurnGap float64
+
+	// The names of the speakers in the RTTM file, by speaker number. Speakers that are not listed are named
+	// `speaker_<number>`.
+	SpeakerNames map[int64]string
+}
+
+// NewRTTMOptions : Instantiate RTTMOptions with the default values
+func NewRTTMOptions(fileID string) *RTTMOptions {
+	return &RTTMOptions{
+		FileID:     fileID,
+		Channel:    DEFAULT_RTTM_CHANNEL,
+		MaxTurnGap: DEFAULT_RTTM_MAX_TURN_GAP,
+	}
+}
+
+// SetChannel : Allow user to set Channel
+func (options *RTTMOptions) SetChannel(channel int) *RTTMOptions {
+	options.Channel = channel
+	return options
+}
+
+// SetMaxTurnGap : Allow user to set MaxTurnGap
+func (options *RTTMOptions) SetMaxTurnGap(maxTurnGap float64) *RTTMOptions {
+	options.MaxTurnGap = maxTurnGap
+	return options
+}
+
+// SetSpeakerName : Allow user to set the name of a speaker
+func (options *RTTMOptions) SetSpeakerName(speaker int64, name string) *RTTMOptions {
+	if options.SpeakerNames == nil {
+		options.SpeakerNames = map[int64]string{}
+	}
+	options.SpeakerNames[speaker] = name
+	return options
+}
+
+// SpeakerTurn : A stretch of speech by one speaker
+type SpeakerTurn struct {
+
+	// The number of the speaker, as assigned by the service.
+	Speaker int64
+
+	// The start time of the turn in seconds.
+	Start float64
+
+	// The end time of the turn in seconds.
+	End float64
+
+	// The confidence of the speaker labels of the turn, averaged over its labels.
+	Confidence float64
+}
+
+// SpeakerTurns : Joins the final speaker labels of the results into turns. Consecutive labels of the same speaker are
+// joined if the silence between them is at most maxGap seconds. The results must have been requested with
+// `speaker_labels` set to `true`. Nil results have no turns.
+func SpeakerTurns(results *SpeechRecognitionResults, maxGap float64) []SpeakerTurn {
+	if results == nil {
+		return nil
+	}
+	var labels []SpeakerLabelsResult
+	for _, label := range results.SpeakerLabels {
+		if label.From == nil || label.To == nil || label.Speaker == nil || label.Final != nil && !*label.Final {
+			continue
+		}
+		labels = append(labels, label)
+	}
+	sort.SliceStable(labels, func(i, j int) bool {
+		return *labels[i].From < *labels[j].From
+	})
+
+	var turns []SpeakerTurn
+	labelCount := 0
+	for _, label := range labels {
+		confidence := 0.0
+		if label.Confidence != nil {
+			confidence = float64(*label.Confidence)
+		}
+		start, end := float64(*label.From), float64(*label.To)
+		if len(turns) > 0 {
+			turn := &turns[len(turns)-1]
+			if turn.Speaker == *label.Speaker && start-turn.End <= maxGap {
+				if end > turn.End {
+					turn.End = end
+				}
+				turn.Confidence = (turn.Confidence*float64(labelCount) + confidence) / float64(labelCount+1)
+				labelCount++
+				continue
+			}
+		}
+		turns = append(turns, SpeakerTurn{Speaker: *label.Speaker, Start: start, End: end, Confidence: confidence})
+		labelCount = 1
+	}
+	return turns
+}
+
+// WriteRTTM : Writes the speaker labels of the results as Rich Transcription Time Marked (RTTM) `SPEAKER` lines, one
+// per speaker turn, for scoring with diarization evaluation tools such as `md-eval` and `dscore`. Nothing is written
+// for nil results.
+func WriteRTTM(writer io.Writer, results *SpeechRecognitionResults, options *RTTMOptions) error {
+	options = rttmOptionsWithDefaults(options)
+	fileID := strings.Join(strings.Fields(options.FileID), "_")
+
+	bufferedWriter := bufio.NewWriter(writer)
+	for _, turn := range SpeakerTurns(results, options.MaxTurnGap) {
+		speaker, ok := options.SpeakerNames[turn.Speaker]
+		if !ok {
+			speaker = fmt.Sprintf("speaker_%d", turn.Speaker)
+		}
+		fmt.Fprintf(bufferedWriter, "SPEAKER %s %d %.3f %.3f <NA> <NA> %s <NA> <NA>\n",
+			fileID, options.Channel, turn.Start, turn.End-turn.Start, speaker)
+	}
+	return bufferedWriter.Flush()
+}
+
+func rttmOptionsWithDefaults(options *RTTMOptions) *RTTMOptions {
+	withDefaults := NewRTTMOptions(DEFAULT_RTTM_FILE_ID)
+	if options == nil {
+		return withDefaults
+	}
+	if strings.TrimSpace(options.FileID) != "" {
+		withDefaults.FileID = options.FileID
+	}
+	if options.Channel > 0 {
+		withDefaults.Channel = options.Channel
+	}
+	if options.MaxTurnGap >= 0 {
+		withDefaults.MaxTurnGap = options.MaxTurnGap
+	}
+	withDefaults.SpeakerNames = options.SpeakerNames
+	return withDefaults
+}
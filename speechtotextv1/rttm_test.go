@@ -0,0 +1,57 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RTTM", func() {
+	var results speechtotextv1.SpeechRecognitionResults
+	BeforeEach(func() {
+		err := json.Unmarshal([]byte(`{"speaker_labels": [
+			{"from": 0.5, "to": 0.9, "speaker": 0, "confidence": 0.6, "final": true},
+			{"from": 0.9, "to": 1.4, "speaker": 0, "confidence": 0.8, "final": true},
+			{"from": 2.0, "to": 2.5, "speaker": 1, "confidence": 0.5, "final": true},
+			{"from": 2.6, "to": 3.0, "speaker": 1, "confidence": 0.7, "final": true},
+			{"from": 4.0, "to": 4.5, "speaker": 1, "confidence": 0.9, "final": true},
+			{"from": 5.0, "to": 5.5, "speaker": 0, "confidence": 0.4, "final": false}]}`), &results)
+		Expect(err).To(BeNil())
+	})
+
+	Describe("SpeakerTurns(results *SpeechRecognitionResults, maxGap float64)", func() {
+		It("Succeed to call SpeakerTurns", func() {
+			turns := speechtotextv1.SpeakerTurns(&results, 0.5)
+			Expect(turns).To(HaveLen(3))
+			Expect(turns[0].Speaker).To(Equal(int64(0)))
+			Expect(turns[0].End).To(BeNumerically("~", 1.4, 0.0001))
+			Expect(turns[0].Confidence).To(BeNumerically("~", 0.7, 0.0001))
+			Expect(turns[1].Start).To(BeNumerically("~", 2.0, 0.0001))
+			Expect(turns[1].End).To(BeNumerically("~", 3.0, 0.0001))
+			Expect(turns[2].Start).To(BeNumerically("~", 4.0, 0.0001))
+		})
+		It("Returns no turns for nil results", func() {
+			Expect(speechtotextv1.SpeakerTurns(nil, 0.5)).To(BeEmpty())
+		})
+	})
+
+	Describe("WriteRTTM(writer io.Writer, results *SpeechRecognitionResults, options *RTTMOptions)", func() {
+		It("Succeed to call WriteRTTM", func() {
+			var rttm bytes.Buffer
+			options := speechtotextv1.NewRTTMOptions("meeting 01").SetSpeakerName(1, "agent")
+			Expect(speechtotextv1.WriteRTTM(&rttm, &results, options)).To(BeNil())
+			Expect(rttm.String()).To(Equal(
+				"SPEAKER meeting_01 1 0.500 0.900 <NA> <NA> speaker_0 <NA> <NA>\n" +
+					"SPEAKER meeting_01 1 2.000 1.000 <NA> <NA> agent <NA> <NA>\n" +
+					"SPEAKER meeting_01 1 4.000 0.500 <NA> <NA> agent <NA> <NA>\n"))
+		})
+		It("Writes nothing for nil results", func() {
+			var rttm bytes.Buffer
+			Expect(speechtotextv1.WriteRTTM(&rttm, nil, nil)).To(BeNil())
+			Expect(rttm.Len()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,289 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+const (
+	DEFAULT_TRIM_SILENCE_THRESHOLD     = 300
+	DEFAULT_TRIM_MIN_SILENCE_DURATION  = time.Second
+	DEFAULT_TRIM_KEEP_SILENCE_DURATION = 200 * time.Millisecond
+
+	// trimFrameDuration is the length of the frames whose energy is measured
+	trimFrameDuration = 20 * time.Millisecond
+)
+
+// TrimSilenceOptions : Options that control how silence is removed from audio
+type TrimSilenceOptions struct {
+
+	// The root mean square amplitude of 16-bit samples below which a frame of audio is silent. Defaults to 300.
+	SilenceThreshold int
+
+	// The shortest silence that is shortened. Defaults to one second.
+	MinSilenceDuration time.Duration
+
+	// The silence that is kept before and after speech, so that the service can detect the ends of words. Zero means
+	// the default of 200 milliseconds.
+	KeepSilenceDuration time.Duration
+}
+
+// NewTrimSilenceOptions : Instantiate TrimSilenceOptions with the default values
+func NewTrimSilenceOptions() *TrimSilenceOptions {
+	return &TrimSilenceOptions{
+		SilenceThreshold:    DEFAULT_TRIM_SILENCE_THRESHOLD,
+		MinSilenceDuration:  DEFAULT_TRIM_MIN_SILENCE_DURATION,
+		KeepSilenceDuration: DEFAULT_TRIM_KEEP_SILENCE_DURATION,
+	}
+}
+
+// SetSilenceThreshold : Allow user to set SilenceThreshold
+func (options *TrimSilenceOptions) SetSilenceThreshold(silenceThreshold int) *TrimSilenceOptions {
+	options.SilenceThreshold = silenceThreshold
+	return options
+}
+
+// SetMinSilenceDuration : Allow user to set MinSilenceDuration
+func (options *TrimSilenceOptions) SetMinSilenceDuration(minSilenceDuration time.Duration) *TrimSilenceOptions {
+	options.MinSilenceDuration = minSilenceDuration
+	return options
+}
+
+// SetKeepSilenceDuration : Allow user to set KeepSilenceDuration
+func (options *TrimSilenceOptions) SetKeepSilenceDuration(keepSilenceDuration time.Duration) *TrimSilenceOptions {
+	options.KeepSilenceDuration = keepSilenceDuration
+	return options
+}
+
+// RemovedSilence : A stretch of silence that was removed from audio
+type RemovedSilence struct {
+
+	// The start of the silence in seconds from the beginning of the original audio.
+	Offset float64
+
+	// The duration of the silence in seconds.
+	Duration float64
+}
+
+// TrimmedAudio : Audio from which silence was removed
+type TrimmedAudio struct {
+
+	// The trimmed audio.
+	Audio []byte
+
+	// The content type of the trimmed audio.
+	ContentType string
+
+	// The silences that were removed, in order.
+	Removed []RemovedSilence
+}
+
+// RemovedDuration : The total duration in seconds of the silence that was removed
+func (trimmed *TrimmedAudio) RemovedDuration() float64 {
+	total := 0.0
+	for _, removed := range trimmed.Removed {
+		total += removed.Duration
+	}
+	return total
+}
+
+// OriginalTime : Maps a time in the trimmed audio to the time in the original audio
+func (trimmed *TrimmedAudio) OriginalTime(seconds float64) float64 {
+	removedSoFar := 0.0
+	for _, removed := range trimmed.Removed {
+		if seconds < removed.Offset-removedSoFar {
+			break
+		}
+		removedSoFar += removed.Duration
+	}
+	return seconds + removedSoFar
+}
+
+// RestoreTimes : Returns a copy of the results of the trimmed audio with the times of words, keywords, word
+// alternatives, and speaker labels mapped to the original audio
+func (trimmed *TrimmedAudio) RestoreTimes(results *SpeechRecognitionResults) *SpeechRecognitionResults {
+	if results == nil {
+		return nil
+	}
+	restored := *results
+	restored.Results = make([]SpeechRecognitionResult, len(results.Results))
+	for i, result := range results.Results {
+		restored.Results[i] = mapRecognitionResultTimes(result, trimmed.OriginalTime)
+	}
+	restored.SpeakerLabels = make([]SpeakerLabelsResult, len(results.SpeakerLabels))
+	for i, speakerLabel := range results.SpeakerLabels {
+		if speakerLabel.From != nil {
+			speakerLabel.From = core.Float32Ptr(float32(trimmed.OriginalTime(float64(*speakerLabel.From))))
+		}
+		if speakerLabel.To != nil {
+			speakerLabel.To = core.Float32Ptr(float32(trimmed.OriginalTime(float64(*speakerLabel.To))))
+		}
+		restored.SpeakerLabels[i] = speakerLabel
+	}
+	return &restored
+}
+
+// TrimWAVSilence : Removes long silences from 16-bit PCM WAV audio
+func TrimWAVSilence(audio []byte, options *TrimSilenceOptions) (*TrimmedAudio, error) {
+	format, data, err := parseWAV(audio)
+	if err != nil {
+		return nil, err
+	}
+	samples, removed := trimPCMSilence(data, format, trimOptionsWithDefaults(options))
+	return &TrimmedAudio{
+		Audio:       buildWAV(samples, format),
+		ContentType: "audio/wav",
+		Removed:     removed,
+	}, nil
+}
+
+// TrimPCMSilence : Removes long silences from little-endian 16-bit PCM samples
+func TrimPCMSilence(audio []byte, sampleRate int, channels int, options *TrimSilenceOptions) (*TrimmedAudio, error) {
+	if sampleRate <= 0 || channels <= 0 {
+		return nil, fmt.Errorf("invalid PCM format: rate %d, channels %d", sampleRate, channels)
+	}
+	format := pcmFormat{sampleRate: sampleRate, channels: channels}
+	samples, removed := trimPCMSilence(audio, format, trimOptionsWithDefaults(options))
+	return &TrimmedAudio{
+		Audio:       samples,
+		ContentType: fmt.Sprintf("audio/l16;rate=%d;channels=%d;endianness=little-endian", sampleRate, channels),
+		Removed:     removed,
+	}, nil
+}
+
+// TrimSilence : Removes stretches of silence that are longer than MinSilenceDuration from audio of the given content
+// type, keeping KeepSilenceDuration of silence next to speech. Silence is detected from the energy of the audio in
+// 20 millisecond frames. Trimming reduces the duration of the audio that is billed and avoids the inactivity timeout
+// of the service on sparse recordings. Use RestoreTimes to map the times in the results to the original audio. WAV
+// and audio/l16 audio are supported.
+func TrimSilence(audio []byte, contentType string, options *TrimSilenceOptions) (*TrimmedAudio, error) {
+	mediaType, parameters := parseAudioContentType(contentType)
+	switch mediaType {
+	case "audio/wav", "audio/wave", "audio/x-wav":
+		return TrimWAVSilence(audio, options)
+	case "audio/l16":
+		if parameters["endianness"] == "big-endian" {
+			return nil, fmt.Errorf("big-endian audio/l16 audio cannot be trimmed")
+		}
+		sampleRate, _ := strconv.Atoi(parameters["rate"])
+		channels := 1
+		if parameters["channels"] != "" {
+			channels, _ = strconv.Atoi(parameters["channels"])
+		}
+		return TrimPCMSilence(audio, sampleRate, channels, options)
+	}
+	return nil, fmt.Errorf("audio of type '%s' cannot be trimmed; convert it to WAV first", contentType)
+}
+
+// trimPCMSilence : Returns the samples without long silences, and the silences that were removed
+func trimPCMSilence(data []byte, format pcmFormat, options *TrimSilenceOptions) ([]byte, []RemovedSilence) {
+	frameBytes := durationBytes(trimFrameDuration, format)
+	frameCount := (len(data) + frameBytes - 1) / frameBytes
+	minSilenceFrames := int(options.MinSilenceDuration / trimFrameDuration)
+	keepFrames := int(options.KeepSilenceDuration / trimFrameDuration)
+
+	var trimmed []byte
+	var removed []RemovedSilence
+	kept := 0
+	removeFrames := func(start int, end int) {
+		startByte, endByte := start*frameBytes, end*frameBytes
+		if endByte > len(data) {
+			endByte = len(data)
+		}
+		if endByte <= startByte {
+			return
+		}
+		trimmed = append(trimmed, data[kept:startByte]...)
+		kept = endByte
+		removed = append(removed, RemovedSilence{
+			Offset:   float64(startByte) / format.bytesPerSecond(),
+			Duration: float64(endByte-startByte) / format.bytesPerSecond(),
+		})
+	}
+
+	silenceStart := -1
+	for frame := 0; frame <= frameCount; frame++ {
+		silent := false
+		if frame < frameCount {
+			end := (frame + 1) * frameBytes
+			if end > len(data) {
+				end = len(data)
+			}
+			silent = rmsAmplitude(data[frame*frameBytes:end]) < options.SilenceThreshold
+		}
+		if silent {
+			if silenceStart < 0 {
+				silenceStart = frame
+			}
+			continue
+		}
+		if silenceStart >= 0 && frame-silenceStart >= minSilenceFrames {
+			start, end := silenceStart+keepFrames, frame-keepFrames
+			if silenceStart == 0 {
+				start = 0
+			}
+			if frame == frameCount {
+				end = frameCount
+			}
+			removeFrames(start, end)
+		}
+		silenceStart = -1
+	}
+	trimmed = append(trimmed, data[kept:]...)
+	return trimmed, removed
+}
+
+// rmsAmplitude : Returns the root mean square of the little-endian 16-bit samples
+func rmsAmplitude(samples []byte) int {
+	count := len(samples) / 2
+	if count == 0 {
+		return 0
+	}
+	sum := 0.0
+	for i := 0; i < count; i++ {
+		sample := float64(int16(binary.LittleEndian.Uint16(samples[2*i:])))
+		sum += sample * sample
+	}
+	return int(math.Sqrt(sum / float64(count)))
+}
+
+func trimOptionsWithDefaults(options *TrimSilenceOptions) *TrimSilenceOptions {
+	withDefaults := NewTrimSilenceOptions()
+	if options == nil {
+		return withDefaults
+	}
+	if options.SilenceThreshold > 0 {
+		withDefaults.SilenceThreshold = options.SilenceThreshold
+	}
+	if options.MinSilenceDuration > 0 {
+		withDefaults.MinSilenceDuration = options.MinSilenceDuration
+	}
+	if options.KeepSilenceDuration > 0 {
+		withDefaults.KeepSilenceDuration = options.KeepSilenceDuration
+	}
+	if withDefaults.MinSilenceDuration < 2*withDefaults.KeepSilenceDuration {
+		withDefaults.MinSilenceDuration = 2 * withDefaults.KeepSilenceDuration
+	}
+	return withDefaults
+}
@@ -0,0 +1,63 @@
+package speechtotextv1_test
+
+import (
+	"time"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SilenceTrimmer", func() {
+	// One second of speech at 8000 Hz, three seconds of silence, and one second of speech
+	var pcm []byte
+	pcm = append(pcm, pcmTone(8000, 5000)...)
+	pcm = append(pcm, pcmTone(24000, 0)...)
+	pcm = append(pcm, pcmTone(8000, 5000)...)
+
+	Describe("TrimSilence(audio []byte, contentType string, options *TrimSilenceOptions)", func() {
+		It("Shortens long silences", func() {
+			trimmed, err := speechtotextv1.TrimSilence(pcm, "audio/l16;rate=8000", nil)
+			Expect(err).To(BeNil())
+			Expect(trimmed.ContentType).To(Equal("audio/l16;rate=8000;channels=1;endianness=little-endian"))
+			Expect(trimmed.Removed).To(HaveLen(1))
+			Expect(trimmed.Removed[0].Offset).To(BeNumerically("~", 1.2, 0.001))
+			Expect(trimmed.Removed[0].Duration).To(BeNumerically("~", 2.6, 0.001))
+			Expect(len(trimmed.Audio)).To(Equal(len(pcm) - 2*20800))
+
+			Expect(trimmed.OriginalTime(0.5)).To(BeNumerically("~", 0.5, 0.001))
+			Expect(trimmed.OriginalTime(1.6)).To(BeNumerically("~", 4.2, 0.001))
+		})
+		It("Keeps short silences", func() {
+			trimmed, err := speechtotextv1.TrimSilence(pcm, "audio/l16;rate=8000", speechtotextv1.NewTrimSilenceOptions().
+				SetMinSilenceDuration(5*time.Second))
+			Expect(err).To(BeNil())
+			Expect(trimmed.Removed).To(BeEmpty())
+			Expect(trimmed.Audio).To(Equal(pcm))
+		})
+		It("Uses the defaults for options that are not set", func() {
+			trimmed, err := speechtotextv1.TrimSilence(pcm, "audio/l16;rate=8000", &speechtotextv1.TrimSilenceOptions{})
+			Expect(err).To(BeNil())
+			Expect(trimmed.Removed).To(HaveLen(1))
+			Expect(trimmed.Removed[0].Offset).To(BeNumerically("~", 1.2, 0.001))
+			Expect(trimmed.Removed[0].Duration).To(BeNumerically("~", 2.6, 0.001))
+		})
+	})
+	Describe("RestoreTimes(results *SpeechRecognitionResults)", func() {
+		It("Maps the times of words to the original audio", func() {
+			trimmed := &speechtotextv1.TrimmedAudio{
+				Removed: []speechtotextv1.RemovedSilence{{Offset: 1.2, Duration: 2.6}},
+			}
+			results := &speechtotextv1.SpeechRecognitionResults{
+				Results: []speechtotextv1.SpeechRecognitionResult{{
+					Alternatives: []speechtotextv1.SpeechRecognitionAlternative{{
+						Timestamps: []speechtotextv1.WordTimestamp{{Word: "hello", Start: 0.5, End: 0.9}, {Word: "world", Start: 1.5, End: 1.9}},
+					}},
+				}},
+			}
+			restored := trimmed.RestoreTimes(results)
+			Expect(restored.Results[0].Alternatives[0].Timestamps[1].Start).To(BeNumerically("~", 4.1, 0.001))
+			Expect(results.Results[0].Alternatives[0].Timestamps[1].Start).To(Equal(1.5))
+		})
+	})
+})
@@ -18,11 +18,14 @@
 package speechtotextv1
 
 import (
+	"context"
 	"fmt"
 	"github.com/edwindvinas/go-sdk-core/core"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 	"strings"
+	"time"
 )
 
 // SpeechToTextV1 : The IBM&reg; Speech to Text service provides APIs that use IBM's speech-recognition capabilities to
@@ -47,6 +50,10 @@ import (
 // See: https://cloud.ibm.com/docs/services/speech-to-text/
 type SpeechToTextV1 struct {
 	Service *core.BaseService
+
+	modelCache      *modelCache
+	profanityFilter *ProfanityFilter
+	audioPreflight  *audioPreflight
 }
 
 const defaultServiceURL = "https://stream.watsonplatform.net/speech-to-text/api"
@@ -55,6 +62,7 @@ const defaultServiceURL = "https://stream.watsonplatform.net/speech-to-text/api"
 type SpeechToTextV1Options struct {
 	URL           string
 	Authenticator core.Authenticator
+	HTTPClient    *http.Client
 }
 
 // NewSpeechToTextV1 : Instantiate SpeechToTextV1
@@ -69,7 +77,7 @@ func NewSpeechToTextV1(options *SpeechToTextV1Options) (service *SpeechToTextV1,
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("speech_to_text")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("speech_to_text")
 		if err != nil {
 			return
 		}
@@ -80,8 +88,13 @@ func NewSpeechToTextV1(options *SpeechToTextV1Options) (service *SpeechToTextV1,
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &SpeechToTextV1{
-		Service: baseService,
+		Service:    baseService,
+		modelCache: &modelCache{},
 	}
 
 	return
@@ -103,11 +116,21 @@ func (speechToText *SpeechToTextV1) DisableSSLVerification() {
 //
 // **See also:** [Languages and
 // models](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-models#models).
+//
+// If EnableModelCache was called, a cached result is returned while it has not expired.
 func (speechToText *SpeechToTextV1) ListModels(listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error) {
+	return speechToText.ListModelsWithContext(context.Background(), listModelsOptions)
+}
+
+// ListModelsWithContext is an alternate form of the ListModels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listModelsOptions, "listModelsOptions")
 	if err != nil {
 		return
 	}
+	if cachedResult, cachedResponse, ok := speechToText.modelCache.getList(); ok {
+		return cachedResult, cachedResponse, nil
+	}
 
 	pathSegments := []string{"v1/models"}
 	pathParameters := []string{}
@@ -133,13 +156,17 @@ func (speechToText *SpeechToTextV1) ListModels(listModelsOptions *ListModelsOpti
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(SpeechModels))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SpeechModels)
 		if !ok {
 			err = fmt.Errorf("An error occurred while processing the operation response.")
+		} else {
+			speechToText.modelCache.putList(result, response)
 		}
 	}
 
@@ -152,7 +179,14 @@ func (speechToText *SpeechToTextV1) ListModels(listModelsOptions *ListModelsOpti
 //
 // **See also:** [Languages and
 // models](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-models#models).
+//
+// If EnableModelCache was called, a cached result is returned while it has not expired.
 func (speechToText *SpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error) {
+	return speechToText.GetModelWithContext(context.Background(), getModelOptions)
+}
+
+// GetModelWithContext is an alternate form of the GetModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetModelWithContext(ctx context.Context, getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getModelOptions, "getModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -161,6 +195,9 @@ func (speechToText *SpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (
 	if err != nil {
 		return
 	}
+	if cachedResult, cachedResponse, ok := speechToText.modelCache.getModel(*getModelOptions.ModelID); ok {
+		return cachedResult, cachedResponse, nil
+	}
 
 	pathSegments := []string{"v1/models"}
 	pathParameters := []string{*getModelOptions.ModelID}
@@ -186,13 +223,17 @@ func (speechToText *SpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(SpeechModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SpeechModel)
 		if !ok {
 			err = fmt.Errorf("An error occurred while processing the operation response.")
+		} else {
+			speechToText.modelCache.putModel(*getModelOptions.ModelID, result, response)
 		}
 	}
 
@@ -257,9 +298,13 @@ func (speechToText *SpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (
 //  **See also:** [Audio
 // formats](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-audio-formats#audio-formats).
 //
+//  **Note:** To stream a large file from disk with a Content-Length header and report the progress of the upload, pass
+// an AudioUpload from OpenAudioUpload as the audio.
+//
 // ### Multipart speech recognition
 //
-//  **Note:** The Watson SDKs do not support multipart speech recognition.
+//  **Note:** Use RecognizeMultipart for multipart speech recognition. Recognize sends a multipart request
+// automatically when the keywords are too long for the query string.
 //
 // The HTTP `POST` method of the service also supports multipart speech recognition. With multipart requests, you pass
 // all audio data as multipart form data. You specify some parameters as request headers and query parameters, but you
@@ -273,6 +318,11 @@ func (speechToText *SpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (
 // **See also:** [Making a multipart HTTP
 // request](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-http#HTTP-multi).
 func (speechToText *SpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	return speechToText.RecognizeWithContext(context.Background(), recognizeOptions)
+}
+
+// RecognizeWithContext is an alternate form of the Recognize method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RecognizeWithContext(ctx context.Context, recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(recognizeOptions, "recognizeOptions cannot be nil")
 	if err != nil {
 		return
@@ -281,6 +331,24 @@ func (speechToText *SpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions
 	if err != nil {
 		return
 	}
+	err = validateCustomizationWeightPtr(recognizeOptions.CustomizationWeight)
+	if err != nil {
+		return
+	}
+	if speechToText.audioPreflight != nil {
+		err = speechToText.audioPreflight.checkRecognize(recognizeOptions.Audio, stringOrEmpty(recognizeOptions.ContentType))
+		if err != nil {
+			return
+		}
+	}
+	if recognizeOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, recognizeOptions.Timeout)
+		defer cancel()
+	}
+	if requiresMultipartRecognize(recognizeOptions) {
+		return speechToText.RecognizeMultipartWithContext(ctx, recognizeOptions)
+	}
 
 	pathSegments := []string{"v1/recognize"}
 	pathParameters := []string{}
@@ -372,13 +440,18 @@ func (speechToText *SpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
+	setUploadContentLength(request, recognizeOptions.Audio)
 
 	response, err = speechToText.Service.Request(request, new(SpeechRecognitionResults))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SpeechRecognitionResults)
 		if !ok {
 			err = fmt.Errorf("An error occurred while processing the operation response.")
+		} else if speechToText.profanityFilter != nil {
+			speechToText.profanityFilter.FilterResults(result)
 		}
 	}
 
@@ -414,6 +487,11 @@ func (speechToText *SpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions
 // **See also:** [Registering a callback
 // URL](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-async#register).
 func (speechToText *SpeechToTextV1) RegisterCallback(registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error) {
+	return speechToText.RegisterCallbackWithContext(context.Background(), registerCallbackOptions)
+}
+
+// RegisterCallbackWithContext is an alternate form of the RegisterCallback method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RegisterCallbackWithContext(ctx context.Context, registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(registerCallbackOptions, "registerCallbackOptions cannot be nil")
 	if err != nil {
 		return
@@ -452,8 +530,10 @@ func (speechToText *SpeechToTextV1) RegisterCallback(registerCallbackOptions *Re
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(RegisterStatus))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*RegisterStatus)
@@ -472,6 +552,11 @@ func (speechToText *SpeechToTextV1) RegisterCallback(registerCallbackOptions *Re
 // **See also:** [Unregistering a callback
 // URL](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-async#unregister).
 func (speechToText *SpeechToTextV1) UnregisterCallback(unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.UnregisterCallbackWithContext(context.Background(), unregisterCallbackOptions)
+}
+
+// UnregisterCallbackWithContext is an alternate form of the UnregisterCallback method which supports a Context parameter
+func (speechToText *SpeechToTextV1) UnregisterCallbackWithContext(ctx context.Context, unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(unregisterCallbackOptions, "unregisterCallbackOptions cannot be nil")
 	if err != nil {
 		return
@@ -505,8 +590,10 @@ func (speechToText *SpeechToTextV1) UnregisterCallback(unregisterCallbackOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -592,6 +679,11 @@ func (speechToText *SpeechToTextV1) UnregisterCallback(unregisterCallbackOptions
 //  **See also:** [Audio
 // formats](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-audio-formats#audio-formats).
 func (speechToText *SpeechToTextV1) CreateJob(createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	return speechToText.CreateJobWithContext(context.Background(), createJobOptions)
+}
+
+// CreateJobWithContext is an alternate form of the CreateJob method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CreateJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createJobOptions, "createJobOptions cannot be nil")
 	if err != nil {
 		return
@@ -600,6 +692,16 @@ func (speechToText *SpeechToTextV1) CreateJob(createJobOptions *CreateJobOptions
 	if err != nil {
 		return
 	}
+	err = validateCustomizationWeightPtr(createJobOptions.CustomizationWeight)
+	if err != nil {
+		return
+	}
+	if speechToText.audioPreflight != nil {
+		err = speechToText.audioPreflight.checkCreateJob(createJobOptions.Audio, stringOrEmpty(createJobOptions.ContentType))
+		if err != nil {
+			return
+		}
+	}
 
 	pathSegments := []string{"v1/recognitions"}
 	pathParameters := []string{}
@@ -709,8 +811,10 @@ func (speechToText *SpeechToTextV1) CreateJob(createJobOptions *CreateJobOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(RecognitionJob))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*RecognitionJob)
@@ -731,7 +835,15 @@ func (speechToText *SpeechToTextV1) CreateJob(createJobOptions *CreateJobOptions
 //
 // **See also:** [Checking the status of the latest
 // jobs](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-async#jobs).
+//
+// The jobs can be filtered by status, user token, and age with the options; the filters are applied to the latest
+// 100 jobs returned by the service.
 func (speechToText *SpeechToTextV1) CheckJobs(checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error) {
+	return speechToText.CheckJobsWithContext(context.Background(), checkJobsOptions)
+}
+
+// CheckJobsWithContext is an alternate form of the CheckJobs method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CheckJobsWithContext(ctx context.Context, checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(checkJobsOptions, "checkJobsOptions")
 	if err != nil {
 		return
@@ -761,14 +873,19 @@ func (speechToText *SpeechToTextV1) CheckJobs(checkJobsOptions *CheckJobsOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(RecognitionJobs))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*RecognitionJobs)
 		if !ok {
 			err = fmt.Errorf("An error occurred while processing the operation response.")
 		}
+		if ok {
+			result.Recognitions = filterRecognitionJobs(result.Recognitions, checkJobsOptions)
+		}
 	}
 
 	return
@@ -787,6 +904,11 @@ func (speechToText *SpeechToTextV1) CheckJobs(checkJobsOptions *CheckJobsOptions
 // **See also:** [Checking the status and retrieving the results of a
 // job](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-async#job).
 func (speechToText *SpeechToTextV1) CheckJob(checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	return speechToText.CheckJobWithContext(context.Background(), checkJobOptions)
+}
+
+// CheckJobWithContext is an alternate form of the CheckJob method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CheckJobWithContext(ctx context.Context, checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(checkJobOptions, "checkJobOptions cannot be nil")
 	if err != nil {
 		return
@@ -820,14 +942,19 @@ func (speechToText *SpeechToTextV1) CheckJob(checkJobOptions *CheckJobOptions) (
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(RecognitionJob))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*RecognitionJob)
 		if !ok {
 			err = fmt.Errorf("An error occurred while processing the operation response.")
 		}
+		if ok && speechToText.profanityFilter != nil {
+			speechToText.profanityFilter.FilterJob(result)
+		}
 	}
 
 	return
@@ -841,6 +968,11 @@ func (speechToText *SpeechToTextV1) CheckJob(checkJobOptions *CheckJobOptions) (
 // **See also:** [Deleting a
 // job](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-async#delete-async).
 func (speechToText *SpeechToTextV1) DeleteJob(deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteJobWithContext(context.Background(), deleteJobOptions)
+}
+
+// DeleteJobWithContext is an alternate form of the DeleteJob method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteJobWithContext(ctx context.Context, deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteJobOptions, "deleteJobOptions cannot be nil")
 	if err != nil {
 		return
@@ -872,8 +1004,10 @@ func (speechToText *SpeechToTextV1) DeleteJob(deleteJobOptions *DeleteJobOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -890,6 +1024,11 @@ func (speechToText *SpeechToTextV1) DeleteJob(deleteJobOptions *DeleteJobOptions
 // **See also:** [Create a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-languageCreate#createModel-language).
 func (speechToText *SpeechToTextV1) CreateLanguageModel(createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
+	return speechToText.CreateLanguageModelWithContext(context.Background(), createLanguageModelOptions)
+}
+
+// CreateLanguageModelWithContext is an alternate form of the CreateLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CreateLanguageModelWithContext(ctx context.Context, createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createLanguageModelOptions, "createLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -942,8 +1081,10 @@ func (speechToText *SpeechToTextV1) CreateLanguageModel(createLanguageModelOptio
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(LanguageModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LanguageModel)
@@ -964,6 +1105,11 @@ func (speechToText *SpeechToTextV1) CreateLanguageModel(createLanguageModelOptio
 // **See also:** [Listing custom language
 // models](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageLanguageModels#listModels-language).
 func (speechToText *SpeechToTextV1) ListLanguageModels(listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error) {
+	return speechToText.ListLanguageModelsWithContext(context.Background(), listLanguageModelsOptions)
+}
+
+// ListLanguageModelsWithContext is an alternate form of the ListLanguageModels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListLanguageModelsWithContext(ctx context.Context, listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listLanguageModelsOptions, "listLanguageModelsOptions")
 	if err != nil {
 		return
@@ -997,8 +1143,10 @@ func (speechToText *SpeechToTextV1) ListLanguageModels(listLanguageModelsOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(LanguageModels))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LanguageModels)
@@ -1017,6 +1165,11 @@ func (speechToText *SpeechToTextV1) ListLanguageModels(listLanguageModelsOptions
 // **See also:** [Listing custom language
 // models](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageLanguageModels#listModels-language).
 func (speechToText *SpeechToTextV1) GetLanguageModel(getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
+	return speechToText.GetLanguageModelWithContext(context.Background(), getLanguageModelOptions)
+}
+
+// GetLanguageModelWithContext is an alternate form of the GetLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetLanguageModelWithContext(ctx context.Context, getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getLanguageModelOptions, "getLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1050,8 +1203,10 @@ func (speechToText *SpeechToTextV1) GetLanguageModel(getLanguageModelOptions *Ge
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(LanguageModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LanguageModel)
@@ -1071,6 +1226,11 @@ func (speechToText *SpeechToTextV1) GetLanguageModel(getLanguageModelOptions *Ge
 // **See also:** [Deleting a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageLanguageModels#deleteModel-language).
 func (speechToText *SpeechToTextV1) DeleteLanguageModel(deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteLanguageModelWithContext(context.Background(), deleteLanguageModelOptions)
+}
+
+// DeleteLanguageModelWithContext is an alternate form of the DeleteLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteLanguageModelWithContext(ctx context.Context, deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteLanguageModelOptions, "deleteLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1104,8 +1264,10 @@ func (speechToText *SpeechToTextV1) DeleteLanguageModel(deleteLanguageModelOptio
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1126,6 +1288,7 @@ func (speechToText *SpeechToTextV1) DeleteLanguageModel(deleteLanguageModelOptio
 // `status` and `progress` fields. A status of `available` means that the custom model is trained and ready to use. The
 // service cannot accept subsequent training requests or requests to add new resources until the existing request
 // completes.
+// Use the **WaitForLanguageModelTraining** helper to wait for the training to finish.
 //
 // **See also:** [Train the custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-languageCreate#trainModel-language).
@@ -1141,6 +1304,11 @@ func (speechToText *SpeechToTextV1) DeleteLanguageModel(deleteLanguageModelOptio
 // exclude the invalid resources from the training. The model must contain at least one valid resource for training to
 // succeed.
 func (speechToText *SpeechToTextV1) TrainLanguageModel(trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
+	return speechToText.TrainLanguageModelWithContext(context.Background(), trainLanguageModelOptions)
+}
+
+// TrainLanguageModelWithContext is an alternate form of the TrainLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) TrainLanguageModelWithContext(ctx context.Context, trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(trainLanguageModelOptions, "trainLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1149,6 +1317,10 @@ func (speechToText *SpeechToTextV1) TrainLanguageModel(trainLanguageModelOptions
 	if err != nil {
 		return
 	}
+	err = validateCustomizationWeightPtr(trainLanguageModelOptions.CustomizationWeight)
+	if err != nil {
+		return
+	}
 
 	pathSegments := []string{"v1/customizations", "train"}
 	pathParameters := []string{*trainLanguageModelOptions.CustomizationID}
@@ -1181,8 +1353,10 @@ func (speechToText *SpeechToTextV1) TrainLanguageModel(trainLanguageModelOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(TrainingResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingResponse)
@@ -1203,6 +1377,11 @@ func (speechToText *SpeechToTextV1) TrainLanguageModel(trainLanguageModelOptions
 // **See also:** [Resetting a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageLanguageModels#resetModel-language).
 func (speechToText *SpeechToTextV1) ResetLanguageModel(resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.ResetLanguageModelWithContext(context.Background(), resetLanguageModelOptions)
+}
+
+// ResetLanguageModelWithContext is an alternate form of the ResetLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ResetLanguageModelWithContext(ctx context.Context, resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(resetLanguageModelOptions, "resetLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1236,8 +1415,10 @@ func (speechToText *SpeechToTextV1) ResetLanguageModel(resetLanguageModelOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1258,6 +1439,11 @@ func (speechToText *SpeechToTextV1) ResetLanguageModel(resetLanguageModelOptions
 // **See also:** [Upgrading a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-customUpgrade#upgradeLanguage).
 func (speechToText *SpeechToTextV1) UpgradeLanguageModel(upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.UpgradeLanguageModelWithContext(context.Background(), upgradeLanguageModelOptions)
+}
+
+// UpgradeLanguageModelWithContext is an alternate form of the UpgradeLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) UpgradeLanguageModelWithContext(ctx context.Context, upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(upgradeLanguageModelOptions, "upgradeLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1291,8 +1477,10 @@ func (speechToText *SpeechToTextV1) UpgradeLanguageModel(upgradeLanguageModelOpt
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1305,6 +1493,11 @@ func (speechToText *SpeechToTextV1) UpgradeLanguageModel(upgradeLanguageModelOpt
 // **See also:** [Listing corpora for a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageCorpora#listCorpora).
 func (speechToText *SpeechToTextV1) ListCorpora(listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error) {
+	return speechToText.ListCorporaWithContext(context.Background(), listCorporaOptions)
+}
+
+// ListCorporaWithContext is an alternate form of the ListCorpora method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListCorporaWithContext(ctx context.Context, listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listCorporaOptions, "listCorporaOptions cannot be nil")
 	if err != nil {
 		return
@@ -1338,8 +1531,10 @@ func (speechToText *SpeechToTextV1) ListCorpora(listCorporaOptions *ListCorporaO
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(Corpora))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Corpora)
@@ -1389,6 +1584,11 @@ func (speechToText *SpeechToTextV1) ListCorpora(listCorporaOptions *ListCorporaO
 // * [Add a corpus to the custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-languageCreate#addCorpus).
 func (speechToText *SpeechToTextV1) AddCorpus(addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddCorpusWithContext(context.Background(), addCorpusOptions)
+}
+
+// AddCorpusWithContext is an alternate form of the AddCorpus method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddCorpusWithContext(ctx context.Context, addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addCorpusOptions, "addCorpusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1422,15 +1622,21 @@ func (speechToText *SpeechToTextV1) AddCorpus(addCorpusOptions *AddCorpusOptions
 		builder.AddQuery("allow_overwrite", fmt.Sprint(*addCorpusOptions.AllowOverwrite))
 	}
 
-	builder.AddFormData("corpus_file", "filename",
+	corpusFilename := "filename"
+	if addCorpusOptions.CorpusFilename != nil {
+		corpusFilename = *addCorpusOptions.CorpusFilename
+	}
+	builder.AddFormData("corpus_file", corpusFilename,
 		"text/plain", addCorpusOptions.CorpusFile)
 
 	request, err := builder.Build()
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1443,6 +1649,11 @@ func (speechToText *SpeechToTextV1) AddCorpus(addCorpusOptions *AddCorpusOptions
 // **See also:** [Listing corpora for a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageCorpora#listCorpora).
 func (speechToText *SpeechToTextV1) GetCorpus(getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error) {
+	return speechToText.GetCorpusWithContext(context.Background(), getCorpusOptions)
+}
+
+// GetCorpusWithContext is an alternate form of the GetCorpus method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetCorpusWithContext(ctx context.Context, getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getCorpusOptions, "getCorpusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1476,8 +1687,10 @@ func (speechToText *SpeechToTextV1) GetCorpus(getCorpusOptions *GetCorpusOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(Corpus))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Corpus)
@@ -1499,6 +1712,11 @@ func (speechToText *SpeechToTextV1) GetCorpus(getCorpusOptions *GetCorpusOptions
 // **See also:** [Deleting a corpus from a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageCorpora#deleteCorpus).
 func (speechToText *SpeechToTextV1) DeleteCorpus(deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteCorpusWithContext(context.Background(), deleteCorpusOptions)
+}
+
+// DeleteCorpusWithContext is an alternate form of the DeleteCorpus method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteCorpusWithContext(ctx context.Context, deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteCorpusOptions, "deleteCorpusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1532,8 +1750,10 @@ func (speechToText *SpeechToTextV1) DeleteCorpus(deleteCorpusOptions *DeleteCorp
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1548,6 +1768,11 @@ func (speechToText *SpeechToTextV1) DeleteCorpus(deleteCorpusOptions *DeleteCorp
 // **See also:** [Listing words from a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageWords#listWords).
 func (speechToText *SpeechToTextV1) ListWords(listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
+	return speechToText.ListWordsWithContext(context.Background(), listWordsOptions)
+}
+
+// ListWordsWithContext is an alternate form of the ListWords method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListWordsWithContext(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listWordsOptions, "listWordsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1588,8 +1813,10 @@ func (speechToText *SpeechToTextV1) ListWords(listWordsOptions *ListWordsOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(Words))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Words)
@@ -1645,7 +1872,14 @@ func (speechToText *SpeechToTextV1) ListWords(listWordsOptions *ListWordsOptions
 // words](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-corporaWords#workingWords)
 // * [Add words to the custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-languageCreate#addWords).
+//
+//  **Note:** The words are checked with ValidateCustomWord before the request is sent.
 func (speechToText *SpeechToTextV1) AddWords(addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddWordsWithContext(context.Background(), addWordsOptions)
+}
+
+// AddWordsWithContext is an alternate form of the AddWords method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddWordsWithContext(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addWordsOptions, "addWordsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1654,6 +1888,12 @@ func (speechToText *SpeechToTextV1) AddWords(addWordsOptions *AddWordsOptions) (
 	if err != nil {
 		return
 	}
+	for _, word := range addWordsOptions.Words {
+		err = ValidateCustomWord(word)
+		if err != nil {
+			return
+		}
+	}
 
 	pathSegments := []string{"v1/customizations", "words"}
 	pathParameters := []string{*addWordsOptions.CustomizationID}
@@ -1689,8 +1929,10 @@ func (speechToText *SpeechToTextV1) AddWords(addWordsOptions *AddWordsOptions) (
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1725,7 +1967,14 @@ func (speechToText *SpeechToTextV1) AddWords(addWordsOptions *AddWordsOptions) (
 // words](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-corporaWords#workingWords)
 // * [Add words to the custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-languageCreate#addWords).
+//
+//  **Note:** The words are checked with ValidateCustomWord before the request is sent.
 func (speechToText *SpeechToTextV1) AddWord(addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddWordWithContext(context.Background(), addWordOptions)
+}
+
+// AddWordWithContext is an alternate form of the AddWord method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddWordWithContext(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addWordOptions, "addWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -1734,6 +1983,14 @@ func (speechToText *SpeechToTextV1) AddWord(addWordOptions *AddWordOptions) (res
 	if err != nil {
 		return
 	}
+	err = ValidateCustomWord(CustomWord{
+		Word:       addWordOptions.WordName,
+		SoundsLike: addWordOptions.SoundsLike,
+		DisplayAs:  addWordOptions.DisplayAs,
+	})
+	if err != nil {
+		return
+	}
 
 	pathSegments := []string{"v1/customizations", "words"}
 	pathParameters := []string{*addWordOptions.CustomizationID, *addWordOptions.WordName}
@@ -1775,8 +2032,10 @@ func (speechToText *SpeechToTextV1) AddWord(addWordOptions *AddWordOptions) (res
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1788,6 +2047,11 @@ func (speechToText *SpeechToTextV1) AddWord(addWordOptions *AddWordOptions) (res
 // **See also:** [Listing words from a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageWords#listWords).
 func (speechToText *SpeechToTextV1) GetWord(getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error) {
+	return speechToText.GetWordWithContext(context.Background(), getWordOptions)
+}
+
+// GetWordWithContext is an alternate form of the GetWord method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetWordWithContext(ctx context.Context, getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getWordOptions, "getWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -1821,8 +2085,10 @@ func (speechToText *SpeechToTextV1) GetWord(getWordOptions *GetWordOptions) (res
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(Word))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Word)
@@ -1844,6 +2110,11 @@ func (speechToText *SpeechToTextV1) GetWord(getWordOptions *GetWordOptions) (res
 // **See also:** [Deleting a word from a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageWords#deleteWord).
 func (speechToText *SpeechToTextV1) DeleteWord(deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteWordWithContext(context.Background(), deleteWordOptions)
+}
+
+// DeleteWordWithContext is an alternate form of the DeleteWord method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteWordWithContext(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteWordOptions, "deleteWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -1877,8 +2148,10 @@ func (speechToText *SpeechToTextV1) DeleteWord(deleteWordOptions *DeleteWordOpti
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1891,6 +2164,11 @@ func (speechToText *SpeechToTextV1) DeleteWord(deleteWordOptions *DeleteWordOpti
 // **See also:** [Listing grammars from a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageGrammars#listGrammars).
 func (speechToText *SpeechToTextV1) ListGrammars(listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error) {
+	return speechToText.ListGrammarsWithContext(context.Background(), listGrammarsOptions)
+}
+
+// ListGrammarsWithContext is an alternate form of the ListGrammars method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListGrammarsWithContext(ctx context.Context, listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listGrammarsOptions, "listGrammarsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1924,8 +2202,10 @@ func (speechToText *SpeechToTextV1) ListGrammars(listGrammarsOptions *ListGramma
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(Grammars))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Grammars)
@@ -1970,6 +2250,11 @@ func (speechToText *SpeechToTextV1) ListGrammars(listGrammarsOptions *ListGramma
 // * [Add a grammar to the custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-grammarAdd#addGrammar).
 func (speechToText *SpeechToTextV1) AddGrammar(addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddGrammarWithContext(context.Background(), addGrammarOptions)
+}
+
+// AddGrammarWithContext is an alternate form of the AddGrammar method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddGrammarWithContext(ctx context.Context, addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addGrammarOptions, "addGrammarOptions cannot be nil")
 	if err != nil {
 		return
@@ -2015,8 +2300,10 @@ func (speechToText *SpeechToTextV1) AddGrammar(addGrammarOptions *AddGrammarOpti
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2029,6 +2316,11 @@ func (speechToText *SpeechToTextV1) AddGrammar(addGrammarOptions *AddGrammarOpti
 // **See also:** [Listing grammars from a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageGrammars#listGrammars).
 func (speechToText *SpeechToTextV1) GetGrammar(getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error) {
+	return speechToText.GetGrammarWithContext(context.Background(), getGrammarOptions)
+}
+
+// GetGrammarWithContext is an alternate form of the GetGrammar method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetGrammarWithContext(ctx context.Context, getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getGrammarOptions, "getGrammarOptions cannot be nil")
 	if err != nil {
 		return
@@ -2062,8 +2354,10 @@ func (speechToText *SpeechToTextV1) GetGrammar(getGrammarOptions *GetGrammarOpti
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(Grammar))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Grammar)
@@ -2085,6 +2379,11 @@ func (speechToText *SpeechToTextV1) GetGrammar(getGrammarOptions *GetGrammarOpti
 // **See also:** [Deleting a grammar from a custom language
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageGrammars#deleteGrammar).
 func (speechToText *SpeechToTextV1) DeleteGrammar(deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteGrammarWithContext(context.Background(), deleteGrammarOptions)
+}
+
+// DeleteGrammarWithContext is an alternate form of the DeleteGrammar method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteGrammarWithContext(ctx context.Context, deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteGrammarOptions, "deleteGrammarOptions cannot be nil")
 	if err != nil {
 		return
@@ -2118,8 +2417,10 @@ func (speechToText *SpeechToTextV1) DeleteGrammar(deleteGrammarOptions *DeleteGr
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2136,6 +2437,11 @@ func (speechToText *SpeechToTextV1) DeleteGrammar(deleteGrammarOptions *DeleteGr
 // **See also:** [Create a custom acoustic
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-acoustic#createModel-acoustic).
 func (speechToText *SpeechToTextV1) CreateAcousticModel(createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
+	return speechToText.CreateAcousticModelWithContext(context.Background(), createAcousticModelOptions)
+}
+
+// CreateAcousticModelWithContext is an alternate form of the CreateAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CreateAcousticModelWithContext(ctx context.Context, createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createAcousticModelOptions, "createAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2185,8 +2491,10 @@ func (speechToText *SpeechToTextV1) CreateAcousticModel(createAcousticModelOptio
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(AcousticModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AcousticModel)
@@ -2207,6 +2515,11 @@ func (speechToText *SpeechToTextV1) CreateAcousticModel(createAcousticModelOptio
 // **See also:** [Listing custom acoustic
 // models](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageAcousticModels#listModels-acoustic).
 func (speechToText *SpeechToTextV1) ListAcousticModels(listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error) {
+	return speechToText.ListAcousticModelsWithContext(context.Background(), listAcousticModelsOptions)
+}
+
+// ListAcousticModelsWithContext is an alternate form of the ListAcousticModels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListAcousticModelsWithContext(ctx context.Context, listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listAcousticModelsOptions, "listAcousticModelsOptions")
 	if err != nil {
 		return
@@ -2240,8 +2553,10 @@ func (speechToText *SpeechToTextV1) ListAcousticModels(listAcousticModelsOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(AcousticModels))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AcousticModels)
@@ -2260,6 +2575,11 @@ func (speechToText *SpeechToTextV1) ListAcousticModels(listAcousticModelsOptions
 // **See also:** [Listing custom acoustic
 // models](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageAcousticModels#listModels-acoustic).
 func (speechToText *SpeechToTextV1) GetAcousticModel(getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
+	return speechToText.GetAcousticModelWithContext(context.Background(), getAcousticModelOptions)
+}
+
+// GetAcousticModelWithContext is an alternate form of the GetAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetAcousticModelWithContext(ctx context.Context, getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getAcousticModelOptions, "getAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2293,8 +2613,10 @@ func (speechToText *SpeechToTextV1) GetAcousticModel(getAcousticModelOptions *Ge
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(AcousticModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AcousticModel)
@@ -2314,6 +2636,11 @@ func (speechToText *SpeechToTextV1) GetAcousticModel(getAcousticModelOptions *Ge
 // **See also:** [Deleting a custom acoustic
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageAcousticModels#deleteModel-acoustic).
 func (speechToText *SpeechToTextV1) DeleteAcousticModel(deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteAcousticModelWithContext(context.Background(), deleteAcousticModelOptions)
+}
+
+// DeleteAcousticModelWithContext is an alternate form of the DeleteAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteAcousticModelWithContext(ctx context.Context, deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteAcousticModelOptions, "deleteAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2347,8 +2674,10 @@ func (speechToText *SpeechToTextV1) DeleteAcousticModel(deleteAcousticModelOptio
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2370,6 +2699,7 @@ func (speechToText *SpeechToTextV1) DeleteAcousticModel(deleteAcousticModelOptio
 // `status` and `progress` fields. A status of `available` indicates that the custom model is trained and ready to use.
 // The service cannot train a model while it is handling another request for the model. The service cannot accept
 // subsequent training requests, or requests to add new audio resources, until the existing training request completes.
+// Use the **WaitForAcousticModelTraining** helper to wait for the training to finish.
 //
 // You can use the optional `custom_language_model_id` parameter to specify the GUID of a separately created custom
 // language model that is to be used during training. Train with a custom language model if you have verbatim
@@ -2395,6 +2725,11 @@ func (speechToText *SpeechToTextV1) DeleteAcousticModel(deleteAcousticModelOptio
 // the `strict` parameter to `false` to exclude the invalid resources from the training. The model must contain at least
 // one valid resource for training to succeed.
 func (speechToText *SpeechToTextV1) TrainAcousticModel(trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
+	return speechToText.TrainAcousticModelWithContext(context.Background(), trainAcousticModelOptions)
+}
+
+// TrainAcousticModelWithContext is an alternate form of the TrainAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) TrainAcousticModelWithContext(ctx context.Context, trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(trainAcousticModelOptions, "trainAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2403,6 +2738,12 @@ func (speechToText *SpeechToTextV1) TrainAcousticModel(trainAcousticModelOptions
 	if err != nil {
 		return
 	}
+	if speechToText.audioPreflight != nil {
+		err = speechToText.audioPreflight.checkTrainAcousticModel(ctx, speechToText, *trainAcousticModelOptions.CustomizationID)
+		if err != nil {
+			return
+		}
+	}
 
 	pathSegments := []string{"v1/acoustic_customizations", "train"}
 	pathParameters := []string{*trainAcousticModelOptions.CustomizationID}
@@ -2432,8 +2773,10 @@ func (speechToText *SpeechToTextV1) TrainAcousticModel(trainAcousticModelOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(TrainingResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingResponse)
@@ -2456,6 +2799,11 @@ func (speechToText *SpeechToTextV1) TrainAcousticModel(trainAcousticModelOptions
 // **See also:** [Resetting a custom acoustic
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageAcousticModels#resetModel-acoustic).
 func (speechToText *SpeechToTextV1) ResetAcousticModel(resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.ResetAcousticModelWithContext(context.Background(), resetAcousticModelOptions)
+}
+
+// ResetAcousticModelWithContext is an alternate form of the ResetAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ResetAcousticModelWithContext(ctx context.Context, resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(resetAcousticModelOptions, "resetAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2489,8 +2837,10 @@ func (speechToText *SpeechToTextV1) ResetAcousticModel(resetAcousticModelOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2518,6 +2868,11 @@ func (speechToText *SpeechToTextV1) ResetAcousticModel(resetAcousticModelOptions
 // **See also:** [Upgrading a custom acoustic
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-customUpgrade#upgradeAcoustic).
 func (speechToText *SpeechToTextV1) UpgradeAcousticModel(upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.UpgradeAcousticModelWithContext(context.Background(), upgradeAcousticModelOptions)
+}
+
+// UpgradeAcousticModelWithContext is an alternate form of the UpgradeAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) UpgradeAcousticModelWithContext(ctx context.Context, upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(upgradeAcousticModelOptions, "upgradeAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2558,8 +2913,10 @@ func (speechToText *SpeechToTextV1) UpgradeAcousticModel(upgradeAcousticModelOpt
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2574,6 +2931,11 @@ func (speechToText *SpeechToTextV1) UpgradeAcousticModel(upgradeAcousticModelOpt
 // **See also:** [Listing audio resources for a custom acoustic
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageAudio#listAudio).
 func (speechToText *SpeechToTextV1) ListAudio(listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error) {
+	return speechToText.ListAudioWithContext(context.Background(), listAudioOptions)
+}
+
+// ListAudioWithContext is an alternate form of the ListAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListAudioWithContext(ctx context.Context, listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listAudioOptions, "listAudioOptions cannot be nil")
 	if err != nil {
 		return
@@ -2607,8 +2969,10 @@ func (speechToText *SpeechToTextV1) ListAudio(listAudioOptions *ListAudioOptions
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(AudioResources))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AudioResources)
@@ -2705,7 +3069,15 @@ func (speechToText *SpeechToTextV1) ListAudio(listAudioOptions *ListAudioOptions
 //
 //  The name of an audio file that is contained in an archive-type resource can include a maximum of 128 characters.
 // This includes the file extension and all elements of the name (for example, slashes).
+//
+//  **Note:** To stream a large archive from disk with a Content-Length header and report the progress of the upload,
+// pass an AudioUpload from OpenAudioUpload as the audio resource.
 func (speechToText *SpeechToTextV1) AddAudio(addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddAudioWithContext(context.Background(), addAudioOptions)
+}
+
+// AddAudioWithContext is an alternate form of the AddAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddAudioWithContext(ctx context.Context, addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addAudioOptions, "addAudioOptions cannot be nil")
 	if err != nil {
 		return
@@ -2714,6 +3086,12 @@ func (speechToText *SpeechToTextV1) AddAudio(addAudioOptions *AddAudioOptions) (
 	if err != nil {
 		return
 	}
+	if speechToText.audioPreflight != nil {
+		err = speechToText.audioPreflight.checkAddAudio(ctx, speechToText, addAudioOptions)
+		if err != nil {
+			return
+		}
+	}
 
 	pathSegments := []string{"v1/acoustic_customizations", "audio"}
 	pathParameters := []string{*addAudioOptions.CustomizationID, *addAudioOptions.AudioName}
@@ -2754,8 +3132,11 @@ func (speechToText *SpeechToTextV1) AddAudio(addAudioOptions *AddAudioOptions) (
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
+	setUploadContentLength(request, addAudioOptions.AudioResource)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2780,6 +3161,11 @@ func (speechToText *SpeechToTextV1) AddAudio(addAudioOptions *AddAudioOptions) (
 // **See also:** [Listing audio resources for a custom acoustic
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageAudio#listAudio).
 func (speechToText *SpeechToTextV1) GetAudio(getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error) {
+	return speechToText.GetAudioWithContext(context.Background(), getAudioOptions)
+}
+
+// GetAudioWithContext is an alternate form of the GetAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetAudioWithContext(ctx context.Context, getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getAudioOptions, "getAudioOptions cannot be nil")
 	if err != nil {
 		return
@@ -2813,8 +3199,10 @@ func (speechToText *SpeechToTextV1) GetAudio(getAudioOptions *GetAudioOptions) (
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, new(AudioListing))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AudioListing)
@@ -2838,6 +3226,11 @@ func (speechToText *SpeechToTextV1) GetAudio(getAudioOptions *GetAudioOptions) (
 // **See also:** [Deleting an audio resource from a custom acoustic
 // model](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-manageAudio#deleteAudio).
 func (speechToText *SpeechToTextV1) DeleteAudio(deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteAudioWithContext(context.Background(), deleteAudioOptions)
+}
+
+// DeleteAudioWithContext is an alternate form of the DeleteAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteAudioWithContext(ctx context.Context, deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteAudioOptions, "deleteAudioOptions cannot be nil")
 	if err != nil {
 		return
@@ -2871,8 +3264,10 @@ func (speechToText *SpeechToTextV1) DeleteAudio(deleteAudioOptions *DeleteAudioO
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -2888,6 +3283,11 @@ func (speechToText *SpeechToTextV1) DeleteAudio(deleteAudioOptions *DeleteAudioO
 // **See also:** [Information
 // security](https://cloud.ibm.com/docs/services/speech-to-text?topic=speech-to-text-information-security#information-security).
 func (speechToText *SpeechToTextV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -2921,8 +3321,10 @@ func (speechToText *SpeechToTextV1) DeleteUserData(deleteUserDataOptions *Delete
 	if err != nil {
 		return
 	}
+	request = request.WithContext(ctx)
 
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -3176,6 +3578,9 @@ type AddCorpusOptions struct {
 	// With the `curl` command, use the `--data-binary` option to upload the file for the request.
 	CorpusFile io.ReadCloser `json:"corpus_file" validate:"required"`
 
+	// The filename for corpusFile.
+	CorpusFilename *string `json:"corpus_filename,omitempty"`
+
 	// If `true`, the specified corpus overwrites an existing corpus with the same name. If `false`, the request fails if a
 	// corpus with the same name already exists. The parameter has no effect if a corpus with the same name does not
 	// already exist.
@@ -3212,6 +3617,12 @@ func (options *AddCorpusOptions) SetCorpusFile(corpusFile io.ReadCloser) *AddCor
 	return options
 }
 
+// SetCorpusFilename : Allow user to set CorpusFilename
+func (options *AddCorpusOptions) SetCorpusFilename(corpusFilename string) *AddCorpusOptions {
+	options.CorpusFilename = core.StringPtr(corpusFilename)
+	return options
+}
+
 // SetAllowOverwrite : Allow user to set AllowOverwrite
 func (options *AddCorpusOptions) SetAllowOverwrite(allowOverwrite bool) *AddCorpusOptions {
 	options.AllowOverwrite = core.BoolPtr(allowOverwrite)
@@ -3693,6 +4104,18 @@ func (options *CheckJobOptions) SetHeaders(param map[string]string) *CheckJobOpt
 // CheckJobsOptions : The CheckJobs options.
 type CheckJobsOptions struct {
 
+	// If set, only jobs with one of these statuses are returned. The filter is applied by the client.
+	Statuses []string
+
+	// If set, only jobs that were created with this user token are returned. The filter is applied by the client.
+	UserToken *string
+
+	// If set, only jobs that were created at least this long ago are returned. The filter is applied by the client.
+	OlderThan time.Duration
+
+	// If set, only jobs that were created at most this long ago are returned. The filter is applied by the client.
+	NewerThan time.Duration
+
 	// Allows users to set headers to be GDPR compliant
 	Headers map[string]string
 }
@@ -3702,6 +4125,30 @@ func (speechToText *SpeechToTextV1) NewCheckJobsOptions() *CheckJobsOptions {
 	return &CheckJobsOptions{}
 }
 
+// SetStatuses : Allow user to set Statuses
+func (options *CheckJobsOptions) SetStatuses(statuses []string) *CheckJobsOptions {
+	options.Statuses = statuses
+	return options
+}
+
+// SetUserToken : Allow user to set UserToken
+func (options *CheckJobsOptions) SetUserToken(userToken string) *CheckJobsOptions {
+	options.UserToken = core.StringPtr(userToken)
+	return options
+}
+
+// SetOlderThan : Allow user to set OlderThan
+func (options *CheckJobsOptions) SetOlderThan(olderThan time.Duration) *CheckJobsOptions {
+	options.OlderThan = olderThan
+	return options
+}
+
+// SetNewerThan : Allow user to set NewerThan
+func (options *CheckJobsOptions) SetNewerThan(newerThan time.Duration) *CheckJobsOptions {
+	options.NewerThan = newerThan
+	return options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *CheckJobsOptions) SetHeaders(param map[string]string) *CheckJobsOptions {
 	options.Headers = param
@@ -4249,6 +4696,8 @@ func (options *CreateJobOptions) SetSpeakerLabels(speakerLabels bool) *CreateJob
 }
 
 // SetCustomizationID : Allow user to set CustomizationID
+//
+// Deprecated: Use SetLanguageCustomizationID instead.
 func (options *CreateJobOptions) SetCustomizationID(customizationID string) *CreateJobOptions {
 	options.CustomizationID = core.StringPtr(customizationID)
 	return options
@@ -5680,6 +6129,14 @@ type RecognizeOptions struct {
 	// audio metrics with the final transcription results. By default, the service returns no audio metrics.
 	AudioMetrics *bool `json:"audio_metrics,omitempty"`
 
+	// If set, the request is cancelled if it does not complete within this time, in addition to any deadline of the
+	// context of the request.
+	Timeout time.Duration `json:"-"`
+
+	// For RecognizeStream only: if set, silence is sent whenever Audio produces no audio for this long, so that the
+	// service does not close the connection while the source of the audio stalls. Requires uncompressed audio.
+	KeepAliveInterval time.Duration `json:"-"`
+
 	// Allows users to set headers to be GDPR compliant
 	Headers map[string]string
 }
@@ -5830,6 +6287,8 @@ func (options *RecognizeOptions) SetSpeakerLabels(speakerLabels bool) *Recognize
 }
 
 // SetCustomizationID : Allow user to set CustomizationID
+//
+// Deprecated: Use SetLanguageCustomizationID instead.
 func (options *RecognizeOptions) SetCustomizationID(customizationID string) *RecognizeOptions {
 	options.CustomizationID = core.StringPtr(customizationID)
 	return options
@@ -5853,6 +6312,18 @@ func (options *RecognizeOptions) SetAudioMetrics(audioMetrics bool) *RecognizeOp
 	return options
 }
 
+// SetTimeout : Allow user to set Timeout
+func (options *RecognizeOptions) SetTimeout(timeout time.Duration) *RecognizeOptions {
+	options.Timeout = timeout
+	return options
+}
+
+// SetKeepAliveInterval : Allow user to set KeepAliveInterval
+func (options *RecognizeOptions) SetKeepAliveInterval(keepAliveInterval time.Duration) *RecognizeOptions {
+	options.KeepAliveInterval = keepAliveInterval
+	return options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *RecognizeOptions) SetHeaders(param map[string]string) *RecognizeOptions {
 	options.Headers = param
@@ -6049,12 +6520,12 @@ type SpeechRecognitionAlternative struct {
 	// Time alignments for each word from the transcript as a list of lists. Each inner list consists of three elements:
 	// the word followed by its start and end time in seconds, for example: `[["hello",0.0,1.2],["world",1.2,2.5]]`.
 	// Timestamps are returned only for the best alternative.
-	Timestamps []interface{} `json:"timestamps,omitempty"`
+	Timestamps []WordTimestamp `json:"timestamps,omitempty"`
 
 	// A confidence score for each word of the transcript as a list of lists. Each inner list consists of two elements: the
 	// word and its confidence score in the range of 0.0 to 1.0, for example: `[["hello",0.95],["world",0.866]]`.
 	// Confidence scores are returned only for the best alternative and only with results marked as final.
-	WordConfidence []interface{} `json:"word_confidence,omitempty"`
+	WordConfidence []WordConfidence `json:"word_confidence,omitempty"`
 }
 
 // SpeechRecognitionResult : Component results for a speech recognition request.
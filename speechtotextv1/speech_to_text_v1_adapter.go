@@ -3,6 +3,7 @@ package speechtotextv1
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 
 	"github.com/edwindvinas/go-sdk-core/core"
 
@@ -45,6 +46,9 @@ type RecognizeUsingWebsocketOptions struct {
 	// instead of at periodic intervals, set the value to a large number. If the value is larger than the duration of the
 	// audio, the service returns processing metrics only for transcription events.
 	ProcessingMetricsInterval *float32 `json:"processing_metrics_interval,omitempty"`
+
+	// If set, dropped connections are restored and the audio that was not recognized is sent again. Can be nil.
+	Reconnect *WebsocketReconnectOptions `json:"-"`
 }
 
 // SetAction: Allows user to set the Action
@@ -71,11 +75,16 @@ func (recognizeWSOptions *RecognizeUsingWebsocketOptions) SetProcessingMetricsIn
 	return recognizeWSOptions
 }
 
-// NewRecognizeUsingWebsocketOptions: Instantiate RecognizeOptions to enable websocket support
-func (speechToText *SpeechToTextV1) NewRecognizeUsingWebsocketOptions(audio io.ReadCloser, contentType string) *RecognizeUsingWebsocketOptions {
-	recognizeOptions := speechToText.NewRecognizeOptions(audio)
+// NewRecognizeUsingWebsocketOptions: Instantiate RecognizeOptions to enable websocket support. The audio is streamed
+// to the service as it is read, so it can be a live source such as a microphone or a network stream.
+func (speechToText *SpeechToTextV1) NewRecognizeUsingWebsocketOptions(audio io.Reader, contentType string) *RecognizeUsingWebsocketOptions {
+	audioReadCloser, ok := audio.(io.ReadCloser)
+	if !ok {
+		audioReadCloser = ioutil.NopCloser(audio)
+	}
+	recognizeOptions := speechToText.NewRecognizeOptions(audioReadCloser)
 	recognizeOptions.SetContentType(contentType)
-	recognizeWSOptions := &RecognizeUsingWebsocketOptions{*recognizeOptions, nil, nil, nil, nil}
+	recognizeWSOptions := &RecognizeUsingWebsocketOptions{RecognizeOptions: *recognizeOptions}
 	return recognizeWSOptions
 }
 
@@ -94,6 +103,49 @@ type RecognizeCallbackWrapper interface {
 	OnError(error)
 }
 
+// RecognizeCallback : Callback for recognize using websocket that is also notified when the service starts
+// listening and when interim results arrive. Callbacks that implement it can be passed to RecognizeUsingWebsocket in
+// place of a RecognizeCallbackWrapper.
+type RecognizeCallback interface {
+	RecognizeCallbackWrapper
+
+	// OnListening is invoked once the service has acknowledged the start message and is ready for audio
+	OnListening()
+
+	// OnInterimResult is invoked for each message that contains results that are not final
+	OnInterimResult(*SpeechRecognitionResults)
+}
+
+// ProcessingMetricsCallback : Callback for recognize using websocket that is notified of the processing metrics
+// requested with SetProcessingMetrics. Callbacks that implement it receive the metrics in addition to OnData.
+type ProcessingMetricsCallback interface {
+
+	// OnProcessingMetrics is invoked for each message that contains processing metrics
+	OnProcessingMetrics(*ProcessingMetrics)
+}
+
+// BaseRecognizeCallback : A RecognizeCallback whose methods do nothing. Embed it in a callback to implement only the
+// events of interest.
+type BaseRecognizeCallback struct{}
+
+// OnOpen : Invoked when the websocket connection is opened
+func (BaseRecognizeCallback) OnOpen() {}
+
+// OnClose : Invoked when the websocket connection is closed
+func (BaseRecognizeCallback) OnClose() {}
+
+// OnData : Invoked with every message containing results
+func (BaseRecognizeCallback) OnData(*core.DetailedResponse) {}
+
+// OnError : Invoked when an error is encountered
+func (BaseRecognizeCallback) OnError(error) {}
+
+// OnListening : Invoked when the service is ready for audio
+func (BaseRecognizeCallback) OnListening() {}
+
+// OnInterimResult : Invoked with every message containing interim results
+func (BaseRecognizeCallback) OnInterimResult(*SpeechRecognitionResults) {}
+
 // RecognizeUsingWebsocket: Recognize audio over websocket connection
 func (speechToText *SpeechToTextV1) RecognizeUsingWebsocket(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) {
 	if err := core.ValidateNotNil(recognizeWSOptions, "recognizeOptions cannot be nil"); err != nil {
@@ -102,6 +154,9 @@ func (speechToText *SpeechToTextV1) RecognizeUsingWebsocket(recognizeWSOptions *
 	if err := core.ValidateStruct(recognizeWSOptions, "recognizeOptions"); err != nil {
 		panic(err)
 	}
+	if err := validateCustomizationWeightPtr(recognizeWSOptions.CustomizationWeight); err != nil {
+		panic(err)
+	}
 
 	// Add authentication to the outbound request.
 	if speechToText.Service.Options.Authenticator == nil {
@@ -135,5 +190,10 @@ func (speechToText *SpeechToTextV1) RecognizeUsingWebsocket(recognizeWSOptions *
 		param.Set("base_model_version", *recognizeWSOptions.BaseModelVersion)
 	}
 
+	if recognizeWSOptions.Reconnect != nil {
+		speechToText.recognizeUsingWebsocketWithReconnect(callback, recognizeWSOptions, dialURL, param, req)
+		return
+	}
+
 	speechToText.NewRecognizeListener(callback, recognizeWSOptions, dialURL, param, headers)
 }
@@ -263,6 +263,58 @@ func TestWords(t *testing.T) {
 	assert.Nil(t, responseErr)
 }
 
+func TestGrammars(t *testing.T) {
+	shouldSkipTest(t)
+
+	// List grammars
+	listGrammars, _, responseErr := service.ListGrammars(
+		&speechtotextv1.ListGrammarsOptions{
+			CustomizationID: languageModel.CustomizationID,
+		},
+	)
+	assert.Nil(t, responseErr)
+	assert.NotNil(t, listGrammars)
+
+	if *languageModel.Status != "Available" {
+		t.Skip("Skipping the rest of the grammars tests")
+	}
+
+	// Add grammar
+	pwd, _ := os.Getwd()
+	grammarFile, grammarFileErr := os.Open(pwd + "/../resources/confirm-grammar.xml")
+	if grammarFileErr != nil {
+		panic(grammarFileErr)
+	}
+	_, responseErr = service.AddGrammar(
+		&speechtotextv1.AddGrammarOptions{
+			CustomizationID: languageModel.CustomizationID,
+			GrammarName:     core.StringPtr("grammar for GO"),
+			GrammarFile:     grammarFile,
+			ContentType:     core.StringPtr("application/srgs+xml"),
+		},
+	)
+	assert.Nil(t, responseErr)
+
+	// Get grammar
+	getGrammar, _, responseErr := service.GetGrammar(
+		&speechtotextv1.GetGrammarOptions{
+			CustomizationID: languageModel.CustomizationID,
+			GrammarName:     core.StringPtr("grammar for GO"),
+		},
+	)
+	assert.Nil(t, responseErr)
+	assert.NotNil(t, getGrammar)
+
+	// Delete grammar
+	_, responseErr = service.DeleteGrammar(
+		&speechtotextv1.DeleteGrammarOptions{
+			CustomizationID: languageModel.CustomizationID,
+			GrammarName:     core.StringPtr("grammar for GO"),
+		},
+	)
+	assert.Nil(t, responseErr)
+}
+
 func TestAcousticModel(t *testing.T) {
 	shouldSkipTest(t)
 
@@ -1,6 +1,7 @@
 package speechtotextv1_test
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"github.com/edwindvinas/go-sdk-core/core"
@@ -1789,4 +1790,78 @@ var _ = Describe("SpeechToTextV1", func() {
 			})
 		})
 	})
+	Describe("ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions)", func() {
+		username := "user1"
+		password := "pass1"
+		Context("Unsuccessfully - Cancelled context", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"models":[]}`)
+			}))
+			It("Fail to call ListModelsWithContext", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				listModelsOptions := testService.NewListModelsOptions()
+				result, _, returnValueErr := testService.ListModelsWithContext(ctx, listModelsOptions)
+				Expect(returnValueErr).NotTo(BeNil())
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+	Describe("NewSpeechToTextV1(options *SpeechToTextV1Options)", func() {
+		username := "user1"
+		password := "pass1"
+		Context("Successfully - Use a custom HTTP client", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.Header.Get("X-Custom-Transport")).To(Equal("true"))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"models":[]}`)
+			}))
+			It("Succeed to call ListModels through the custom client", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+					HTTPClient: &http.Client{
+						Transport: headerTransport{},
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				result, returnValue, returnValueErr := testService.ListModels(testService.NewListModelsOptions())
+				Expect(returnValueErr).To(BeNil())
+				Expect(returnValue).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+		})
+	})
 })
+
+type headerTransport struct{}
+
+func (headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("X-Custom-Transport", "true")
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	DEFAULT_SUBTITLE_MAX_LINE_LENGTH      = 42
+	DEFAULT_SUBTITLE_MAX_LINES            = 2
+	DEFAULT_SUBTITLE_MAX_CAPTION_DURATION = 6.0
+)
+
+// SubtitleOptions : Options that control how recognition results are divided into captions
+type SubtitleOptions struct {
+
+	// The maximum number of characters on a line of a caption. Defaults to 42.
+	MaxLineLength int
+
+	// The maximum number of lines in a caption. Defaults to 2.
+	MaxLines int
+
+	// The maximum duration of a caption in seconds. Defaults to 6.
+	MaxCaptionDuration float64
+}
+
+// NewSubtitleOptions : Instantiate SubtitleOptions with the default values
+func NewSubtitleOptions() *SubtitleOptions {
+	return &SubtitleOptions{
+		MaxLineLength:      DEFAULT_SUBTITLE_MAX_LINE_LENGTH,
+		MaxLines:           DEFAULT_SUBTITLE_MAX_LINES,
+		MaxCaptionDuration: DEFAULT_SUBTITLE_MAX_CAPTION_DURATION,
+	}
+}
+
+// SetMaxLineLength : Allow user to set MaxLineLength
+func (options *SubtitleOptions) SetMaxLineLength(maxLineLength int) *SubtitleOptions {
+	options.MaxLineLength = maxLineLength
+	return options
+}
+
+// SetMaxLines : Allow user to set MaxLines
+func (options *SubtitleOptions) SetMaxLines(maxLines int) *SubtitleOptions {
+	options.MaxLines = maxLines
+	return options
+}
+
+// SetMaxCaptionDuration : Allow user to set MaxCaptionDuration
+func (options *SubtitleOptions) SetMaxCaptionDuration(maxCaptionDuration float64) *SubtitleOptions {
+	options.MaxCaptionDuration = maxCaptionDuration
+	return options
+}
+
+// Caption : A caption of a subtitle file
+type Caption struct {
+
+	// The position of the caption in the subtitle file, starting at 1.
+	Index int
+
+	// The time in seconds at which the caption is displayed.
+	Start float64
+
+	// The time in seconds at which the caption is hidden.
+	End float64
+
+	// The lines of text of the caption.
+	Lines []string
+}
+
+// BuildCaptions : Divides the final results of a recognition into captions. The results must have been requested
+// with `timestamps` set to `true`; results without word timestamps are skipped. A caption never spans two results.
+// Nil results have no captions.
+func BuildCaptions(results *SpeechRecognitionResults, options *SubtitleOptions) []Caption {
+	if results == nil {
+		return nil
+	}
+	options = subtitleOptionsWithDefaults(options)
+
+	var captions []Caption
+	for _, result := range results.Results {
+		if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+			continue
+		}
+
+		var caption *Caption
+		for _, timestamp := range result.Alternatives[0].Timestamps {
+			if caption != nil && !captionAccepts(caption, timestamp, options) {
+				captions = append(captions, *caption)
+				caption = nil
+			}
+			if caption == nil {
+				caption = &Caption{
+					Index: len(captions) + 1,
+					Start: timestamp.Start,
+					Lines: []string{timestamp.Word},
+				}
+			} else {
+				lastLine := &caption.Lines[len(caption.Lines)-1]
+				if len(*lastLine)+1+len(timestamp.Word) <= options.MaxLineLength {
+					*lastLine += " " + timestamp.Word
+				} else {
+					caption.Lines = append(caption.Lines, timestamp.Word)
+				}
+			}
+			caption.End = timestamp.End
+		}
+		if caption != nil {
+			captions = append(captions, *caption)
+		}
+	}
+	return captions
+}
+
+// WriteSRT : Writes the final results of a recognition as a SubRip (.srt) subtitle file
+func WriteSRT(writer io.Writer, results *SpeechRecognitionResults, options *SubtitleOptions) error {
+	return WriteCaptionsSRT(writer, BuildCaptions(results, options))
+}
+
+// WriteWebVTT : Writes the final results of a recognition as a WebVTT (.vtt) subtitle file
+func WriteWebVTT(writer io.Writer, results *SpeechRecognitionResults, options *SubtitleOptions) error {
+	return WriteCaptionsWebVTT(writer, BuildCaptions(results, options))
+}
+
+// WriteCaptionsSRT : Writes captions as a SubRip (.srt) subtitle file
+func WriteCaptionsSRT(writer io.Writer, captions []Caption) error {
+	return writeCaptions(writer, "", captions, ",")
+}
+
+// WriteCaptionsWebVTT : Writes captions as a WebVTT (.vtt) subtitle file
+func WriteCaptionsWebVTT(writer io.Writer, captions []Caption) error {
+	return writeCaptions(writer, "WEBVTT\n\n", captions, ".")
+}
+
+func writeCaptions(writer io.Writer, header string, captions []Caption, separator string) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	bufferedWriter.WriteString(header)
+	for _, caption := range captions {
+		fmt.Fprintf(bufferedWriter, "%d\n%s --> %s\n%s\n\n",
+			caption.Index,
+			formatSubtitleTime(caption.Start, separator),
+			formatSubtitleTime(caption.End, separator),
+			strings.Join(caption.Lines, "\n"))
+	}
+	return bufferedWriter.Flush()
+}
+
+// captionAccepts : Reports whether the word fits in the caption without exceeding the line or duration limits
+func captionAccepts(caption *Caption, timestamp WordTimestamp, options *SubtitleOptions) bool {
+	if timestamp.End-caption.Start > options.MaxCaptionDuration {
+		return false
+	}
+	lastLine := caption.Lines[len(caption.Lines)-1]
+	if len(lastLine)+1+len(timestamp.Word) <= options.MaxLineLength {
+		return true
+	}
+	return len(caption.Lines) < options.MaxLines
+}
+
+func subtitleOptionsWithDefaults(options *SubtitleOptions) *SubtitleOptions {
+	withDefaults := NewSubtitleOptions()
+	if options == nil {
+		return withDefaults
+	}
+	if options.MaxLineLength > 0 {
+		withDefaults.MaxLineLength = options.MaxLineLength
+	}
+	if options.MaxLines > 0 {
+		withDefaults.MaxLines = options.MaxLines
+	}
+	if options.MaxCaptionDuration > 0 {
+		withDefaults.MaxCaptionDuration = options.MaxCaptionDuration
+	}
+	return withDefaults
+}
+
+// formatSubtitleTime : Formats seconds as hh:mm:ss followed by the separator and milliseconds
+func formatSubtitleTime(seconds float64, separator string) string {
+	milliseconds := int64(seconds*1000 + 0.5)
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d",
+		milliseconds/3600000,
+		milliseconds/60000%60,
+		milliseconds/1000%60,
+		separator,
+		milliseconds%1000)
+}
@@ -0,0 +1,63 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Subtitles", func() {
+	var results speechtotextv1.SpeechRecognitionResults
+	BeforeEach(func() {
+		err := json.Unmarshal([]byte(`{"results": [
+			{"final": true, "alternatives": [{"transcript": "hello world how are you",
+				"timestamps": [["hello", 0.5, 0.9], ["world", 1.0, 1.5], ["how", 1.6, 1.8], ["are", 1.8, 1.9], ["you", 1.9, 2.2]]}]},
+			{"final": false, "alternatives": [{"transcript": "interim", "timestamps": [["interim", 3.0, 3.5]]}]},
+			{"final": true, "alternatives": [{"transcript": "goodbye", "timestamps": [["goodbye", 3661.25, 3662.0]]}]}
+		]}`), &results)
+		Expect(err).To(BeNil())
+	})
+	Describe("BuildCaptions(results *SpeechRecognitionResults, options *SubtitleOptions)", func() {
+		It("Splits captions by line length and line count", func() {
+			options := speechtotextv1.NewSubtitleOptions().SetMaxLineLength(11).SetMaxLines(1)
+			captions := speechtotextv1.BuildCaptions(&results, options)
+			Expect(captions).To(HaveLen(3))
+			Expect(captions[0].Lines).To(Equal([]string{"hello world"}))
+			Expect(captions[1].Lines).To(Equal([]string{"how are you"}))
+			Expect(captions[1].Start).To(Equal(1.6))
+			Expect(captions[1].End).To(Equal(2.2))
+			Expect(captions[2].Index).To(Equal(3))
+		})
+		It("Splits captions by duration", func() {
+			options := speechtotextv1.NewSubtitleOptions().SetMaxCaptionDuration(1.0)
+			captions := speechtotextv1.BuildCaptions(&results, options)
+			Expect(captions).To(HaveLen(3))
+			Expect(captions[0].Lines).To(Equal([]string{"hello world"}))
+		})
+		It("Builds no captions for nil results", func() {
+			Expect(speechtotextv1.BuildCaptions(nil, nil)).To(BeEmpty())
+
+			var buffer bytes.Buffer
+			Expect(speechtotextv1.WriteWebVTT(&buffer, nil, nil)).To(Succeed())
+			Expect(buffer.String()).To(Equal("WEBVTT\n\n"))
+		})
+	})
+	Describe("WriteSRT(writer io.Writer, results *SpeechRecognitionResults, options *SubtitleOptions)", func() {
+		It("Writes SubRip captions", func() {
+			var buffer bytes.Buffer
+			Expect(speechtotextv1.WriteSRT(&buffer, &results, nil)).To(Succeed())
+			Expect(buffer.String()).To(Equal("1\n00:00:00,500 --> 00:00:02,200\nhello world how are you\n\n" +
+				"2\n01:01:01,250 --> 01:01:02,000\ngoodbye\n\n"))
+		})
+	})
+	Describe("WriteWebVTT(writer io.Writer, results *SpeechRecognitionResults, options *SubtitleOptions)", func() {
+		It("Writes WebVTT captions", func() {
+			var buffer bytes.Buffer
+			Expect(speechtotextv1.WriteWebVTT(&buffer, &results, nil)).To(Succeed())
+			Expect(buffer.String()).To(HavePrefix("WEBVTT\n\n1\n00:00:00.500 --> 00:00:02.200\n"))
+		})
+	})
+})
@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"strings"
+)
+
+// TranscriptDelta : The change of a live transcript from one message of interim results to the next. The transcript
+// changes only at its end: the characters after Unchanged are replaced by Text. Lengths are counted in characters
+// (runes), not bytes.
+type TranscriptDelta struct {
+
+	// The number of characters at the start of the previous transcript that are unchanged.
+	Unchanged int
+
+	// The number of characters of the previous transcript, after the unchanged characters, that are removed.
+	Removed int
+
+	// The text that is appended after the unchanged characters.
+	Text string
+
+	// The number of characters at the start of the transcript that are final and will not change.
+	Final int
+}
+
+// Apply : Returns the transcript with the delta applied to it
+func (delta TranscriptDelta) Apply(transcript string) string {
+	runes := []rune(transcript)
+	if delta.Unchanged < len(runes) {
+		runes = runes[:delta.Unchanged]
+	}
+	return string(runes) + delta.Text
+}
+
+// TranscriptDiffer : Compares successive messages of interim results, as received by RecognizeUsingWebsocket, and
+// returns only the part of the transcript that changed. The transcript is the concatenation of the best alternative
+// of each result segment.
+type TranscriptDiffer struct {
+	segments   []string
+	final      []bool
+	transcript []rune
+}
+
+// NewTranscriptDiffer : Instantiate TranscriptDiffer
+func NewTranscriptDiffer() *TranscriptDiffer {
+	return &TranscriptDiffer{}
+}
+
+// Update : Applies a message of results to the transcript and returns the change. The message replaces the result
+// segments from its `result_index` on. Returns `false` if the transcript did not change.
+func (differ *TranscriptDiffer) Update(results *SpeechRecognitionResults) (TranscriptDelta, bool) {
+	if results == nil {
+		return TranscriptDelta{Unchanged: len(differ.transcript), Final: differ.finalLength()}, false
+	}
+
+	resultIndex := 0
+	if results.ResultIndex != nil {
+		resultIndex = int(*results.ResultIndex)
+	}
+	for i, result := range results.Results {
+		index := resultIndex + i
+		for len(differ.segments) <= index {
+			differ.segments = append(differ.segments, "")
+			differ.final = append(differ.final, false)
+		}
+		differ.segments[index] = ""
+		if len(result.Alternatives) > 0 {
+			differ.segments[index] = stringOrEmpty(result.Alternatives[0].Transcript)
+		}
+		differ.final[index] = result.Final != nil && *result.Final
+	}
+
+	transcript := []rune(strings.Join(differ.segments, ""))
+	unchanged := 0
+	for unchanged < len(transcript) && unchanged < len(differ.transcript) && transcript[unchanged] == differ.transcript[unchanged] {
+		unchanged++
+	}
+	delta := TranscriptDelta{
+		Unchanged: unchanged,
+		Removed:   len(differ.transcript) - unchanged,
+		Text:      string(transcript[unchanged:]),
+	}
+	differ.transcript = transcript
+	delta.Final = differ.finalLength()
+	return delta, delta.Removed > 0 || delta.Text != ""
+}
+
+// Transcript : Returns the current transcript
+func (differ *TranscriptDiffer) Transcript() string {
+	return string(differ.transcript)
+}
+
+// Reset : Clears the transcript, for example before a new recognition
+func (differ *TranscriptDiffer) Reset() {
+	differ.segments = nil
+	differ.final = nil
+	differ.transcript = nil
+}
+
+// finalLength : Returns the number of characters of the transcript in the leading final result segments
+func (differ *TranscriptDiffer) finalLength() int {
+	length := 0
+	for i, segment := range differ.segments {
+		if !differ.final[i] {
+			break
+		}
+		length += len([]rune(segment))
+	}
+	return length
+}
@@ -0,0 +1,47 @@
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TranscriptDiffer", func() {
+	update := func(differ *speechtotextv1.TranscriptDiffer, message string) (speechtotextv1.TranscriptDelta, bool) {
+		var results speechtotextv1.SpeechRecognitionResults
+		Expect(json.Unmarshal([]byte(message), &results)).To(Succeed())
+		return differ.Update(&results)
+	}
+
+	It("Succeed to call Update", func() {
+		differ := speechtotextv1.NewTranscriptDiffer()
+		transcript := ""
+
+		delta, changed := update(differ, `{"result_index": 0, "results": [{"final": false, "alternatives": [{"transcript": "the cat "}]}]}`)
+		Expect(changed).To(BeTrue())
+		Expect(delta).To(Equal(speechtotextv1.TranscriptDelta{Text: "the cat "}))
+		transcript = delta.Apply(transcript)
+
+		delta, changed = update(differ, `{"result_index": 0, "results": [{"final": false, "alternatives": [{"transcript": "the cap sat "}]}]}`)
+		Expect(changed).To(BeTrue())
+		Expect(delta).To(Equal(speechtotextv1.TranscriptDelta{Unchanged: 6, Removed: 2, Text: "p sat "}))
+		transcript = delta.Apply(transcript)
+
+		delta, changed = update(differ, `{"result_index": 0, "results": [{"final": true, "alternatives": [{"transcript": "the cap sat "}]}]}`)
+		Expect(changed).To(BeFalse())
+		Expect(delta.Final).To(Equal(12))
+
+		delta, changed = update(differ, `{"result_index": 1, "results": [{"final": false, "alternatives": [{"transcript": "down "}]}]}`)
+		Expect(changed).To(BeTrue())
+		Expect(delta).To(Equal(speechtotextv1.TranscriptDelta{Unchanged: 12, Text: "down ", Final: 12}))
+		transcript = delta.Apply(transcript)
+
+		Expect(transcript).To(Equal("the cap sat down "))
+		Expect(differ.Transcript()).To(Equal(transcript))
+
+		differ.Reset()
+		Expect(differ.Transcript()).To(Equal(""))
+	})
+})
@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// TranscriptEvaluation : The accuracy of a transcript measured against a reference transcript
+type TranscriptEvaluation struct {
+
+	// The number of words in the reference transcript.
+	ReferenceWords int
+
+	// The number of reference words that were recognized as other words.
+	Substitutions int
+
+	// The number of words that were recognized but are not in the reference transcript.
+	Insertions int
+
+	// The number of reference words that were not recognized.
+	Deletions int
+
+	// The word error rate: the substitutions, insertions, and deletions divided by the number of reference words.
+	WordErrorRate float64
+
+	// For each keyword that occurs in the reference transcript, the fraction of its occurrences that were recognized.
+	KeywordRecall map[string]float64
+}
+
+// String : Summarizes the evaluation on one line
+func (evaluation *TranscriptEvaluation) String() string {
+	return fmt.Sprintf("WER %.2f%% (%d substitutions, %d insertions, %d deletions in %d words)",
+		100*evaluation.WordErrorRate, evaluation.Substitutions, evaluation.Insertions, evaluation.Deletions, evaluation.ReferenceWords)
+}
+
+// EvaluateTranscript : Compares a transcript with a reference transcript
+// Both transcripts are normalized with NormalizeTranscript before they are aligned word by word. The recall of each
+// keyword, which can be a phrase, is the number of its occurrences in the transcript, up to the number in the
+// reference, divided by the number in the reference.
+func EvaluateTranscript(transcript string, reference string, keywords []string) *TranscriptEvaluation {
+	hypothesisWords := NormalizeTranscript(transcript)
+	referenceWords := NormalizeTranscript(reference)
+
+	evaluation := &TranscriptEvaluation{ReferenceWords: len(referenceWords)}
+	evaluation.Substitutions, evaluation.Insertions, evaluation.Deletions = alignWords(hypothesisWords, referenceWords)
+	errors := evaluation.Substitutions + evaluation.Insertions + evaluation.Deletions
+	if len(referenceWords) > 0 {
+		evaluation.WordErrorRate = float64(errors) / float64(len(referenceWords))
+	} else if errors > 0 {
+		evaluation.WordErrorRate = 1
+	}
+
+	evaluation.KeywordRecall = map[string]float64{}
+	for _, keyword := range keywords {
+		keywordWords := NormalizeTranscript(keyword)
+		inReference := countPhrase(referenceWords, keywordWords)
+		if inReference == 0 {
+			continue
+		}
+		recognized := countPhrase(hypothesisWords, keywordWords)
+		if recognized > inReference {
+			recognized = inReference
+		}
+		evaluation.KeywordRecall[keyword] = float64(recognized) / float64(inReference)
+	}
+	return evaluation
+}
+
+// EvaluateRecognitionResults : Compares the best alternatives of the final results with a reference transcript. Use
+// it to measure the benefit of a custom model by evaluating the results of the same audio before and after training.
+func EvaluateRecognitionResults(results *SpeechRecognitionResults, reference string, keywords []string) *TranscriptEvaluation {
+	var transcripts []string
+	if results != nil {
+		for _, result := range results.Results {
+			if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+				continue
+			}
+			transcripts = append(transcripts, stringOrEmpty(result.Alternatives[0].Transcript))
+		}
+	}
+	return EvaluateTranscript(strings.Join(transcripts, " "), reference, keywords)
+}
+
+// NormalizeTranscript : Splits a transcript into lowercase words, without punctuation and hesitation markers, so
+// that formatting differences are not counted as errors. Apostrophes within words are kept.
+func NormalizeTranscript(transcript string) []string {
+	cleaned := hesitationMarker.ReplaceAllString(transcript, " ")
+	words := strings.FieldsFunc(strings.ToLower(cleaned), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+	normalized := words[:0]
+	for _, word := range words {
+		if word = strings.Trim(word, "'"); word != "" {
+			normalized = append(normalized, word)
+		}
+	}
+	return normalized
+}
+
+// alignWords : Counts the substitutions, insertions, and deletions of the minimum edit distance alignment
+func alignWords(hypothesis []string, reference []string) (substitutions int, insertions int, deletions int) {
+	type cell struct {
+		cost, substitutions, insertions, deletions int
+	}
+	previous := make([]cell, len(hypothesis)+1)
+	current := make([]cell, len(hypothesis)+1)
+	for j := range previous {
+		previous[j] = cell{cost: j, insertions: j}
+	}
+	for i := 1; i <= len(reference); i++ {
+		current[0] = cell{cost: i, deletions: i}
+		for j := 1; j <= len(hypothesis); j++ {
+			if reference[i-1] == hypothesis[j-1] {
+				current[j] = previous[j-1]
+				continue
+			}
+			best := previous[j-1]
+			best.substitutions++
+			if deletion := previous[j]; deletion.cost < best.cost {
+				best = deletion
+				best.deletions++
+			}
+			if insertion := current[j-1]; insertion.cost < best.cost {
+				best = insertion
+				best.insertions++
+			}
+			best.cost++
+			current[j] = best
+		}
+		previous, current = current, previous
+	}
+	last := previous[len(hypothesis)]
+	return last.substitutions, last.insertions, last.deletions
+}
+
+// countPhrase : Counts the non-overlapping occurrences of the phrase in the words
+func countPhrase(words []string, phrase []string) int {
+	if len(phrase) == 0 {
+		return 0
+	}
+	count := 0
+	for i := 0; i+len(phrase) <= len(words); {
+		matches := true
+		for j, word := range phrase {
+			if words[i+j] != word {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			count++
+			i += len(phrase)
+		} else {
+			i++
+		}
+	}
+	return count
+}
@@ -0,0 +1,42 @@
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TranscriptEvaluation", func() {
+	Describe("EvaluateTranscript(transcript string, reference string, keywords []string)", func() {
+		It("Counts substitutions, insertions, and deletions", func() {
+			evaluation := speechtotextv1.EvaluateTranscript(
+				"the quick brown fox %HESITATION jumped over a lazy lazy dog",
+				"The quick brown fox jumps over the lazy dog.",
+				[]string{"brown fox", "jumps", "cat"})
+			Expect(evaluation.ReferenceWords).To(Equal(9))
+			Expect(evaluation.Substitutions).To(Equal(2))
+			Expect(evaluation.Insertions).To(Equal(1))
+			Expect(evaluation.Deletions).To(Equal(0))
+			Expect(evaluation.WordErrorRate).To(BeNumerically("~", 3.0/9, 0.0001))
+			Expect(evaluation.KeywordRecall).To(Equal(map[string]float64{"brown fox": 1, "jumps": 0}))
+		})
+		It("Counts deletions", func() {
+			evaluation := speechtotextv1.EvaluateTranscript("it's done", "It's all done now", nil)
+			Expect(evaluation.Deletions).To(Equal(2))
+			Expect(evaluation.WordErrorRate).To(Equal(0.5))
+		})
+	})
+	Describe("EvaluateRecognitionResults(results *SpeechRecognitionResults, reference string, keywords []string)", func() {
+		It("Evaluates the final results", func() {
+			var results speechtotextv1.SpeechRecognitionResults
+			Expect(json.Unmarshal([]byte(`{"results": [
+				{"final": true, "alternatives": [{"transcript": "hello world "}]},
+				{"final": false, "alternatives": [{"transcript": "interim "}]}
+			]}`), &results)).To(Succeed())
+			evaluation := speechtotextv1.EvaluateRecognitionResults(&results, "Hello, world!", nil)
+			Expect(evaluation.WordErrorRate).To(Equal(0.0))
+		})
+	})
+})
@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"sort"
+)
+
+// TranscriptPart : The results of one file of a recording that was transcribed in several files, such as the hourly
+// files of one meeting
+type TranscriptPart struct {
+
+	// The results of the file.
+	Results *SpeechRecognitionResults
+
+	// The time in seconds at which the file starts in the recording.
+	Offset float64
+}
+
+// ConsecutiveTranscriptParts : Returns the parts of a recording whose files follow each other without gaps, given the
+// duration in seconds of each file. If a duration is missing or zero, the file is taken to end at the last time in its
+// results.
+func ConsecutiveTranscriptParts(results []*SpeechRecognitionResults, durations []float64) []TranscriptPart {
+	parts := make([]TranscriptPart, len(results))
+	offset := 0.0
+	for i, result := range results {
+		parts[i] = TranscriptPart{Results: result, Offset: offset}
+		if i < len(durations) && durations[i] > 0 {
+			offset += durations[i]
+		} else {
+			offset += resultsEndTime(result)
+		}
+	}
+	return parts
+}
+
+// MergeTranscripts : Combines the results of the parts of a recording into one continuous SpeechRecognitionResults
+// The parts are ordered by offset, and the times of words, keywords, word alternatives, and speaker labels are shifted
+// by the offset of their part, as by StitchRecognitionResults. If the files overlap, the results and speaker labels of
+// a part that start before the end of the previous parts are dropped, so that the overlapping speech appears once.
+// Results without word timestamps are never dropped.
+func MergeTranscripts(parts []TranscriptPart) *SpeechRecognitionResults {
+	sorted := append([]TranscriptPart(nil), parts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Offset < sorted[j].Offset
+	})
+
+	segments := make([]AudioSegment, len(sorted))
+	results := make([]*SpeechRecognitionResults, len(sorted))
+	end := 0.0
+	for i, part := range sorted {
+		segments[i] = AudioSegment{Offset: part.Offset}
+		if part.Results == nil {
+			continue
+		}
+
+		trimmed := *part.Results
+		trimmed.Results = nil
+		for _, result := range part.Results.Results {
+			if start, ok := resultStartTime(result); ok && i > 0 && part.Offset+start < end {
+				continue
+			}
+			trimmed.Results = append(trimmed.Results, result)
+		}
+		trimmed.SpeakerLabels = nil
+		for _, speakerLabel := range part.Results.SpeakerLabels {
+			if speakerLabel.From != nil && i > 0 && part.Offset+float64(*speakerLabel.From) < end {
+				continue
+			}
+			trimmed.SpeakerLabels = append(trimmed.SpeakerLabels, speakerLabel)
+		}
+		results[i] = &trimmed
+
+		if partEnd := part.Offset + resultsEndTime(&trimmed); partEnd > end {
+			end = partEnd
+		}
+	}
+	return StitchRecognitionResults(segments, results)
+}
+
+// resultStartTime : Returns the start time of the first word of the best alternative of a result
+func resultStartTime(result SpeechRecognitionResult) (float64, bool) {
+	if len(result.Alternatives) == 0 || len(result.Alternatives[0].Timestamps) == 0 {
+		return 0, false
+	}
+	return result.Alternatives[0].Timestamps[0].Start, true
+}
+
+// resultsEndTime : Returns the latest end time of the words and speaker labels of the results
+func resultsEndTime(results *SpeechRecognitionResults) float64 {
+	end := 0.0
+	if results == nil {
+		return end
+	}
+	for _, result := range results.Results {
+		for _, alternative := range result.Alternatives {
+			for _, timestamp := range alternative.Timestamps {
+				if timestamp.End > end {
+					end = timestamp.End
+				}
+			}
+		}
+	}
+	for _, speakerLabel := range results.SpeakerLabels {
+		if speakerLabel.To != nil && float64(*speakerLabel.To) > end {
+			end = float64(*speakerLabel.To)
+		}
+	}
+	return end
+}
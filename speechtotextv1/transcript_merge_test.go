@@ -0,0 +1,51 @@
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TranscriptMerge", func() {
+	var first, second speechtotextv1.SpeechRecognitionResults
+	BeforeEach(func() {
+		Expect(json.Unmarshal([]byte(`{"results": [
+			{"final": true, "alternatives": [{"transcript": "good morning ", "timestamps": [["good", 1.0, 1.4], ["morning", 1.4, 2.0]]}]},
+			{"final": true, "alternatives": [{"transcript": "everyone ", "timestamps": [["everyone", 8.0, 9.0]]}]}],
+			"speaker_labels": [{"from": 1.0, "to": 2.0, "speaker": 0, "confidence": 0.9, "final": true}]}`), &first)).To(Succeed())
+		Expect(json.Unmarshal([]byte(`{"results": [
+			{"final": true, "alternatives": [{"transcript": "everyone ", "timestamps": [["everyone", 0.0, 1.0]]}]},
+			{"final": true, "alternatives": [{"transcript": "let's begin ", "timestamps": [["let's", 3.0, 3.5], ["begin", 3.5, 4.0]]}]}]}`), &second)).To(Succeed())
+	})
+
+	Describe("MergeTranscripts(parts []TranscriptPart)", func() {
+		It("Succeed to call MergeTranscripts", func() {
+			merged := speechtotextv1.MergeTranscripts([]speechtotextv1.TranscriptPart{
+				{Results: &second, Offset: 8.0},
+				{Results: &first, Offset: 0.0},
+			})
+			Expect(merged.Results).To(HaveLen(3))
+			Expect(*merged.Results[1].Alternatives[0].Transcript).To(Equal("everyone "))
+			Expect(merged.Results[1].Alternatives[0].Timestamps[0].Start).To(Equal(8.0))
+			Expect(*merged.Results[2].Alternatives[0].Transcript).To(Equal("let's begin "))
+			Expect(merged.Results[2].Alternatives[0].Timestamps[0].Start).To(Equal(11.0))
+			Expect(merged.SpeakerLabels).To(HaveLen(1))
+		})
+	})
+
+	Describe("ConsecutiveTranscriptParts(results []*SpeechRecognitionResults, durations []float64)", func() {
+		It("Succeed to call ConsecutiveTranscriptParts", func() {
+			parts := speechtotextv1.ConsecutiveTranscriptParts([]*speechtotextv1.SpeechRecognitionResults{&first, &second, &first}, []float64{3600, 0})
+			Expect(parts).To(HaveLen(3))
+			Expect(parts[0].Offset).To(Equal(0.0))
+			Expect(parts[1].Offset).To(Equal(3600.0))
+			Expect(parts[2].Offset).To(Equal(3604.0))
+
+			merged := speechtotextv1.MergeTranscripts(parts)
+			Expect(merged.Results).To(HaveLen(6))
+			Expect(merged.Results[5].Alternatives[0].Timestamps[0].Start).To(Equal(3612.0))
+		})
+	})
+})
@@ -0,0 +1,250 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/edwindvinas/go-sdk/languagetranslatorv3"
+)
+
+// MAX_TRANSLATE_REQUEST_SIZE is the largest amount of text, in bytes, that a TranslationPipeline sends with each
+// request to the Language Translator service.
+const MAX_TRANSLATE_REQUEST_SIZE = 50 * 1024
+
+// TranslationPipeline : Recognizes audio and translates the transcripts with the Language Translator service
+// The language pair is selected with ModelID, or with Source and Target. If SubtitleOptions is set, the captions of
+// the results are also translated, so that translated subtitles keep the timing of the speech.
+type TranslationPipeline struct {
+
+	// The service that recognizes the audio.
+	SpeechToText *SpeechToTextV1
+
+	// The service that translates the transcripts.
+	LanguageTranslator *languagetranslatorv3.LanguageTranslatorV3
+
+	// The model to use for translation, such as `en-es`.
+	ModelID *string
+
+	// The language of the transcripts, such as `en`.
+	Source *string
+
+	// The language to translate the transcripts into, such as `es`.
+	Target *string
+
+	// If set, the captions built from the results with these options are translated. The results must have been
+	// requested with `timestamps` set to `true`.
+	SubtitleOptions *SubtitleOptions
+
+	// Allows users to set headers on the translation requests to be GDPR compliant
+	Headers map[string]string
+}
+
+// NewTranslationPipeline : Instantiate TranslationPipeline
+func NewTranslationPipeline(speechToText *SpeechToTextV1, languageTranslator *languagetranslatorv3.LanguageTranslatorV3) *TranslationPipeline {
+	return &TranslationPipeline{
+		SpeechToText:       speechToText,
+		LanguageTranslator: languageTranslator,
+	}
+}
+
+// SetModelID : Allow user to set ModelID
+func (pipeline *TranslationPipeline) SetModelID(modelID string) *TranslationPipeline {
+	pipeline.ModelID = core.StringPtr(modelID)
+	return pipeline
+}
+
+// SetSource : Allow user to set Source
+func (pipeline *TranslationPipeline) SetSource(source string) *TranslationPipeline {
+	pipeline.Source = core.StringPtr(source)
+	return pipeline
+}
+
+// SetTarget : Allow user to set Target
+func (pipeline *TranslationPipeline) SetTarget(target string) *TranslationPipeline {
+	pipeline.Target = core.StringPtr(target)
+	return pipeline
+}
+
+// SetSubtitleOptions : Allow user to set SubtitleOptions
+func (pipeline *TranslationPipeline) SetSubtitleOptions(subtitleOptions *SubtitleOptions) *TranslationPipeline {
+	pipeline.SubtitleOptions = subtitleOptions
+	return pipeline
+}
+
+// SetHeaders : Allow user to set Headers
+func (pipeline *TranslationPipeline) SetHeaders(param map[string]string) *TranslationPipeline {
+	pipeline.Headers = param
+	return pipeline
+}
+
+// TranslatedTranscript : The outcome of a TranslationPipeline
+type TranslatedTranscript struct {
+
+	// The recognition results.
+	Results *SpeechRecognitionResults
+
+	// The transcript of each final result.
+	Transcripts []string
+
+	// The translation of each transcript.
+	Translations []string
+
+	// The translated captions, if SubtitleOptions of the pipeline is set. Each caption keeps the times of the words it
+	// translates.
+	Captions []Caption
+}
+
+// Text : The translations, separated by newlines
+func (transcript *TranslatedTranscript) Text() string {
+	return strings.Join(transcript.Translations, "\n")
+}
+
+// WriteSRT : Writes the translated captions as a SubRip (.srt) subtitle file
+func (transcript *TranslatedTranscript) WriteSRT(writer io.Writer) error {
+	return WriteCaptionsSRT(writer, transcript.Captions)
+}
+
+// WriteWebVTT : Writes the translated captions as a WebVTT (.vtt) subtitle file
+func (transcript *TranslatedTranscript) WriteWebVTT(writer io.Writer) error {
+	return WriteCaptionsWebVTT(writer, transcript.Captions)
+}
+
+// Recognize : Recognize audio and translate the transcripts
+func (pipeline *TranslationPipeline) Recognize(recognizeOptions *RecognizeOptions) (*TranslatedTranscript, error) {
+	return pipeline.RecognizeWithContext(context.Background(), recognizeOptions)
+}
+
+// RecognizeWithContext is an alternate form of the Recognize method which supports a Context parameter
+func (pipeline *TranslationPipeline) RecognizeWithContext(ctx context.Context, recognizeOptions *RecognizeOptions) (*TranslatedTranscript, error) {
+	results, _, err := pipeline.SpeechToText.RecognizeWithContext(ctx, recognizeOptions)
+	if err != nil {
+		return nil, err
+	}
+	return pipeline.TranslateWithContext(ctx, results)
+}
+
+// Translate : Translate the transcripts of recognition results, such as the results of a completed job
+func (pipeline *TranslationPipeline) Translate(results *SpeechRecognitionResults) (*TranslatedTranscript, error) {
+	return pipeline.TranslateWithContext(context.Background(), results)
+}
+
+// TranslateWithContext is an alternate form of the Translate method which supports a Context parameter
+func (pipeline *TranslationPipeline) TranslateWithContext(ctx context.Context, results *SpeechRecognitionResults) (*TranslatedTranscript, error) {
+	err := core.ValidateNotNil(results, "results cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+
+	transcript := &TranslatedTranscript{Results: results}
+	for _, result := range results.Results {
+		if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+			continue
+		}
+		text := strings.TrimSpace(hesitationMarker.ReplaceAllString(stringOrEmpty(result.Alternatives[0].Transcript), ""))
+		transcript.Transcripts = append(transcript.Transcripts, strings.Join(strings.Fields(text), " "))
+	}
+	transcript.Translations, err = pipeline.translateTexts(ctx, transcript.Transcripts)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipeline.SubtitleOptions != nil {
+		captions := BuildCaptions(results, pipeline.SubtitleOptions)
+		captionTexts := make([]string, len(captions))
+		for i, caption := range captions {
+			captionTexts[i] = strings.TrimSpace(hesitationMarker.ReplaceAllString(strings.Join(caption.Lines, " "), ""))
+		}
+		captionTranslations, err := pipeline.translateTexts(ctx, captionTexts)
+		if err != nil {
+			return nil, err
+		}
+		maxLineLength := subtitleOptionsWithDefaults(pipeline.SubtitleOptions).MaxLineLength
+		for i, caption := range captions {
+			caption.Lines = wrapCaptionText(captionTranslations[i], maxLineLength)
+			transcript.Captions = append(transcript.Captions, caption)
+		}
+	}
+	return transcript, nil
+}
+
+// translateTexts : Translates the texts in order, sending as many with each request as MAX_TRANSLATE_REQUEST_SIZE
+// allows. Empty texts are not sent.
+func (pipeline *TranslationPipeline) translateTexts(ctx context.Context, texts []string) ([]string, error) {
+	translations := make([]string, len(texts))
+	var batch []string
+	var batchIndexes []int
+	batchSize := 0
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		translateOptions := pipeline.LanguageTranslator.NewTranslateOptions(batch)
+		translateOptions.ModelID = pipeline.ModelID
+		translateOptions.Source = pipeline.Source
+		translateOptions.Target = pipeline.Target
+		translateOptions.Headers = pipeline.Headers
+		result, _, err := pipeline.LanguageTranslator.TranslateWithContext(ctx, translateOptions)
+		if err != nil {
+			return err
+		}
+		if len(result.Translations) != len(batch) {
+			return fmt.Errorf("the service returned %d translations for %d texts", len(result.Translations), len(batch))
+		}
+		for i, translation := range result.Translations {
+			translations[batchIndexes[i]] = stringOrEmpty(translation.Translation)
+		}
+		batch, batchIndexes, batchSize = nil, nil, 0
+		return nil
+	}
+
+	for i, text := range texts {
+		if text == "" {
+			continue
+		}
+		if batchSize > 0 && batchSize+len(text) > MAX_TRANSLATE_REQUEST_SIZE {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+		batch = append(batch, text)
+		batchIndexes = append(batchIndexes, i)
+		batchSize += len(text)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+// wrapCaptionText : Divides text into lines of at most maxLineLength characters at spaces. A word that is longer
+// than maxLineLength is placed on a line of its own.
+func wrapCaptionText(text string, maxLineLength int) []string {
+	var lines []string
+	for _, word := range strings.Fields(text) {
+		if len(lines) > 0 && len(lines[len(lines)-1])+1+len(word) <= maxLineLength {
+			lines[len(lines)-1] += " " + word
+		} else {
+			lines = append(lines, word)
+		}
+	}
+	return lines
+}
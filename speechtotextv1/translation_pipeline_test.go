@@ -0,0 +1,98 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/languagetranslatorv3"
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TranslationPipeline", func() {
+	Describe("Recognize(recognizeOptions *RecognizeOptions)", func() {
+		Context("Successfully - Recognize audio and translate the transcripts and captions", func() {
+			dictionary := map[string]string{
+				"good morning everyone": "buenos dias a todos",
+				"good morning":          "buenos dias",
+				"everyone":              "a todos",
+				"thank you":             "gracias",
+			}
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.Path {
+				case "/v1/recognize":
+					fmt.Fprint(res, `{"results": [
+						{"final": true, "alternatives": [{"transcript": "good morning %HESITATION everyone ",
+							"timestamps": [["good", 0.0, 0.3], ["morning", 0.3, 0.8], ["%HESITATION", 0.8, 1.0], ["everyone", 1.0, 1.5]]}]},
+						{"final": true, "alternatives": [{"transcript": "thank you ",
+							"timestamps": [["thank", 2.0, 2.3], ["you", 2.3, 2.5]]}]}]}`)
+				case "/v3/translate":
+					Expect(req.URL.Query().Get("version")).To(Equal("2018-05-01"))
+					var body struct {
+						Text    []string `json:"text"`
+						ModelID string   `json:"model_id"`
+					}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(BeNil())
+					Expect(body.ModelID).To(Equal("en-es"))
+					var translations []string
+					for _, text := range body.Text {
+						translations = append(translations, fmt.Sprintf(`{"translation": "%s"}`, dictionary[text]))
+					}
+					fmt.Fprintf(res, `{"word_count": 1, "character_count": 1, "translations": [%s]}`, strings.Join(translations, ","))
+				default:
+					Fail("unexpected request " + req.URL.Path)
+				}
+			}))
+			It("Succeed to call Recognize", func() {
+				defer testServer.Close()
+
+				speechToText, speechToTextErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(speechToTextErr).To(BeNil())
+				languageTranslator, languageTranslatorErr := languagetranslatorv3.NewLanguageTranslatorV3(&languagetranslatorv3.LanguageTranslatorV3Options{
+					URL:     testServer.URL,
+					Version: "2018-05-01",
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(languageTranslatorErr).To(BeNil())
+
+				pipeline := speechtotextv1.NewTranslationPipeline(speechToText, languageTranslator).
+					SetModelID("en-es").
+					SetSubtitleOptions(speechtotextv1.NewSubtitleOptions().SetMaxCaptionDuration(1.0))
+				recognizeOptions := speechToText.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader("audio"))).
+					SetContentType("audio/wav").
+					SetTimestamps(true)
+				transcript, err := pipeline.Recognize(recognizeOptions)
+				Expect(err).To(BeNil())
+				Expect(transcript.Transcripts).To(Equal([]string{"good morning everyone", "thank you"}))
+				Expect(transcript.Text()).To(Equal("buenos dias a todos\ngracias"))
+				Expect(transcript.Captions).To(HaveLen(3))
+
+				var srt bytes.Buffer
+				Expect(transcript.WriteSRT(&srt)).To(BeNil())
+				Expect(srt.String()).To(Equal("1\n00:00:00,000 --> 00:00:01,000\nbuenos dias\n\n" +
+					"2\n00:00:01,000 --> 00:00:01,500\na todos\n\n" +
+					"3\n00:00:02,000 --> 00:00:02,500\ngracias\n\n"))
+			})
+		})
+	})
+})
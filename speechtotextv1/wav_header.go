@@ -0,0 +1,237 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Encodings of the samples of WAV audio, as stored in the format chunk.
+const (
+	WAV_ENCODING_PCM        = 1
+	WAV_ENCODING_FLOAT      = 3
+	WAV_ENCODING_ALAW       = 6
+	WAV_ENCODING_MULAW      = 7
+	WAV_ENCODING_EXTENSIBLE = 0xFFFE
+)
+
+// Minimum sampling rates of the audio for broadband and narrowband models.
+const (
+	BROADBAND_MIN_SAMPLE_RATE  = 16000
+	NARROWBAND_MIN_SAMPLE_RATE = 8000
+)
+
+// maxWAVHeaderSize bounds the chunks that are read before the sample data
+const maxWAVHeaderSize = 1 << 20
+
+// WAVHeader : The format of WAV audio, read from its RIFF header
+type WAVHeader struct {
+
+	// The encoding of the samples, such as WAV_ENCODING_PCM.
+	Encoding int
+
+	// The number of channels.
+	Channels int
+
+	// The sampling rate in Hz.
+	SampleRate int
+
+	// The number of bits of each sample.
+	BitsPerSample int
+
+	// The size of the sample data in bytes, or -1 if it is unknown, as for streamed audio.
+	DataSize int64
+
+	// The number of bytes that precede the sample data.
+	HeaderSize int64
+}
+
+// ReadWAVHeader : Reads the RIFF header of WAV audio, up to the start of the sample data
+func ReadWAVHeader(reader io.Reader) (*WAVHeader, error) {
+	header, _, err := readWAVHeader(reader)
+	return header, err
+}
+
+// readWAVHeader : Reads the RIFF header of WAV audio, and returns the bytes that were read
+func readWAVHeader(reader io.Reader) (*WAVHeader, []byte, error) {
+	var consumed bytes.Buffer
+	read := func(size int) ([]byte, error) {
+		if consumed.Len()+size > maxWAVHeaderSize {
+			return nil, fmt.Errorf("the WAV header is larger than %d bytes", maxWAVHeaderSize)
+		}
+		chunk := make([]byte, size)
+		bytesRead, err := io.ReadFull(reader, chunk)
+		consumed.Write(chunk[:bytesRead])
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = fmt.Errorf("the WAV header is truncated")
+		}
+		return chunk, err
+	}
+
+	riff, err := read(12)
+	if err != nil {
+		return nil, consumed.Bytes(), err
+	}
+	if string(riff[0:4]) != "RIFF" || string(riff[8:12]) != "WAVE" {
+		return nil, consumed.Bytes(), fmt.Errorf("the audio is not WAV audio")
+	}
+
+	var header *WAVHeader
+	for {
+		chunkHeader, err := read(8)
+		if err != nil {
+			return nil, consumed.Bytes(), err
+		}
+		chunkID := string(chunkHeader[0:4])
+		chunkSize := int64(binary.LittleEndian.Uint32(chunkHeader[4:]))
+		switch chunkID {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, consumed.Bytes(), fmt.Errorf("the WAV format chunk is truncated")
+			}
+			body, err := read(int(chunkSize + chunkSize%2))
+			if err != nil {
+				return nil, consumed.Bytes(), err
+			}
+			header = &WAVHeader{
+				Encoding:      int(binary.LittleEndian.Uint16(body[0:])),
+				Channels:      int(binary.LittleEndian.Uint16(body[2:])),
+				SampleRate:    int(binary.LittleEndian.Uint32(body[4:])),
+				BitsPerSample: int(binary.LittleEndian.Uint16(body[14:])),
+			}
+		case "data":
+			if header == nil {
+				return nil, consumed.Bytes(), fmt.Errorf("the WAV data chunk precedes the format chunk")
+			}
+			header.DataSize = chunkSize
+			// Streamed WAV files can have an unknown data size
+			if chunkSize == 0 || chunkSize == 0xFFFFFFFF {
+				header.DataSize = -1
+			}
+			header.HeaderSize = int64(consumed.Len())
+			return header, consumed.Bytes(), nil
+		default:
+			if _, err := read(int(chunkSize + chunkSize%2)); err != nil {
+				return nil, consumed.Bytes(), err
+			}
+		}
+	}
+}
+
+// RawAudioFormat : The format of the sample data without the header, for 16-bit PCM, A-law, and mu-law audio
+func (header *WAVHeader) RawAudioFormat() (AudioFormat, error) {
+	switch {
+	case (header.Encoding == WAV_ENCODING_PCM || header.Encoding == WAV_ENCODING_EXTENSIBLE) && header.BitsPerSample == 16:
+		return NewAudioFormat("audio/l16").
+			WithRate(header.SampleRate).
+			WithChannels(header.Channels).
+			WithEndianness(ENDIANNESS_LITTLE_ENDIAN), nil
+	case header.Encoding == WAV_ENCODING_ALAW && header.Channels == 1:
+		return NewAudioFormat("audio/alaw").WithRate(header.SampleRate), nil
+	case header.Encoding == WAV_ENCODING_MULAW && header.Channels == 1:
+		return NewAudioFormat("audio/mulaw").WithRate(header.SampleRate), nil
+	}
+	return AudioFormat{}, fmt.Errorf("WAV audio with encoding %d, %d bits per sample, and %d channels has no raw equivalent",
+		header.Encoding, header.BitsPerSample, header.Channels)
+}
+
+// MinimumSampleRate : The minimum sampling rate of audio for a model, or zero if the model is not known. An empty
+// model name stands for the default model, `en-US_BroadbandModel`.
+func MinimumSampleRate(model string) int {
+	if model == "" {
+		model = EnUSBroadbandModel
+	}
+	switch {
+	case strings.HasSuffix(model, "NarrowbandModel"):
+		return NARROWBAND_MIN_SAMPLE_RATE
+	case strings.HasSuffix(model, "BroadbandModel"):
+		return BROADBAND_MIN_SAMPLE_RATE
+	}
+	return 0
+}
+
+// ConfigureFromWAVHeader : Reads the WAV header of Audio and configures the request for it
+// If ContentType is `audio/l16`, `audio/alaw`, or `audio/mulaw`, the header is removed from Audio and ContentType is
+// set to the format of the samples, including the rate, channels, and endianness parameters. Otherwise Audio is left
+// intact and ContentType is set to `audio/wav` if it is not set. Warnings are returned for audio that the service
+// will reject, such as audio sampled below the minimum rate of Model.
+func (options *RecognizeOptions) ConfigureFromWAVHeader() (*RecognizeOptions, []string, error) {
+	audio, contentType, warnings, err := configureFromWAVHeader(options.Audio, options.ContentType, options.Model)
+	options.Audio = audio
+	if err == nil {
+		options.SetContentType(contentType)
+	}
+	return options, warnings, err
+}
+
+// ConfigureFromWAVHeader : Reads the WAV header of Audio and configures the job for it
+// If ContentType is `audio/l16`, `audio/alaw`, or `audio/mulaw`, the header is removed from Audio and ContentType is
+// set to the format of the samples, including the rate, channels, and endianness parameters. Otherwise Audio is left
+// intact and ContentType is set to `audio/wav` if it is not set. Warnings are returned for audio that the service
+// will reject, such as audio sampled below the minimum rate of Model.
+func (options *CreateJobOptions) ConfigureFromWAVHeader() (*CreateJobOptions, []string, error) {
+	audio, contentType, warnings, err := configureFromWAVHeader(options.Audio, options.ContentType, options.Model)
+	options.Audio = audio
+	if err == nil {
+		options.SetContentType(contentType)
+	}
+	return options, warnings, err
+}
+
+func configureFromWAVHeader(audio io.ReadCloser, contentType *string, model *string) (io.ReadCloser, string, []string, error) {
+	header, consumed, err := readWAVHeader(audio)
+	replayed := &audioReadCloser{
+		Reader: io.MultiReader(bytes.NewReader(consumed), audio),
+		Closer: audio,
+	}
+	if err != nil {
+		return replayed, "", nil, err
+	}
+
+	var warnings []string
+	minimumRate := MinimumSampleRate(stringOrEmpty(model))
+	if header.SampleRate < minimumRate {
+		warnings = append(warnings, fmt.Sprintf("the sampling rate of the audio, %d Hz, is below the minimum of %d Hz for the model; use audio sampled at a higher rate or a narrowband model",
+			header.SampleRate, minimumRate))
+	}
+
+	mimeType, _ := parseAudioContentType(stringOrEmpty(contentType))
+	switch mimeType {
+	case "audio/l16", "audio/alaw", "audio/mulaw":
+		format, err := header.RawAudioFormat()
+		if err != nil {
+			return replayed, "", warnings, err
+		}
+		if format.MimeType() != mimeType {
+			return replayed, "", warnings, fmt.Errorf("the WAV audio is %s, not %s", format.MimeType(), mimeType)
+		}
+		stripped := &audioReadCloser{Reader: audio, Closer: audio}
+		return stripped, format.String(), warnings, nil
+	}
+
+	if header.Channels > 9 {
+		warnings = append(warnings, fmt.Sprintf("the audio has %d channels, but WAV audio can have at most nine", header.Channels))
+	}
+	if contentType == nil {
+		return replayed, "audio/wav", warnings, nil
+	}
+	return replayed, *contentType, warnings, nil
+}
@@ -0,0 +1,99 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// wavFile returns 16-bit PCM WAV audio with a LIST chunk before the format chunk
+func wavFile(sampleRate int, channels int, samples []byte) []byte {
+	var wav bytes.Buffer
+	wav.WriteString("RIFF")
+	binary.Write(&wav, binary.LittleEndian, uint32(4+12+24+8+len(samples)))
+	wav.WriteString("WAVE")
+	wav.WriteString("LIST")
+	binary.Write(&wav, binary.LittleEndian, uint32(3))
+	wav.WriteString("abc\x00")
+	wav.WriteString("fmt ")
+	binary.Write(&wav, binary.LittleEndian, uint32(16))
+	binary.Write(&wav, binary.LittleEndian, uint16(1))
+	binary.Write(&wav, binary.LittleEndian, uint16(channels))
+	binary.Write(&wav, binary.LittleEndian, uint32(sampleRate))
+	binary.Write(&wav, binary.LittleEndian, uint32(sampleRate*channels*2))
+	binary.Write(&wav, binary.LittleEndian, uint16(channels*2))
+	binary.Write(&wav, binary.LittleEndian, uint16(16))
+	wav.WriteString("data")
+	binary.Write(&wav, binary.LittleEndian, uint32(len(samples)))
+	wav.Write(samples)
+	return wav.Bytes()
+}
+
+var _ = Describe("WAVHeader", func() {
+	samples := pcmTone(100, 1000)
+
+	Describe("ReadWAVHeader(reader io.Reader)", func() {
+		It("Reads the format of the audio", func() {
+			header, err := speechtotextv1.ReadWAVHeader(bytes.NewReader(wavFile(22050, 2, samples)))
+			Expect(err).To(BeNil())
+			Expect(header.Encoding).To(Equal(speechtotextv1.WAV_ENCODING_PCM))
+			Expect(header.SampleRate).To(Equal(22050))
+			Expect(header.Channels).To(Equal(2))
+			Expect(header.BitsPerSample).To(Equal(16))
+			Expect(header.DataSize).To(Equal(int64(len(samples))))
+			Expect(header.HeaderSize).To(Equal(int64(56)))
+
+			format, err := header.RawAudioFormat()
+			Expect(err).To(BeNil())
+			Expect(format.String()).To(Equal("audio/l16;rate=22050;channels=2;endianness=little-endian"))
+		})
+		It("Fails for audio that is not WAV audio", func() {
+			_, err := speechtotextv1.ReadWAVHeader(bytes.NewReader([]byte("fLaC\x00\x00\x00\x22")))
+			Expect(err).NotTo(BeNil())
+		})
+		It("Fails for a chunk of the largest size", func() {
+			// Replace the LIST chunk with an empty chunk that claims the largest size, which must not wrap around to
+			// zero when it is padded
+			wav := wavFile(22050, 2, samples)
+			junk := append([]byte("JUNK"), 0xFF, 0xFF, 0xFF, 0xFF)
+			wav = append(append(append([]byte{}, wav[:12]...), junk...), wav[24:]...)
+			_, err := speechtotextv1.ReadWAVHeader(bytes.NewReader(wav))
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("larger than"))
+		})
+	})
+	Describe("ConfigureFromWAVHeader()", func() {
+		testService := &speechtotextv1.SpeechToTextV1{}
+		It("Strips the header and sets the l16 parameters", func() {
+			recognizeOptions, warnings, err := testService.NewRecognizeOptions(ioutil.NopCloser(bytes.NewReader(wavFile(16000, 1, samples)))).
+				SetContentType("audio/l16").
+				ConfigureFromWAVHeader()
+			Expect(err).To(BeNil())
+			Expect(warnings).To(BeEmpty())
+			Expect(*recognizeOptions.ContentType).To(Equal("audio/l16;rate=16000;channels=1;endianness=little-endian"))
+			audio, _ := ioutil.ReadAll(recognizeOptions.Audio)
+			Expect(audio).To(Equal(samples))
+		})
+		It("Keeps WAV audio intact and warns about a low sampling rate", func() {
+			wav := wavFile(8000, 1, samples)
+			recognizeOptions, warnings, err := testService.NewRecognizeOptions(ioutil.NopCloser(bytes.NewReader(wav))).
+				SetModel(speechtotextv1.EnUSBroadbandModel).
+				ConfigureFromWAVHeader()
+			Expect(err).To(BeNil())
+			Expect(warnings).To(HaveLen(1))
+			Expect(*recognizeOptions.ContentType).To(Equal("audio/wav"))
+			audio, _ := ioutil.ReadAll(recognizeOptions.Audio)
+			Expect(audio).To(Equal(wav))
+
+			_, warnings, err = testService.NewRecognizeOptions(ioutil.NopCloser(bytes.NewReader(wav))).
+				SetModel(speechtotextv1.EnUSNarrowbandModel).
+				ConfigureFromWAVHeader()
+			Expect(err).To(BeNil())
+			Expect(warnings).To(BeEmpty())
+		})
+	})
+})
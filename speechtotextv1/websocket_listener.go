@@ -54,11 +54,20 @@ func (wsHandle RecognizeListener) OnData(conn *websocket.Conn, recognizeOptions
 		if websocketResponse.State == "listening" {
 			if !isListening {
 				isListening = true
+				if callback, ok := wsHandle.Callback.(RecognizeCallback); ok {
+					callback.OnListening()
+				}
 				continue
 			} else {
 				break
 			}
 		}
+		if callback, ok := wsHandle.Callback.(RecognizeCallback); ok && hasInterimResults(&websocketResponse.SpeechRecognitionResults) {
+			callback.OnInterimResult(&websocketResponse.SpeechRecognitionResults)
+		}
+		if callback, ok := wsHandle.Callback.(ProcessingMetricsCallback); ok && websocketResponse.ProcessingMetrics != nil {
+			callback.OnProcessingMetrics(websocketResponse.ProcessingMetrics)
+		}
 		detailResp := core.DetailedResponse{}
 		detailResp.Result = result
 		detailResp.StatusCode = SUCCESS
@@ -75,6 +84,18 @@ func (wsHandle RecognizeListener) OnError(err error) {
 	wsHandle.Callback.OnError(err)
 }
 
+/*
+	hasInterimResults : Reports whether any of the results is not final
+*/
+func hasInterimResults(results *SpeechRecognitionResults) bool {
+	for _, result := range results.Results {
+		if result.Final != nil && !*result.Final {
+			return true
+		}
+	}
+	return false
+}
+
 /*
 	sendStartMessage : Sends start message to server
 */
@@ -104,16 +125,17 @@ func sendAudio(conn *websocket.Conn, recognizeOptions *RecognizeUsingWebsocketOp
 	chunk := make([]byte, ONE_KB*2)
 	for {
 		bytesRead, err := (recognizeOptions.Audio).Read(chunk)
-		if err != nil {
-			if err == io.EOF {
+		if bytesRead > 0 {
+			if writeErr := conn.WriteMessage(websocket.BinaryMessage, chunk[:bytesRead]); writeErr != nil {
+				recognizeListener.OnError(writeErr)
 				break
-			} else {
-				recognizeListener.OnError(err)
 			}
 		}
-		err = conn.WriteMessage(websocket.BinaryMessage, chunk[:bytesRead])
 		if err != nil {
-			recognizeListener.OnError(err)
+			if err != io.EOF {
+				recognizeListener.OnError(err)
+			}
+			break
 		}
 		time.Sleep(TEN_MILLISECONDS)
 	}
@@ -128,6 +150,8 @@ func (speechToText *SpeechToTextV1) NewRecognizeListener(callback RecognizeCallb
 	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s%s?%s", dialURL, RECOGNIZE_ENDPOINT, param.Encode()), headers)
 	if err != nil {
 		recognizeListener.OnError(err)
+		callback.OnClose()
+		return
 	}
 	recognizeListener.OnOpen(recognizeWSOptions, conn)
 	go recognizeListener.OnData(conn, recognizeWSOptions)
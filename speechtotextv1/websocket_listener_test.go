@@ -0,0 +1,95 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/gorilla/websocket"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type processingMetricsCallback struct {
+	speechtotextv1.BaseRecognizeCallback
+	metrics []*speechtotextv1.ProcessingMetrics
+	data    int
+	errors  []error
+}
+
+func (callback *processingMetricsCallback) OnProcessingMetrics(metrics *speechtotextv1.ProcessingMetrics) {
+	callback.metrics = append(callback.metrics, metrics)
+}
+
+func (callback *processingMetricsCallback) OnData(*core.DetailedResponse) {
+	callback.data++
+}
+
+func (callback *processingMetricsCallback) OnError(err error) {
+	callback.errors = append(callback.errors, err)
+}
+
+var _ = Describe("WebsocketListener", func() {
+	Describe("RecognizeUsingWebsocket(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper)", func() {
+		Context("Successfully - Receive processing metrics", func() {
+			upgrader := websocket.Upgrader{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				conn, err := upgrader.Upgrade(res, req, nil)
+				Expect(err).To(BeNil())
+				defer conn.Close()
+
+				_, start, err := conn.ReadMessage()
+				Expect(err).To(BeNil())
+				Expect(string(start)).To(ContainSubstring(`"processing_metrics":true`))
+				Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))).To(Succeed())
+
+				for {
+					messageType, message, err := conn.ReadMessage()
+					Expect(err).To(BeNil())
+					if messageType == websocket.TextMessage {
+						Expect(string(message)).To(ContainSubstring(`"action":"stop"`))
+						break
+					}
+				}
+
+				Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"processing_metrics": {"processed_audio":
+					{"received": 1.0, "seen_by_engine": 0.9, "transcription": 0.8, "speaker_labels": 0.0},
+					"wall_clock_since_first_byte_received": 1.25, "periodic": true}}`))).To(Succeed())
+				Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"result_index": 0, "results": [{"final": true,
+					"alternatives": [{"transcript": "hello "}]}]}`))).To(Succeed())
+				Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))).To(Succeed())
+			}))
+			It("Succeed to call RecognizeUsingWebsocket", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: "ws" + strings.TrimPrefix(testServer.URL, "http"),
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				callback := &processingMetricsCallback{}
+				recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(bytes.NewReader(make([]byte, 3200)), "audio/l16; rate=16000").
+					SetProcessingMetrics(true)
+				testService.RecognizeUsingWebsocket(recognizeWSOptions, callback)
+				Expect(callback.errors).To(BeEmpty())
+
+				Expect(callback.metrics).To(HaveLen(1))
+				Expect(*callback.metrics[0].WallClockSinceFirstByteReceived).To(Equal(float32(1.25)))
+				Expect(*callback.metrics[0].Periodic).To(BeTrue())
+				Expect(*callback.metrics[0].ProcessedAudio.Transcription).To(Equal(float32(0.8)))
+				Expect(callback.data).To(Equal(2))
+			})
+		})
+	})
+})
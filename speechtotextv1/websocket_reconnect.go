@@ -0,0 +1,445 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/gorilla/websocket"
+)
+
+// errWebsocketSessionEnded is returned to the sender of a connection that was replaced by a new connection
+var errWebsocketSessionEnded = errors.New("the websocket session ended")
+
+const (
+	DEFAULT_WEBSOCKET_MAX_RECONNECTS     = 3
+	DEFAULT_WEBSOCKET_RECONNECT_INTERVAL = time.Second
+	DEFAULT_WEBSOCKET_MAX_BUFFERED_AUDIO = 10 * 1024 * 1024
+)
+
+// WebsocketReconnectOptions : Options that control how RecognizeUsingWebsocket recovers from dropped connections
+// When the connection drops before the end of the audio, a new connection is opened, the start message is sent again,
+// and the audio that has not been confirmed by final results is sent again, followed by the rest of the audio. The
+// times in the results of the new connection are shifted so that they stay relative to the start of the audio.
+//
+// Audio is confirmed by the word timestamps of final results, so set Timestamps to `true` to avoid sending
+// recognized audio again. Reconnection requires uncompressed audio: `audio/l16`, `audio/mulaw`, `audio/alaw`, or WAV
+// audio with one of those encodings.
+type WebsocketReconnectOptions struct {
+
+	// The number of consecutive failed connections after which the recognition fails. Defaults to 3.
+	MaxReconnects int
+
+	// The time to wait before each reconnection. Defaults to 1 second.
+	ReconnectInterval time.Duration
+
+	// The most audio, in bytes, that is kept to be sent again. The oldest audio that was sent is discarded when it is
+	// exceeded, and reading the audio pauses while the buffer holds only audio that has not been sent. Defaults to 10 MB.
+	MaxBufferedAudio int
+}
+
+// NewWebsocketReconnectOptions : Instantiate WebsocketReconnectOptions with the default values
+func NewWebsocketReconnectOptions() *WebsocketReconnectOptions {
+	return &WebsocketReconnectOptions{
+		MaxReconnects:     DEFAULT_WEBSOCKET_MAX_RECONNECTS,
+		ReconnectInterval: DEFAULT_WEBSOCKET_RECONNECT_INTERVAL,
+		MaxBufferedAudio:  DEFAULT_WEBSOCKET_MAX_BUFFERED_AUDIO,
+	}
+}
+
+// SetMaxReconnects : Allow user to set MaxReconnects
+func (options *WebsocketReconnectOptions) SetMaxReconnects(maxReconnects int) *WebsocketReconnectOptions {
+	options.MaxReconnects = maxReconnects
+	return options
+}
+
+// SetReconnectInterval : Allow user to set ReconnectInterval
+func (options *WebsocketReconnectOptions) SetReconnectInterval(reconnectInterval time.Duration) *WebsocketReconnectOptions {
+	options.ReconnectInterval = reconnectInterval
+	return options
+}
+
+// SetMaxBufferedAudio : Allow user to set MaxBufferedAudio
+func (options *WebsocketReconnectOptions) SetMaxBufferedAudio(maxBufferedAudio int) *WebsocketReconnectOptions {
+	options.MaxBufferedAudio = maxBufferedAudio
+	return options
+}
+
+// SetReconnect : Allow user to set Reconnect
+func (recognizeWSOptions *RecognizeUsingWebsocketOptions) SetReconnect(reconnect *WebsocketReconnectOptions) *RecognizeUsingWebsocketOptions {
+	recognizeWSOptions.Reconnect = reconnect
+	return recognizeWSOptions
+}
+
+// websocketAudioBuffer : Reads the audio of a recognition and keeps the audio that has not been confirmed by final
+// results, so that it can be sent again on a new connection. The source is read by a single goroutine; each connection
+// takes the audio from the buffer.
+type websocketAudioBuffer struct {
+	source         io.Reader
+	header         []byte
+	bytesPerSecond float64
+	frameSize      int
+	maxSize        int
+
+	mutex      sync.Mutex
+	changed    *sync.Cond
+	buffered   []byte
+	start      int64
+	position   int
+	generation int
+	err        error
+	stopped    bool
+}
+
+func newWebsocketAudioBuffer(source io.Reader, contentType string, maxSize int) (*websocketAudioBuffer, error) {
+	buffer := &websocketAudioBuffer{source: source, maxSize: maxSize}
+	buffer.changed = sync.NewCond(&buffer.mutex)
+
+	mediaType, _ := parseAudioContentType(contentType)
+	format, err := ParseAudioFormat(contentType)
+	if mediaType == "audio/wav" || mediaType == "audio/wave" || mediaType == "audio/x-wav" {
+		var header *WAVHeader
+		header, buffer.header, err = readWAVHeader(source)
+		if err == nil {
+			format, err = header.RawAudioFormat()
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reconnection requires uncompressed audio: %v", err)
+	}
+
+	buffer.frameSize = 1
+	switch format.MimeType() {
+	case "audio/l16":
+		buffer.frameSize = 2
+		if format.Channels() > 0 {
+			buffer.frameSize *= format.Channels()
+		}
+	case "audio/mulaw", "audio/alaw":
+	default:
+		return nil, fmt.Errorf("reconnection requires uncompressed audio; audio of type '%s' cannot be sent again", contentType)
+	}
+	buffer.bytesPerSecond = float64(format.Rate() * buffer.frameSize)
+
+	go buffer.readSource()
+	return buffer, nil
+}
+
+// readSource : Appends the audio of the source to the buffer until the source ends or the buffer is stopped. Reading
+// pauses while the buffer is full of audio that has not been sent.
+func (buffer *websocketAudioBuffer) readSource() {
+	chunk := make([]byte, 32*1024)
+	for {
+		buffer.mutex.Lock()
+		for !buffer.stopped && buffer.maxSize > 0 && len(buffer.buffered) >= buffer.maxSize && buffer.position < buffer.frameSize {
+			buffer.changed.Wait()
+		}
+		if buffer.stopped {
+			buffer.mutex.Unlock()
+			return
+		}
+		buffer.mutex.Unlock()
+
+		bytesRead, err := buffer.source.Read(chunk)
+
+		buffer.mutex.Lock()
+		buffer.buffered = append(buffer.buffered, chunk[:bytesRead]...)
+		if excess := len(buffer.buffered) - buffer.maxSize; buffer.maxSize > 0 && excess > 0 {
+			buffer.discard(excess + (buffer.frameSize-excess%buffer.frameSize)%buffer.frameSize)
+		}
+		buffer.err = err
+		buffer.changed.Broadcast()
+		buffer.mutex.Unlock()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// next : Returns the next chunk of audio for the connection of the generation, waiting until there is audio. Returns
+// io.EOF at the end of the audio, and errWebsocketSessionEnded once a newer connection has started.
+func (buffer *websocketAudioBuffer) next(generation int, chunkSize int) ([]byte, error) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	for buffer.generation == generation && !buffer.stopped && buffer.position >= len(buffer.buffered) && buffer.err == nil {
+		buffer.changed.Wait()
+	}
+	if buffer.generation != generation || buffer.stopped {
+		return nil, errWebsocketSessionEnded
+	}
+	if buffer.position < len(buffer.buffered) {
+		end := buffer.position + chunkSize
+		if end > len(buffer.buffered) {
+			end = len(buffer.buffered)
+		}
+		data := append([]byte(nil), buffer.buffered[buffer.position:end]...)
+		buffer.position = end
+		buffer.changed.Broadcast()
+		return data, nil
+	}
+	return nil, buffer.err
+}
+
+// confirm : Discards the audio before the time in seconds from the start of the audio, which final results cover
+func (buffer *websocketAudioBuffer) confirm(seconds float64) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	confirmed := int64(seconds * buffer.bytesPerSecond)
+	confirmed -= confirmed % int64(buffer.frameSize)
+	if confirmed > buffer.start {
+		buffer.discard(int(confirmed - buffer.start))
+		buffer.changed.Broadcast()
+	}
+}
+
+// discard : Discards up to size bytes of the oldest audio that was already sent. Must be called with the mutex held.
+func (buffer *websocketAudioBuffer) discard(size int) {
+	if size > buffer.position {
+		size = buffer.position - buffer.position%buffer.frameSize
+	}
+	buffer.buffered = buffer.buffered[size:]
+	buffer.start += int64(size)
+	buffer.position -= size
+}
+
+// rewind : Starts a new generation for a new connection, which sends the buffered audio again, and returns the
+// generation and the time in seconds from the start of the audio at which the buffered audio begins
+func (buffer *websocketAudioBuffer) rewind() (int, float64) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	buffer.generation++
+	buffer.position = 0
+	buffer.changed.Broadcast()
+	return buffer.generation, float64(buffer.start) / buffer.bytesPerSecond
+}
+
+// stop : Ends the reading of the source and the connections that wait for audio
+func (buffer *websocketAudioBuffer) stop() {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	buffer.stopped = true
+	buffer.changed.Broadcast()
+}
+
+// recognizeUsingWebsocketWithReconnect : Runs a recognition over as many connections as it takes to send all of the
+// audio, reconnecting when a connection drops. The request carries the headers of each connection.
+func (speechToText *SpeechToTextV1) recognizeUsingWebsocketWithReconnect(callback RecognizeCallbackWrapper, recognizeWSOptions *RecognizeUsingWebsocketOptions, dialURL string, param url.Values, req *http.Request) {
+	defer callback.OnClose()
+
+	dial := func() (*websocket.Conn, error) {
+		// Authenticate each connection, because a token can expire during a long recognition
+		if err := speechToText.Service.Options.Authenticator.Authenticate(req); err != nil {
+			return nil, err
+		}
+		conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s%s?%s", dialURL, RECOGNIZE_ENDPOINT, param.Encode()), req.Header)
+		return conn, err
+	}
+
+	options := websocketReconnectOptionsWithDefaults(recognizeWSOptions.Reconnect)
+	audio, err := newWebsocketAudioBuffer(recognizeWSOptions.Audio, stringOrEmpty(recognizeWSOptions.ContentType), options.MaxBufferedAudio)
+	if err != nil {
+		callback.OnError(err)
+		return
+	}
+
+	defer audio.stop()
+
+	callback.OnOpen()
+	session := &websocketSession{callback: callback, audio: audio}
+	failures := 0
+	for {
+		conn, err := dial()
+		if err == nil {
+			session.generation, session.offset = audio.rewind()
+			var finished bool
+			finished, err = session.run(conn, recognizeWSOptions)
+			if finished {
+				return
+			}
+			if session.received {
+				failures = 0
+			}
+		}
+		failures++
+		if failures > options.MaxReconnects {
+			callback.OnError(fmt.Errorf("the connection could not be restored after %d attempts: %v", options.MaxReconnects, err))
+			return
+		}
+		time.Sleep(options.ReconnectInterval)
+	}
+}
+
+// websocketSession : The state of a recognition that is carried from one connection to the next
+type websocketSession struct {
+	callback   RecognizeCallbackWrapper
+	audio      *websocketAudioBuffer
+	generation int
+	offset     float64
+	listening  bool
+	received   bool
+}
+
+// run : Sends the start message and the audio on the connection, and passes the results to the callback until the
+// recognition finishes or the connection drops
+func (session *websocketSession) run(conn *websocket.Conn, recognizeWSOptions *RecognizeUsingWebsocketOptions) (bool, error) {
+	defer conn.Close()
+	session.received = false
+
+	startOptions := *recognizeWSOptions
+	startOptions.Action = core.StringPtr("start")
+	startMessage, err := json.Marshal(&startOptions)
+	if err != nil {
+		return false, err
+	}
+	if err = conn.WriteMessage(websocket.TextMessage, startMessage); err != nil {
+		return false, err
+	}
+
+	go session.sendAudio(conn, session.generation)
+
+	listeningStates := 0
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return false, err
+		}
+		var websocketResponse struct {
+			WebsocketRecognitionResults
+			Error string `json:"error,omitempty"`
+		}
+		if err = json.Unmarshal(message, &websocketResponse); err != nil {
+			session.callback.OnError(err)
+			return true, nil
+		}
+		if websocketResponse.Error != "" {
+			session.callback.OnError(fmt.Errorf("%s", websocketResponse.Error))
+			return true, nil
+		}
+		if websocketResponse.State == "listening" {
+			listeningStates++
+			if listeningStates > 1 {
+				return true, nil
+			}
+			if callback, ok := session.callback.(RecognizeCallback); ok && !session.listening {
+				callback.OnListening()
+			}
+			session.listening = true
+			continue
+		}
+
+		session.received = true
+		results := &websocketResponse.SpeechRecognitionResults
+		if session.offset > 0 {
+			shiftWebsocketResults(results, session.offset)
+			if message, err = json.Marshal(results); err != nil {
+				session.callback.OnError(err)
+				return true, nil
+			}
+		}
+		if confirmed, ok := finalResultsEnd(results); ok {
+			session.audio.confirm(confirmed)
+		}
+		if callback, ok := session.callback.(RecognizeCallback); ok && hasInterimResults(results) {
+			callback.OnInterimResult(results)
+		}
+		if callback, ok := session.callback.(ProcessingMetricsCallback); ok && results.ProcessingMetrics != nil {
+			callback.OnProcessingMetrics(results.ProcessingMetrics)
+		}
+		session.callback.OnData(&core.DetailedResponse{StatusCode: SUCCESS, Result: message})
+	}
+}
+
+// sendAudio : Sends the header of the audio, if it has one, and the audio, and then the stop message
+func (session *websocketSession) sendAudio(conn *websocket.Conn, generation int) {
+	if len(session.audio.header) > 0 {
+		if conn.WriteMessage(websocket.BinaryMessage, session.audio.header) != nil {
+			return
+		}
+	}
+	for {
+		data, err := session.audio.next(generation, ONE_KB*2)
+		if len(data) > 0 {
+			if conn.WriteMessage(websocket.BinaryMessage, data) != nil {
+				return
+			}
+		}
+		if err == errWebsocketSessionEnded {
+			return
+		}
+		if err != nil {
+			if err != io.EOF {
+				session.callback.OnError(err)
+			}
+			break
+		}
+		time.Sleep(TEN_MILLISECONDS)
+	}
+	sendCloseMessage(conn)
+}
+
+// finalResultsEnd : Returns the end time of the last word of the final results, if they have word timestamps
+func finalResultsEnd(results *SpeechRecognitionResults) (float64, bool) {
+	end, ok := 0.0, false
+	for _, result := range results.Results {
+		if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+			continue
+		}
+		timestamps := result.Alternatives[0].Timestamps
+		if len(timestamps) > 0 && timestamps[len(timestamps)-1].End > end {
+			end, ok = timestamps[len(timestamps)-1].End, true
+		}
+	}
+	return end, ok
+}
+
+// shiftWebsocketResults : Shifts the times of the results and speaker labels of a connection by the offset of the
+// audio that it was sent
+func shiftWebsocketResults(results *SpeechRecognitionResults, offset float64) {
+	for i, result := range results.Results {
+		results.Results[i] = shiftRecognitionResult(result, offset)
+	}
+	for i, speakerLabel := range results.SpeakerLabels {
+		if speakerLabel.From != nil {
+			results.SpeakerLabels[i].From = core.Float32Ptr(*speakerLabel.From + float32(offset))
+		}
+		if speakerLabel.To != nil {
+			results.SpeakerLabels[i].To = core.Float32Ptr(*speakerLabel.To + float32(offset))
+		}
+	}
+}
+
+func websocketReconnectOptionsWithDefaults(options *WebsocketReconnectOptions) *WebsocketReconnectOptions {
+	withDefaults := NewWebsocketReconnectOptions()
+	if options.MaxReconnects > 0 {
+		withDefaults.MaxReconnects = options.MaxReconnects
+	}
+	if options.ReconnectInterval > 0 {
+		withDefaults.ReconnectInterval = options.ReconnectInterval
+	}
+	if options.MaxBufferedAudio > 0 {
+		withDefaults.MaxBufferedAudio = options.MaxBufferedAudio
+	}
+	return withDefaults
+}
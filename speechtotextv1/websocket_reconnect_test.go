@@ -0,0 +1,213 @@
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/gorilla/websocket"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type reconnectCallback struct {
+	speechtotextv1.BaseRecognizeCallback
+	results []speechtotextv1.SpeechRecognitionResults
+	errors  []error
+	closed  bool
+}
+
+func (callback *reconnectCallback) OnData(response *core.DetailedResponse) {
+	var results speechtotextv1.SpeechRecognitionResults
+	if err := json.Unmarshal(response.Result.([]byte), &results); err != nil {
+		callback.errors = append(callback.errors, err)
+		return
+	}
+	callback.results = append(callback.results, results)
+}
+
+func (callback *reconnectCallback) OnError(err error) {
+	callback.errors = append(callback.errors, err)
+}
+
+func (callback *reconnectCallback) OnClose() {
+	callback.closed = true
+}
+
+var _ = Describe("WebsocketReconnect", func() {
+	Describe("NewWebsocketReconnectOptions()", func() {
+		It("Succeed to call NewWebsocketReconnectOptions", func() {
+			options := speechtotextv1.NewWebsocketReconnectOptions()
+			Expect(options.MaxReconnects).To(Equal(speechtotextv1.DEFAULT_WEBSOCKET_MAX_RECONNECTS))
+			Expect(options.ReconnectInterval).To(Equal(speechtotextv1.DEFAULT_WEBSOCKET_RECONNECT_INTERVAL))
+			Expect(options.MaxBufferedAudio).To(Equal(speechtotextv1.DEFAULT_WEBSOCKET_MAX_BUFFERED_AUDIO))
+
+			options.SetMaxReconnects(5).SetReconnectInterval(time.Millisecond).SetMaxBufferedAudio(1024)
+			Expect(options.MaxReconnects).To(Equal(5))
+			Expect(options.ReconnectInterval).To(Equal(time.Millisecond))
+			Expect(options.MaxBufferedAudio).To(Equal(1024))
+		})
+	})
+
+	Describe("RecognizeUsingWebsocket(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper)", func() {
+		Context("Unsuccessfully - Reconnection requires uncompressed audio", func() {
+			It("Fail to call RecognizeUsingWebsocket", func() {
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: "https://localhost",
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				callback := &reconnectCallback{}
+				recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(strings.NewReader("fLaC"), "audio/flac").
+					SetReconnect(speechtotextv1.NewWebsocketReconnectOptions())
+				testService.RecognizeUsingWebsocket(recognizeWSOptions, callback)
+				Expect(callback.errors).To(HaveLen(1))
+				Expect(callback.closed).To(BeTrue())
+			})
+		})
+		Context("Successfully - Resend the unconfirmed audio when the connection drops", func() {
+			// One second of 16 kHz mono audio; the first connection drops after half of it, when the first quarter
+			// second is confirmed by a final result
+			audio := make([]byte, 32000)
+			for i := range audio {
+				audio[i] = byte(i / 2)
+			}
+			var mutex sync.Mutex
+			var received [][]byte
+			upgrader := websocket.Upgrader{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/recognize"))
+				conn, err := upgrader.Upgrade(res, req, nil)
+				Expect(err).To(BeNil())
+				defer conn.Close()
+
+				mutex.Lock()
+				connection := len(received)
+				received = append(received, nil)
+				mutex.Unlock()
+
+				_, start, err := conn.ReadMessage()
+				Expect(err).To(BeNil())
+				Expect(string(start)).To(ContainSubstring(`"action":"start"`))
+				Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))).To(Succeed())
+
+				var data bytes.Buffer
+				for {
+					messageType, message, err := conn.ReadMessage()
+					Expect(err).To(BeNil())
+					if messageType == websocket.TextMessage {
+						Expect(string(message)).To(ContainSubstring(`"action":"stop"`))
+						break
+					}
+					data.Write(message)
+					if connection == 0 && data.Len() >= 16000 {
+						break
+					}
+				}
+				mutex.Lock()
+				received[connection] = data.Bytes()
+				mutex.Unlock()
+
+				if connection == 0 {
+					// Confirm the first quarter second and drop the connection without closing it
+					Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"result_index": 0, "results": [{"final": true,
+						"alternatives": [{"transcript": "hello ", "timestamps": [["hello", 0.05, 0.25]]}]}]}`))).To(Succeed())
+					return
+				}
+				Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"result_index": 0, "results": [{"final": true,
+					"alternatives": [{"transcript": "world ", "timestamps": [["world", 0.1, 0.5]]}]}]}`))).To(Succeed())
+				Expect(conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))).To(Succeed())
+			}))
+			It("Succeed to call RecognizeUsingWebsocket", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: "ws" + strings.TrimPrefix(testServer.URL, "http"),
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				callback := &reconnectCallback{}
+				recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(bytes.NewReader(audio), "audio/l16; rate=16000").
+					SetReconnect(speechtotextv1.NewWebsocketReconnectOptions().SetReconnectInterval(time.Millisecond))
+				recognizeWSOptions.SetTimestamps(true)
+				testService.RecognizeUsingWebsocket(recognizeWSOptions, callback)
+				Expect(callback.errors).To(BeEmpty())
+				Expect(callback.closed).To(BeTrue())
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				Expect(received).To(HaveLen(2))
+				Expect(len(received[0])).To(BeNumerically(">=", 16000))
+				Expect(received[1]).To(Equal(audio[8000:]))
+
+				Expect(callback.results).To(HaveLen(2))
+				first := callback.results[0].Results[0].Alternatives[0].Timestamps[0]
+				Expect(first.Start).To(Equal(0.05))
+				Expect(first.End).To(Equal(0.25))
+				second := callback.results[1].Results[0].Alternatives[0].Timestamps[0]
+				Expect(second.Word).To(Equal("world"))
+				Expect(second.Start).To(BeNumerically("~", 0.35, 1e-9))
+				Expect(second.End).To(BeNumerically("~", 0.75, 1e-9))
+			})
+		})
+		Context("Unsuccessfully - Give up after MaxReconnects failed connections", func() {
+			var mutex sync.Mutex
+			connections := 0
+			upgrader := websocket.Upgrader{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				conn, err := upgrader.Upgrade(res, req, nil)
+				Expect(err).To(BeNil())
+				mutex.Lock()
+				connections++
+				mutex.Unlock()
+				conn.Close()
+			}))
+			It("Fail to call RecognizeUsingWebsocket", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: "ws" + strings.TrimPrefix(testServer.URL, "http"),
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				callback := &reconnectCallback{}
+				recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(bytes.NewReader(make([]byte, 32000)), "audio/l16; rate=16000").
+					SetReconnect(speechtotextv1.NewWebsocketReconnectOptions().
+						SetMaxReconnects(2).
+						SetReconnectInterval(time.Millisecond))
+				testService.RecognizeUsingWebsocket(recognizeWSOptions, callback)
+				Expect(callback.errors).To(HaveLen(1))
+				Expect(callback.errors[0].Error()).To(ContainSubstring("after 2 attempts"))
+				Expect(callback.closed).To(BeTrue())
+				Expect(callback.results).To(BeEmpty())
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				Expect(connections).To(Equal(3))
+			})
+		})
+	})
+})
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// MAX_ADD_WORDS_BATCH_SIZE is the number of words that ImportWordsFromCSV adds with each AddWords request.
+const MAX_ADD_WORDS_BATCH_SIZE = 30000
+
+// WordImportError : A row of a CSV file of custom words that could not be imported
+type WordImportError struct {
+
+	// The number of the row, from 1, including any header row.
+	Row int
+
+	// The word of the row, if it has one.
+	Word string
+
+	// The reason the row could not be imported.
+	Err error
+}
+
+// Error : Describes the row and the reason it could not be imported
+func (err *WordImportError) Error() string {
+	if err.Word == "" {
+		return fmt.Sprintf("row %d: %v", err.Row, err.Err)
+	}
+	return fmt.Sprintf("row %d (%s): %v", err.Row, err.Word, err.Err)
+}
+
+// WordImportReport : The outcome of ImportWordsFromCSV
+type WordImportReport struct {
+
+	// The number of words that were added to the custom model.
+	Imported int
+
+	// The rows that were not imported, in order.
+	Errors []*WordImportError
+}
+
+// ParseWordsCSV : Parses custom words from CSV or TSV with the columns word, sounds_like, and display_as
+// The delimiter is a tab if the first line contains one, and a comma otherwise. The sounds_like and display_as
+// columns are optional, and multiple sounds-like pronunciations are separated by `|`. A first row whose first column
+// is `word` is treated as a header. Rows that are invalid or repeat an earlier word are returned as errors instead of
+// words.
+func ParseWordsCSV(reader io.Reader) ([]CustomWord, []*WordImportError, error) {
+	buffered := bufio.NewReader(reader)
+	firstLine, err := buffered.Peek(4096)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, nil, err
+	}
+	if newline := strings.IndexByte(string(firstLine), '\n'); newline >= 0 {
+		firstLine = firstLine[:newline]
+	}
+
+	csvReader := csv.NewReader(buffered)
+	if strings.Contains(string(firstLine), "\t") {
+		csvReader.Comma = '\t'
+	}
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	var words []CustomWord
+	var rowErrors []*WordImportError
+	rowsByWord := map[string]int{}
+	for row := 1; ; row++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if row == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "word") {
+			continue
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		word := CustomWord{Word: core.StringPtr(strings.TrimSpace(record[0]))}
+		if len(record) > 1 && strings.TrimSpace(record[1]) != "" {
+			for _, soundsLike := range strings.Split(record[1], "|") {
+				word.SoundsLike = append(word.SoundsLike, strings.TrimSpace(soundsLike))
+			}
+		}
+		if len(record) > 2 && strings.TrimSpace(record[2]) != "" {
+			word.DisplayAs = core.StringPtr(strings.TrimSpace(record[2]))
+		}
+		if len(record) > 3 {
+			err = fmt.Errorf("the row has %d columns; expected word, sounds_like, and display_as", len(record))
+		} else {
+			err = ValidateCustomWord(word)
+		}
+		if err == nil {
+			if earlierRow, ok := rowsByWord[*word.Word]; ok {
+				err = fmt.Errorf("the word is already defined in row %d", earlierRow)
+			}
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, &WordImportError{Row: row, Word: *word.Word, Err: err})
+			continue
+		}
+		rowsByWord[*word.Word] = row
+		words = append(words, word)
+	}
+	return words, rowErrors, nil
+}
+
+// ImportWordsFromCSV : Add custom words from CSV or TSV to a custom language model
+// Parses the words with ParseWordsCSV and adds the valid ones with AddWords, in batches of at most 30,000 words. The
+// service processes one addition to a model at a time, so each batch after the first waits until the model is
+// `ready`. Invalid rows are reported in the returned report rather than as an error; an error is returned if the input
+// cannot be parsed or a batch cannot be added, with the report of the words imported so far.
+func (speechToText *SpeechToTextV1) ImportWordsFromCSV(customizationID string, reader io.Reader) (*WordImportReport, error) {
+	return speechToText.ImportWordsFromCSVWithContext(context.Background(), customizationID, reader)
+}
+
+// ImportWordsFromCSVWithContext is an alternate form of the ImportWordsFromCSV method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ImportWordsFromCSVWithContext(ctx context.Context, customizationID string, reader io.Reader) (*WordImportReport, error) {
+	words, rowErrors, err := ParseWordsCSV(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &WordImportReport{Errors: rowErrors}
+	for start := 0; start < len(words); start += MAX_ADD_WORDS_BATCH_SIZE {
+		if start > 0 {
+			_, err = speechToText.waitForLanguageModelStatus(ctx, customizationID, LanguageModel_Status_Ready, nil)
+			if err != nil {
+				return report, err
+			}
+		}
+		end := start + MAX_ADD_WORDS_BATCH_SIZE
+		if end > len(words) {
+			end = len(words)
+		}
+		addWordsOptions := speechToText.NewAddWordsOptions(customizationID, words[start:end])
+		if _, err = speechToText.AddWordsWithContext(ctx, addWordsOptions); err != nil {
+			return report, err
+		}
+		report.Imported = end
+	}
+	return report, nil
+}
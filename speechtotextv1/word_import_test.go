@@ -0,0 +1,76 @@
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WordImport", func() {
+	Describe("ValidateCustomWord(word CustomWord)", func() {
+		It("Enforces the limits of the service", func() {
+			Expect(speechtotextv1.ValidateCustomWord(speechtotextv1.CustomWord{Word: core.StringPtr("IEEE")})).To(Succeed())
+			Expect(speechtotextv1.ValidateCustomWord(speechtotextv1.CustomWord{Word: core.StringPtr("two words")})).ToNot(Succeed())
+			Expect(speechtotextv1.ValidateCustomWord(speechtotextv1.CustomWord{
+				Word:       core.StringPtr("long"),
+				SoundsLike: []string{strings.Repeat("a ", 41)},
+			})).ToNot(Succeed())
+			Expect(speechtotextv1.ValidateCustomWord(speechtotextv1.CustomWord{
+				Word:       core.StringPtr("spaced"),
+				SoundsLike: []string{strings.Repeat("a ", 40)},
+			})).To(Succeed())
+		})
+	})
+	Describe("ImportWordsFromCSV(customizationID string, reader io.Reader)", func() {
+		Context("Successfully - Import the valid rows and report the others", func() {
+			var added []speechtotextv1.CustomWord
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/customizations/customization1/words"))
+				Expect(req.Method).To(Equal("POST"))
+				var body struct {
+					Words []speechtotextv1.CustomWord `json:"words"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				added = body.Words
+				res.WriteHeader(http.StatusCreated)
+			}))
+			It("Succeed to call ImportWordsFromCSV", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: "user1",
+						Password: "pass1",
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				csv := "word,sounds_like,display_as\n" +
+					"IEEE,I triple E,\n" +
+					"HHonors,hilton honors|h honors,HHonors\n" +
+					"two words,,\n" +
+					"IEEE,eye triple e,\n"
+				report, err := testService.ImportWordsFromCSV("customization1", strings.NewReader(csv))
+				Expect(err).To(BeNil())
+				Expect(report.Imported).To(Equal(2))
+				Expect(report.Errors).To(HaveLen(2))
+				Expect(report.Errors[0].Row).To(Equal(4))
+				Expect(report.Errors[1].Error()).To(Equal("row 5 (IEEE): the word is already defined in row 2"))
+
+				Expect(added).To(HaveLen(2))
+				Expect(added[1].SoundsLike).To(Equal([]string{"hilton honors", "h honors"}))
+				Expect(*added[1].DisplayAs).To(Equal("HHonors"))
+			})
+		})
+	})
+})
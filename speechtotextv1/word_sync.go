@@ -0,0 +1,253 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// SyncWordsOptions : The SyncWords options.
+type SyncWordsOptions struct {
+
+	// The customization ID (GUID) of the custom language model that is to be used for the request.
+	CustomizationID *string `json:"customization_id" validate:"required"`
+
+	// The complete list of user-added words that the custom model is to contain. A word whose `sounds_like` or
+	// `display_as` is omitted keeps the value that the service has for it.
+	Words []CustomWord `json:"words"`
+
+	// If true, user-added words of the model that are not in Words are kept instead of deleted.
+	KeepUnlisted *bool `json:"keep_unlisted,omitempty"`
+
+	// If true, the changes are computed and returned but not applied.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// If true, the model is trained after the changes have been processed by the service.
+	Train *bool `json:"train,omitempty"`
+
+	// Controls how long to wait for the service to process added words before the model is trained.
+	WaitForTrainingOptions *WaitForTrainingOptions
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewSyncWordsOptions : Instantiate SyncWordsOptions
+func (speechToText *SpeechToTextV1) NewSyncWordsOptions(customizationID string, words []CustomWord) *SyncWordsOptions {
+	return &SyncWordsOptions{
+		CustomizationID: core.StringPtr(customizationID),
+		Words:           words,
+	}
+}
+
+// SetCustomizationID : Allow user to set CustomizationID
+func (options *SyncWordsOptions) SetCustomizationID(customizationID string) *SyncWordsOptions {
+	options.CustomizationID = core.StringPtr(customizationID)
+	return options
+}
+
+// SetWords : Allow user to set Words
+func (options *SyncWordsOptions) SetWords(words []CustomWord) *SyncWordsOptions {
+	options.Words = words
+	return options
+}
+
+// SetKeepUnlisted : Allow user to set KeepUnlisted
+func (options *SyncWordsOptions) SetKeepUnlisted(keepUnlisted bool) *SyncWordsOptions {
+	options.KeepUnlisted = core.BoolPtr(keepUnlisted)
+	return options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (options *SyncWordsOptions) SetDryRun(dryRun bool) *SyncWordsOptions {
+	options.DryRun = core.BoolPtr(dryRun)
+	return options
+}
+
+// SetTrain : Allow user to set Train
+func (options *SyncWordsOptions) SetTrain(train bool) *SyncWordsOptions {
+	options.Train = core.BoolPtr(train)
+	return options
+}
+
+// SetWaitForTrainingOptions : Allow user to set WaitForTrainingOptions
+func (options *SyncWordsOptions) SetWaitForTrainingOptions(waitForTrainingOptions *WaitForTrainingOptions) *SyncWordsOptions {
+	options.WaitForTrainingOptions = waitForTrainingOptions
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SyncWordsOptions) SetHeaders(param map[string]string) *SyncWordsOptions {
+	options.Headers = param
+	return options
+}
+
+// SyncWordsResult : The changes made by SyncWords.
+type SyncWordsResult struct {
+
+	// The words that were not in the model and were added.
+	Added []string
+
+	// The words whose `sounds_like` or `display_as` were changed.
+	Updated []string
+
+	// The user-added words that were not in the list and were deleted.
+	Deleted []string
+
+	// The response of the training request, if the model was trained.
+	Training *TrainingResponse
+}
+
+// SyncWords : Make the user-added words of a custom language model match a list
+// Lists the user-added words of the model and computes which words must be added, updated, and deleted to match the
+// list. The additions and updates are sent with a single **Add custom words** request, and each deletion with a
+// **Delete a custom word** request. Words that were extracted from corpora or grammars are not changed.
+//
+// If Train is set and the model changed, SyncWords waits until the service has processed the words and then starts
+// the training of the model. Use WaitForLanguageModelTraining to wait for the training to finish.
+func (speechToText *SpeechToTextV1) SyncWords(syncWordsOptions *SyncWordsOptions) (*SyncWordsResult, error) {
+	return speechToText.SyncWordsWithContext(context.Background(), syncWordsOptions)
+}
+
+// SyncWordsWithContext is an alternate form of the SyncWords method which supports a Context parameter
+func (speechToText *SpeechToTextV1) SyncWordsWithContext(ctx context.Context, syncWordsOptions *SyncWordsOptions) (*SyncWordsResult, error) {
+	err := core.ValidateNotNil(syncWordsOptions, "syncWordsOptions cannot be nil")
+	if err != nil {
+		return nil, err
+	}
+	err = core.ValidateStruct(syncWordsOptions, "syncWordsOptions")
+	if err != nil {
+		return nil, err
+	}
+	customizationID := *syncWordsOptions.CustomizationID
+
+	listWordsOptions := speechToText.NewListWordsOptions(customizationID).
+		SetWordType(ListWordsOptions_WordType_User).
+		SetHeaders(syncWordsOptions.Headers)
+	existingWords, _, err := speechToText.ListWordsWithContext(ctx, listWordsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result, changedWords, err := diffWords(existingWords.Words, syncWordsOptions.Words, boolValue(syncWordsOptions.KeepUnlisted))
+	if err != nil || boolValue(syncWordsOptions.DryRun) {
+		return result, err
+	}
+
+	if len(changedWords) > 0 {
+		addWordsOptions := speechToText.NewAddWordsOptions(customizationID, changedWords).
+			SetHeaders(syncWordsOptions.Headers)
+		if _, err = speechToText.AddWordsWithContext(ctx, addWordsOptions); err != nil {
+			return result, err
+		}
+	}
+	for _, word := range result.Deleted {
+		deleteWordOptions := speechToText.NewDeleteWordOptions(customizationID, word).
+			SetHeaders(syncWordsOptions.Headers)
+		if _, err = speechToText.DeleteWordWithContext(ctx, deleteWordOptions); err != nil {
+			return result, err
+		}
+	}
+
+	changed := len(changedWords) > 0 || len(result.Deleted) > 0
+	if !boolValue(syncWordsOptions.Train) || !changed {
+		return result, nil
+	}
+
+	// Added words are processed asynchronously; the model cannot be trained until its status is `ready`
+	_, err = speechToText.waitForLanguageModelStatus(ctx, customizationID, LanguageModel_Status_Ready, syncWordsOptions.WaitForTrainingOptions)
+	if err != nil {
+		return result, err
+	}
+
+	trainLanguageModelOptions := speechToText.NewTrainLanguageModelOptions(customizationID).
+		SetHeaders(syncWordsOptions.Headers)
+	result.Training, _, err = speechToText.TrainLanguageModelWithContext(ctx, trainLanguageModelOptions)
+	return result, err
+}
+
+// diffWords : Computes the changes that make the existing words match the desired words, and returns the words
+// that must be sent to AddWords
+func diffWords(existingWords []Word, desiredWords []CustomWord, keepUnlisted bool) (*SyncWordsResult, []CustomWord, error) {
+	existingByName := map[string]Word{}
+	for _, word := range existingWords {
+		if word.Word != nil {
+			existingByName[*word.Word] = word
+		}
+	}
+
+	result := &SyncWordsResult{}
+	var changedWords []CustomWord
+	desiredNames := map[string]bool{}
+	for _, desired := range desiredWords {
+		if desired.Word == nil || *desired.Word == "" {
+			return nil, nil, fmt.Errorf("every word to sync must have a value for Word")
+		}
+		name := *desired.Word
+		if desiredNames[name] {
+			return nil, nil, fmt.Errorf("the word '%s' is listed more than once", name)
+		}
+		desiredNames[name] = true
+
+		existing, ok := existingByName[name]
+		switch {
+		case !ok:
+			result.Added = append(result.Added, name)
+			changedWords = append(changedWords, desired)
+		case wordDiffers(existing, desired):
+			result.Updated = append(result.Updated, name)
+			changedWords = append(changedWords, desired)
+		}
+	}
+
+	if !keepUnlisted {
+		for name := range existingByName {
+			if !desiredNames[name] {
+				result.Deleted = append(result.Deleted, name)
+			}
+		}
+		sort.Strings(result.Deleted)
+	}
+	return result, changedWords, nil
+}
+
+// wordDiffers : Reports whether the desired word specifies a pronunciation or spelling that the existing word lacks
+func wordDiffers(existing Word, desired CustomWord) bool {
+	if desired.DisplayAs != nil && stringOrEmpty(existing.DisplayAs) != *desired.DisplayAs {
+		return true
+	}
+	if len(desired.SoundsLike) == 0 {
+		return false
+	}
+	if len(desired.SoundsLike) != len(existing.SoundsLike) {
+		return true
+	}
+	for i := range desired.SoundsLike {
+		if desired.SoundsLike[i] != existing.SoundsLike[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
@@ -0,0 +1,119 @@
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WordSync", func() {
+	username := "user1"
+	password := "pass1"
+	wordsJSON := `{"words": [
+		{"word": "IEEE", "sounds_like": ["I. triple E."], "display_as": "IEEE", "count": 1, "source": ["user"]},
+		{"word": "HHonors", "sounds_like": ["H. honors"], "display_as": "HHonors", "count": 1, "source": ["user"]},
+		{"word": "obsolete", "sounds_like": ["obsolete"], "display_as": "obsolete", "count": 1, "source": ["user"]}
+	]}`
+	desiredWords := []speechtotextv1.CustomWord{
+		{Word: core.StringPtr("IEEE"), SoundsLike: []string{"I. E. E. E."}},
+		{Word: core.StringPtr("HHonors")},
+		{Word: core.StringPtr("tomato"), DisplayAs: core.StringPtr("Tomato")},
+	}
+
+	Describe("SyncWords(syncWordsOptions *SyncWordsOptions)", func() {
+		Context("Successfully - Add, update, delete, and train", func() {
+			var requests []string
+			var addedWords []speechtotextv1.CustomWord
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.Method == "GET" && req.URL.Path == "/v1/customizations/model1/words":
+					Expect(req.URL.Query().Get("word_type")).To(Equal("user"))
+					fmt.Fprintf(res, wordsJSON)
+				case req.Method == "POST" && req.URL.Path == "/v1/customizations/model1/words":
+					var body speechtotextv1.AddWordsOptions
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					addedWords = body.Words
+					res.WriteHeader(http.StatusCreated)
+					fmt.Fprintf(res, `{}`)
+				case req.Method == "GET" && req.URL.Path == "/v1/customizations/model1":
+					fmt.Fprintf(res, `{"customization_id": "model1", "status": "ready"}`)
+				case req.Method == "POST" && req.URL.Path == "/v1/customizations/model1/train":
+					fmt.Fprintf(res, `{}`)
+				default:
+					fmt.Fprintf(res, `{}`)
+				}
+			}))
+			It("Succeed to call SyncWords", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				syncWordsOptions := testService.NewSyncWordsOptions("model1", desiredWords).
+					SetTrain(true).
+					SetWaitForTrainingOptions(testService.NewWaitForTrainingOptions().SetPollInterval(time.Millisecond))
+				result, err := testService.SyncWords(syncWordsOptions)
+				Expect(err).To(BeNil())
+				Expect(result.Added).To(Equal([]string{"tomato"}))
+				Expect(result.Updated).To(Equal([]string{"IEEE"}))
+				Expect(result.Deleted).To(Equal([]string{"obsolete"}))
+				Expect(result.Training).ToNot(BeNil())
+				Expect(addedWords).To(HaveLen(2))
+				Expect(requests).To(Equal([]string{
+					"GET /v1/customizations/model1/words",
+					"POST /v1/customizations/model1/words",
+					"DELETE /v1/customizations/model1/words/obsolete",
+					"GET /v1/customizations/model1",
+					"POST /v1/customizations/model1/train",
+				}))
+			})
+		})
+		Context("Successfully - Dry run", func() {
+			var requests []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, wordsJSON)
+			}))
+			It("Succeed to call SyncWords without changes", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+
+				syncWordsOptions := testService.NewSyncWordsOptions("model1", desiredWords).
+					SetKeepUnlisted(true).
+					SetDryRun(true)
+				result, err := testService.SyncWords(syncWordsOptions)
+				Expect(err).To(BeNil())
+				Expect(result.Added).To(Equal([]string{"tomato"}))
+				Expect(result.Deleted).To(BeEmpty())
+				Expect(requests).To(Equal([]string{"GET /v1/customizations/model1/words"}))
+			})
+		})
+	})
+})
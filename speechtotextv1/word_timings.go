@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// WordTimestamp : The time alignment of a word from a transcript. The service represents it as a list of three
+// elements, for example `["hello",0.0,1.2]`.
+type WordTimestamp struct {
+
+	// The word.
+	Word string
+
+	// The start time of the word in seconds from the beginning of the audio.
+	Start float64
+
+	// The end time of the word in seconds from the beginning of the audio.
+	End float64
+}
+
+// UnmarshalJSON : Decodes a WordTimestamp from its list representation
+func (timestamp *WordTimestamp) UnmarshalJSON(data []byte) error {
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	if len(values) != 3 {
+		return fmt.Errorf("word timestamp must have 3 elements, found %d", len(values))
+	}
+	if err := json.Unmarshal(values[0], &timestamp.Word); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(values[1], &timestamp.Start); err != nil {
+		return err
+	}
+	return json.Unmarshal(values[2], &timestamp.End)
+}
+
+// MarshalJSON : Encodes a WordTimestamp in its list representation
+func (timestamp WordTimestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{timestamp.Word, timestamp.Start, timestamp.End})
+}
+
+// WordConfidence : The confidence score of a word from a transcript. The service represents it as a list of two
+// elements, for example `["hello",0.95]`.
+type WordConfidence struct {
+
+	// The word.
+	Word string
+
+	// The confidence score of the word in the range of 0.0 to 1.0.
+	Confidence float64
+}
+
+// UnmarshalJSON : Decodes a WordConfidence from its list representation
+func (wordConfidence *WordConfidence) UnmarshalJSON(data []byte) error {
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	if len(values) != 2 {
+		return fmt.Errorf("word confidence must have 2 elements, found %d", len(values))
+	}
+	if err := json.Unmarshal(values[0], &wordConfidence.Word); err != nil {
+		return err
+	}
+	return json.Unmarshal(values[1], &wordConfidence.Confidence)
+}
+
+// MarshalJSON : Encodes a WordConfidence in its list representation
+func (wordConfidence WordConfidence) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{wordConfidence.Word, wordConfidence.Confidence})
+}
@@ -0,0 +1,39 @@
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WordTimings", func() {
+	alternativeJSON := `{"transcript":"hello world","timestamps":[["hello",0,1.2],["world",1.2,2.5]],"word_confidence":[["hello",0.95],["world",0.866]]}`
+
+	It("Decodes word timestamps and word confidence", func() {
+		var alternative speechtotextv1.SpeechRecognitionAlternative
+		Expect(json.Unmarshal([]byte(alternativeJSON), &alternative)).To(Succeed())
+		Expect(alternative.Timestamps).To(Equal([]speechtotextv1.WordTimestamp{
+			{Word: "hello", Start: 0, End: 1.2},
+			{Word: "world", Start: 1.2, End: 2.5},
+		}))
+		Expect(alternative.WordConfidence).To(Equal([]speechtotextv1.WordConfidence{
+			{Word: "hello", Confidence: 0.95},
+			{Word: "world", Confidence: 0.866},
+		}))
+	})
+	It("Encodes word timestamps and word confidence as lists", func() {
+		var alternative speechtotextv1.SpeechRecognitionAlternative
+		Expect(json.Unmarshal([]byte(alternativeJSON), &alternative)).To(Succeed())
+		data, err := json.Marshal(alternative)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(MatchJSON(alternativeJSON))
+	})
+	It("Rejects malformed entries", func() {
+		var timestamp speechtotextv1.WordTimestamp
+		Expect(json.Unmarshal([]byte(`["hello",0]`), &timestamp)).NotTo(Succeed())
+		var wordConfidence speechtotextv1.WordConfidence
+		Expect(json.Unmarshal([]byte(`{"word":"hello"}`), &wordConfidence)).NotTo(Succeed())
+	})
+})
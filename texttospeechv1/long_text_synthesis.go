@@ -0,0 +1,275 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package texttospeechv1
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+const (
+	// MAX_SYNTHESIZE_TEXT_SIZE is the largest text, in bytes, that the service accepts in one Synthesize request.
+	MAX_SYNTHESIZE_TEXT_SIZE = 5 * 1024
+
+	DEFAULT_LONG_TEXT_CHUNK_SIZE  = 4 * 1024
+	DEFAULT_LONG_TEXT_CONCURRENCY = 1
+)
+
+// SynthesizeLongTextOptions : Options that control how SynthesizeLongText divides and synthesizes text
+type SynthesizeLongTextOptions struct {
+
+	// The largest chunk of text, in bytes, that is sent in one request. Defaults to 4 KB.
+	MaxChunkSize int
+
+	// The number of chunks that are synthesized at the same time. Defaults to 1.
+	Concurrency int
+}
+
+// NewSynthesizeLongTextOptions : Instantiate SynthesizeLongTextOptions with the default values
+func NewSynthesizeLongTextOptions() *SynthesizeLongTextOptions {
+	return &SynthesizeLongTextOptions{
+		MaxChunkSize: DEFAULT_LONG_TEXT_CHUNK_SIZE,
+		Concurrency:  DEFAULT_LONG_TEXT_CONCURRENCY,
+	}
+}
+
+// SetMaxChunkSize : Allow user to set MaxChunkSize
+func (options *SynthesizeLongTextOptions) SetMaxChunkSize(maxChunkSize int) *SynthesizeLongTextOptions {
+	options.MaxChunkSize = maxChunkSize
+	return options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (options *SynthesizeLongTextOptions) SetConcurrency(concurrency int) *SynthesizeLongTextOptions {
+	options.Concurrency = concurrency
+	return options
+}
+
+// SplitText : Divides text into chunks of at most maxSize bytes, at the ends of sentences where possible, then at
+// spaces, and otherwise between characters. The chunks contain all of the text.
+func SplitText(text string, maxSize int) []string {
+	if maxSize <= 0 {
+		maxSize = DEFAULT_LONG_TEXT_CHUNK_SIZE
+	}
+	var chunks []string
+	var chunk strings.Builder
+	flush := func() {
+		if strings.TrimSpace(chunk.String()) != "" {
+			chunks = append(chunks, chunk.String())
+		}
+		chunk.Reset()
+	}
+	for _, sentence := range splitSentences(text) {
+		if chunk.Len()+len(sentence) > maxSize {
+			flush()
+		}
+		for len(sentence) > maxSize {
+			end := strings.LastIndexFunc(sentence[:maxSize+1], unicode.IsSpace)
+			if end <= 0 {
+				end = maxSize
+				for end > 0 && !utf8.RuneStart(sentence[end]) {
+					end--
+				}
+			}
+			chunk.WriteString(sentence[:end])
+			flush()
+			sentence = sentence[end:]
+		}
+		chunk.WriteString(sentence)
+	}
+	flush()
+	return chunks
+}
+
+// splitSentences : Divides text after each end of a sentence or line, keeping the following whitespace with the
+// sentence
+func splitSentences(text string) []string {
+	var sentences []string
+	start := 0
+	ended := false
+	for i, r := range text {
+		if ended && !unicode.IsSpace(r) {
+			sentences = append(sentences, text[start:i])
+			start = i
+			ended = false
+		}
+		switch r {
+		case '\n':
+			ended = true
+		case '.', '!', '?', '。', '！', '？':
+			next, _ := utf8.DecodeRuneInString(text[i+utf8.RuneLen(r):])
+			ended = next == utf8.RuneError || unicode.IsSpace(next) || r >= utf8.RuneSelf
+		}
+	}
+	if start < len(text) {
+		sentences = append(sentences, text[start:])
+	}
+	return sentences
+}
+
+// SynthesizeLongText : Synthesize text that is longer than the service accepts in one request
+// Divides the text with SplitText, synthesizes each chunk with the voice, format, and custom model of the options, and
+// returns the audio of the chunks concatenated in order. The headers of WAV audio are combined into one header with
+// the total size, so the WAV audio is returned once all of its chunks have been synthesized; audio in other formats is
+// returned as its chunks are synthesized. Text that is SSML cannot be divided and must fit in one chunk.
+func (textToSpeech *TextToSpeechV1) SynthesizeLongText(synthesizeOptions *SynthesizeOptions, longTextOptions *SynthesizeLongTextOptions) (io.ReadCloser, error) {
+	if err := core.ValidateNotNil(synthesizeOptions, "synthesizeOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(synthesizeOptions, "synthesizeOptions"); err != nil {
+		return nil, err
+	}
+	longTextOptions = longTextOptionsWithDefaults(longTextOptions)
+
+	text := *synthesizeOptions.Text
+	chunks := SplitText(text, longTextOptions.MaxChunkSize)
+	if len(chunks) > 1 && strings.HasPrefix(strings.TrimSpace(text), "<speak") {
+		return nil, fmt.Errorf("SSML text of %d bytes cannot be divided into chunks of %d bytes", len(text), longTextOptions.MaxChunkSize)
+	}
+
+	// Each chunk is synthesized into a buffered channel, so that workers can run ahead while the chunks are written
+	// in order
+	results := make([]chan synthesizedChunk, len(chunks))
+	for i := range results {
+		results[i] = make(chan synthesizedChunk, 1)
+	}
+	indexes := make(chan int)
+	done := make(chan struct{})
+	go func() {
+		defer close(indexes)
+		for i := range chunks {
+			select {
+			case indexes <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	for worker := 0; worker < longTextOptions.Concurrency; worker++ {
+		go func() {
+			for i := range indexes {
+				chunkOptions := *synthesizeOptions
+				chunkOptions.Text = core.StringPtr(chunks[i])
+				results[i] <- textToSpeech.synthesizeChunk(&chunkOptions)
+			}
+		}()
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		defer close(done)
+		writer.CloseWithError(writeSynthesizedChunks(writer, results))
+	}()
+	return &longTextAudio{PipeReader: reader, done: done}, nil
+}
+
+// synthesizedChunk : The audio of a chunk of text, or the error that prevented its synthesis
+type synthesizedChunk struct {
+	audio []byte
+	err   error
+}
+
+func (textToSpeech *TextToSpeechV1) synthesizeChunk(synthesizeOptions *SynthesizeOptions) synthesizedChunk {
+	result, _, err := textToSpeech.Synthesize(synthesizeOptions)
+	if err != nil {
+		return synthesizedChunk{err: err}
+	}
+	defer result.Close()
+	audio, err := ioutil.ReadAll(result)
+	return synthesizedChunk{audio: audio, err: err}
+}
+
+// writeSynthesizedChunks : Writes the audio of the chunks in order. WAV audio is written with one header, after all
+// of the chunks have been received.
+func writeSynthesizedChunks(writer io.Writer, results []chan synthesizedChunk) error {
+	var header []byte
+	var samples [][]byte
+	var dataSize int64
+	for i, result := range results {
+		chunk := <-result
+		if chunk.err != nil {
+			return chunk.err
+		}
+		if i == 0 && isWAV(chunk.audio) {
+			dataOffset, err := findWAVData(chunk.audio)
+			if err != nil {
+				return err
+			}
+			header = chunk.audio[:dataOffset]
+		}
+		if header == nil {
+			if _, err := writer.Write(chunk.audio); err != nil {
+				return err
+			}
+			continue
+		}
+
+		dataOffset, err := findWAVData(chunk.audio)
+		if err != nil {
+			return err
+		}
+		samples = append(samples, chunk.audio[dataOffset:])
+		dataSize += int64(len(chunk.audio) - dataOffset)
+	}
+	if header == nil {
+		return nil
+	}
+
+	repairWAVHeader(header, dataSize)
+	if _, err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, sample := range samples {
+		if _, err := writer.Write(sample); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// longTextAudio : The audio of SynthesizeLongText. Closing it stops the synthesis of the remaining chunks.
+type longTextAudio struct {
+	*io.PipeReader
+	done chan struct{}
+}
+
+// Close : Stops the synthesis and waits for the chunk that is being written
+func (audio *longTextAudio) Close() error {
+	err := audio.PipeReader.Close()
+	<-audio.done
+	return err
+}
+
+func longTextOptionsWithDefaults(options *SynthesizeLongTextOptions) *SynthesizeLongTextOptions {
+	withDefaults := NewSynthesizeLongTextOptions()
+	if options == nil {
+		return withDefaults
+	}
+	if options.MaxChunkSize > 0 {
+		withDefaults.MaxChunkSize = options.MaxChunkSize
+	}
+	if options.Concurrency > 0 {
+		withDefaults.Concurrency = options.Concurrency
+	}
+	return withDefaults
+}
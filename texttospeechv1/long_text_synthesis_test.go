@@ -0,0 +1,116 @@
+package texttospeechv1_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/texttospeechv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// streamedWAV : Returns WAV audio with the placeholder sizes of streamed audio and a sample per byte of the text
+func streamedWAV(text string) []byte {
+	var audio bytes.Buffer
+	audio.WriteString("RIFF")
+	_ = binary.Write(&audio, binary.LittleEndian, uint32(0xFFFFFFFF))
+	audio.WriteString("WAVEfmt ")
+	_ = binary.Write(&audio, binary.LittleEndian, uint32(16))
+	audio.Write(make([]byte, 16))
+	audio.WriteString("data")
+	_ = binary.Write(&audio, binary.LittleEndian, uint32(0xFFFFFFFF))
+	audio.WriteString(text)
+	return audio.Bytes()
+}
+
+var _ = Describe("LongTextSynthesis", func() {
+	Describe("SplitText(text string, maxSize int)", func() {
+		It("Succeed to call SplitText", func() {
+			text := "Hello there. How are you? I am fine!\nA sentence that is much longer than the chunks."
+			chunks := texttospeechv1.SplitText(text, 20)
+			Expect(strings.Join(chunks, "")).To(Equal(text))
+			Expect(chunks[0]).To(Equal("Hello there. "))
+			Expect(chunks[1]).To(Equal("How are you? "))
+			Expect(chunks[2]).To(Equal("I am fine!\n"))
+			for _, chunk := range chunks {
+				Expect(len(chunk)).To(BeNumerically("<=", 20))
+			}
+		})
+	})
+	Describe("SynthesizeLongText(synthesizeOptions *SynthesizeOptions, longTextOptions *SynthesizeLongTextOptions)", func() {
+		username := "user1"
+		password := "pass1"
+		text := "First sentence. Second sentence. Third sentence."
+		Context("Successfully - Synthesize long text", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/synthesize"))
+				Expect(req.URL.Query()["voice"]).To(Equal([]string{texttospeechv1.SynthesizeOptions_Voice_EnUsAllisonv3voice}))
+				var body map[string]string
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(len(body["text"])).To(BeNumerically("<=", 20))
+
+				res.Header().Set("Content-Type", "audio/wav")
+				res.WriteHeader(http.StatusOK)
+				_, _ = res.Write(streamedWAV(body["text"]))
+			}))
+			It("Succeed to call SynthesizeLongText", func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := texttospeechv1.
+					NewTextToSpeechV1(&texttospeechv1.TextToSpeechV1Options{
+						URL: testServer.URL,
+						Authenticator: &core.BasicAuthenticator{
+							Username: username,
+							Password: password,
+						},
+					})
+				Expect(testServiceErr).To(BeNil())
+
+				synthesizeOptions := testService.NewSynthesizeOptions(text).
+					SetAccept("audio/wav").
+					SetVoice(texttospeechv1.SynthesizeOptions_Voice_EnUsAllisonv3voice)
+				longTextOptions := texttospeechv1.NewSynthesizeLongTextOptions().
+					SetMaxChunkSize(20).
+					SetConcurrency(2)
+				result, err := testService.SynthesizeLongText(synthesizeOptions, longTextOptions)
+				Expect(err).To(BeNil())
+				defer result.Close()
+
+				audio, err := ioutil.ReadAll(result)
+				Expect(err).To(BeNil())
+				Expect(string(audio[44:])).To(Equal(text))
+				Expect(binary.LittleEndian.Uint32(audio[4:8])).To(Equal(uint32(36 + len(text))))
+				Expect(binary.LittleEndian.Uint32(audio[40:44])).To(Equal(uint32(len(text))))
+			})
+		})
+		Context("Unsuccessfully - Synthesize long text", func() {
+			testService, testServiceErr := texttospeechv1.
+				NewTextToSpeechV1(&texttospeechv1.TextToSpeechV1Options{
+					URL: "http://localhost",
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+			It("Fail to call SynthesizeLongText", func() {
+				Expect(testServiceErr).To(BeNil())
+
+				synthesizeOptions := testService.NewSynthesizeOptions(`<speak version="1.0">` + text + `</speak>`)
+				_, err := testService.SynthesizeLongText(synthesizeOptions, texttospeechv1.NewSynthesizeLongTextOptions().SetMaxChunkSize(20))
+				Expect(err).ToNot(BeNil())
+
+				_, err = testService.SynthesizeLongText(nil, nil)
+				Expect(err).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,226 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package texttospeechv1
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// Constants associated with the strength of an SSML `<break>` element
+const (
+	SSML_BREAK_NONE     = "none"
+	SSML_BREAK_X_WEAK   = "x-weak"
+	SSML_BREAK_WEAK     = "weak"
+	SSML_BREAK_MEDIUM   = "medium"
+	SSML_BREAK_STRONG   = "strong"
+	SSML_BREAK_X_STRONG = "x-strong"
+)
+
+// Constants associated with the level of an SSML `<emphasis>` element
+const (
+	SSML_EMPHASIS_STRONG   = "strong"
+	SSML_EMPHASIS_MODERATE = "moderate"
+	SSML_EMPHASIS_NONE     = "none"
+	SSML_EMPHASIS_REDUCED  = "reduced"
+)
+
+// Constants associated with the interpret-as attribute of an SSML `<say-as>` element
+const (
+	SSML_SAY_AS_LETTERS      = "letters"
+	SSML_SAY_AS_DIGITS       = "digits"
+	SSML_SAY_AS_CARDINAL     = "cardinal"
+	SSML_SAY_AS_ORDINAL      = "ordinal"
+	SSML_SAY_AS_NUMBER       = "number"
+	SSML_SAY_AS_VXML_DATE    = "vxml:date"
+	SSML_SAY_AS_VXML_TIME    = "vxml:time"
+	SSML_SAY_AS_VXML_PHONE   = "vxml:phone"
+	SSML_SAY_AS_DATE         = "date"
+	SSML_SAY_AS_TIME         = "time"
+	SSML_SAY_AS_TELEPHONE    = "telephone"
+	SSML_SAY_AS_ADDRESS      = "address"
+	SSML_SAY_AS_INTERJECTION = "interjection"
+)
+
+// Constants associated with the alphabet of an SSML `<phoneme>` element
+const (
+	SSML_PHONEME_IPA = "ipa"
+	SSML_PHONEME_IBM = "ibm"
+)
+
+// Constants associated with the attributes of an SSML `<prosody>` element. Rates, pitches, and volumes can also be
+// given as relative changes such as `+10%` or `-2st`.
+const (
+	SSML_PROSODY_X_SLOW  = "x-slow"
+	SSML_PROSODY_SLOW    = "slow"
+	SSML_PROSODY_FAST    = "fast"
+	SSML_PROSODY_X_FAST  = "x-fast"
+	SSML_PROSODY_X_LOW   = "x-low"
+	SSML_PROSODY_LOW     = "low"
+	SSML_PROSODY_MEDIUM  = "medium"
+	SSML_PROSODY_HIGH    = "high"
+	SSML_PROSODY_X_HIGH  = "x-high"
+	SSML_PROSODY_SILENT  = "silent"
+	SSML_PROSODY_X_SOFT  = "x-soft"
+	SSML_PROSODY_SOFT    = "soft"
+	SSML_PROSODY_LOUD    = "loud"
+	SSML_PROSODY_X_LOUD  = "x-loud"
+	SSML_PROSODY_DEFAULT = "default"
+)
+
+// SSMLProsody : The attributes of an SSML `<prosody>` element. Empty attributes are omitted.
+type SSMLProsody struct {
+
+	// The speaking rate, such as SSML_PROSODY_SLOW or `+10%`.
+	Rate string
+
+	// The baseline pitch, such as SSML_PROSODY_HIGH or `-2st`.
+	Pitch string
+
+	// The volume, such as SSML_PROSODY_LOUD or `+6dB`.
+	Volume string
+}
+
+// SSMLBuilder : Builds SSML input for Synthesize and SynthesizeUsingWebsocket
+// Text and attribute values are escaped, and elements are always closed, so the markup is well formed. Build returns an
+// error for the first attribute value that the service does not accept.
+type SSMLBuilder struct {
+	buffer bytes.Buffer
+	err    error
+}
+
+// NewSSMLBuilder : Instantiate SSMLBuilder
+func NewSSMLBuilder() *SSMLBuilder {
+	return &SSMLBuilder{}
+}
+
+// Text : Adds text to be spoken
+func (builder *SSMLBuilder) Text(text string) *SSMLBuilder {
+	xml.EscapeText(&builder.buffer, []byte(text))
+	return builder
+}
+
+// Break : Adds a pause of a strength, such as SSML_BREAK_MEDIUM
+func (builder *SSMLBuilder) Break(strength string) *SSMLBuilder {
+	builder.check("break strength", strength, SSML_BREAK_NONE, SSML_BREAK_X_WEAK, SSML_BREAK_WEAK, SSML_BREAK_MEDIUM, SSML_BREAK_STRONG, SSML_BREAK_X_STRONG)
+	builder.element("break", []string{"strength", strength}, nil)
+	return builder
+}
+
+// BreakTime : Adds a pause of a duration, which is rounded to milliseconds
+func (builder *SSMLBuilder) BreakTime(duration time.Duration) *SSMLBuilder {
+	if duration < 0 {
+		builder.fail(fmt.Errorf("break time must not be negative, found %s", duration))
+	}
+	builder.element("break", []string{"time", fmt.Sprintf("%dms", duration/time.Millisecond)}, nil)
+	return builder
+}
+
+// SayAs : Adds text that is spoken as a type of content, such as SSML_SAY_AS_DIGITS
+func (builder *SSMLBuilder) SayAs(interpretAs string, text string) *SSMLBuilder {
+	builder.check("say-as interpret-as", interpretAs, SSML_SAY_AS_LETTERS, SSML_SAY_AS_DIGITS, SSML_SAY_AS_CARDINAL, SSML_SAY_AS_ORDINAL, SSML_SAY_AS_NUMBER, SSML_SAY_AS_VXML_DATE, SSML_SAY_AS_VXML_TIME, SSML_SAY_AS_VXML_PHONE, SSML_SAY_AS_DATE, SSML_SAY_AS_TIME, SSML_SAY_AS_TELEPHONE, SSML_SAY_AS_ADDRESS, SSML_SAY_AS_INTERJECTION)
+	builder.element("say-as", []string{"interpret-as", interpretAs}, func(builder *SSMLBuilder) {
+		builder.Text(text)
+	})
+	return builder
+}
+
+// Phoneme : Adds text that is spoken with a phonetic translation in an alphabet, SSML_PHONEME_IPA or SSML_PHONEME_IBM
+func (builder *SSMLBuilder) Phoneme(alphabet string, translation string, text string) *SSMLBuilder {
+	builder.check("phoneme alphabet", alphabet, SSML_PHONEME_IPA, SSML_PHONEME_IBM)
+	builder.element("phoneme", []string{"alphabet", alphabet, "ph", translation}, func(builder *SSMLBuilder) {
+		builder.Text(text)
+	})
+	return builder
+}
+
+// Emphasis : Adds text that is spoken with a level of emphasis, such as SSML_EMPHASIS_STRONG
+func (builder *SSMLBuilder) Emphasis(level string, text string) *SSMLBuilder {
+	builder.check("emphasis level", level, SSML_EMPHASIS_STRONG, SSML_EMPHASIS_MODERATE, SSML_EMPHASIS_NONE, SSML_EMPHASIS_REDUCED)
+	builder.element("emphasis", []string{"level", level}, func(builder *SSMLBuilder) {
+		builder.Text(text)
+	})
+	return builder
+}
+
+// Prosody : Adds the content that the function builds, spoken with the rate, pitch, and volume of the prosody
+func (builder *SSMLBuilder) Prosody(prosody SSMLProsody, content func(*SSMLBuilder)) *SSMLBuilder {
+	builder.element("prosody", []string{"rate", prosody.Rate, "pitch", prosody.Pitch, "volume", prosody.Volume}, content)
+	return builder
+}
+
+// Mark : Adds a mark, whose time in the audio is reported by OnMarks of SynthesizeUsingWebsocket
+func (builder *SSMLBuilder) Mark(name string) *SSMLBuilder {
+	builder.element("mark", []string{"name", name}, nil)
+	return builder
+}
+
+// Build : Returns the SSML document, or the first error of the values that were added
+func (builder *SSMLBuilder) Build() (string, error) {
+	if builder.err != nil {
+		return "", builder.err
+	}
+	return `<speak version="1.0">` + builder.buffer.String() + `</speak>`, nil
+}
+
+// element : Writes an element with the attributes, given as name and value pairs of which those with empty values are
+// omitted, and the content that the function builds
+func (builder *SSMLBuilder) element(name string, attributes []string, content func(*SSMLBuilder)) {
+	builder.buffer.WriteString("<" + name)
+	for i := 0; i+1 < len(attributes); i += 2 {
+		if attributes[i+1] == "" {
+			continue
+		}
+		builder.buffer.WriteString(" " + attributes[i] + `="`)
+		xml.EscapeText(&builder.buffer, []byte(attributes[i+1]))
+		builder.buffer.WriteString(`"`)
+	}
+	if content == nil {
+		builder.buffer.WriteString("/>")
+		return
+	}
+	builder.buffer.WriteString(">")
+	content(builder)
+	builder.buffer.WriteString("</" + name + ">")
+}
+
+// check : Records an error if the value is not one of the allowed values
+func (builder *SSMLBuilder) check(attribute string, value string, allowed ...string) {
+	for _, allowedValue := range allowed {
+		if value == allowedValue {
+			return
+		}
+	}
+	builder.fail(fmt.Errorf("%s '%s' is not supported", attribute, value))
+}
+
+func (builder *SSMLBuilder) fail(err error) {
+	if builder.err == nil {
+		builder.err = err
+	}
+}
+
+// SetSSML : Allow user to set Text to the SSML document of a builder
+func (options *SynthesizeOptions) SetSSML(builder *SSMLBuilder) (*SynthesizeOptions, error) {
+	ssml, err := builder.Build()
+	if err != nil {
+		return options, err
+	}
+	return options.SetText(ssml), nil
+}
@@ -0,0 +1,43 @@
+package texttospeechv1_test
+
+import (
+	"time"
+
+	"github.com/edwindvinas/go-sdk/texttospeechv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSMLBuilder", func() {
+	Describe("Build()", func() {
+		It("Succeed to call Build", func() {
+			ssml, err := texttospeechv1.NewSSMLBuilder().
+				Text("Tom & Jerry ").
+				Break(texttospeechv1.SSML_BREAK_MEDIUM).
+				SayAs(texttospeechv1.SSML_SAY_AS_DIGITS, "123").
+				BreakTime(1500*time.Millisecond).
+				Phoneme(texttospeechv1.SSML_PHONEME_IPA, "təˈmɑto", "tomato").
+				Prosody(texttospeechv1.SSMLProsody{Rate: texttospeechv1.SSML_PROSODY_SLOW, Pitch: "+10%"}, func(builder *texttospeechv1.SSMLBuilder) {
+					builder.Emphasis(texttospeechv1.SSML_EMPHASIS_STRONG, "now").Mark("end")
+				}).
+				Build()
+			Expect(err).To(BeNil())
+			Expect(ssml).To(Equal(`<speak version="1.0">Tom &amp; Jerry <break strength="medium"/>` +
+				`<say-as interpret-as="digits">123</say-as><break time="1500ms"/>` +
+				`<phoneme alphabet="ipa" ph="təˈmɑto">tomato</phoneme>` +
+				`<prosody rate="slow" pitch="+10%"><emphasis level="strong">now</emphasis><mark name="end"/></prosody></speak>`))
+		})
+		It("Fail to call Build", func() {
+			_, err := texttospeechv1.NewSSMLBuilder().Text("hello").Break("long").Build()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe("SetSSML(builder *SSMLBuilder)", func() {
+		It("Succeed to call SetSSML", func() {
+			synthesizeOptions, err := (&texttospeechv1.SynthesizeOptions{}).SetSSML(texttospeechv1.NewSSMLBuilder().Text("hi"))
+			Expect(err).To(BeNil())
+			Expect(*synthesizeOptions.Text).To(Equal(`<speak version="1.0">hi</speak>`))
+		})
+	})
+})
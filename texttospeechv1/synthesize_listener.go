@@ -131,6 +131,8 @@ func (textToSpeechV1 *TextToSpeechV1) NewSynthesizeListener(callback SynthesizeC
 	conn, _, err := websocket.DefaultDialer.Dial(req.URL.String(), req.Header)
 	if err != nil {
 		synthesizeListener.OnError(err)
+		callback.OnClose()
+		return
 	}
 
 	go synthesizeListener.OnData(conn)
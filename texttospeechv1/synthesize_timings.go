@@ -0,0 +1,75 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package texttospeechv1
+
+// WordTiming : The start and end time of a word of the input text in the synthesized audio
+type WordTiming struct {
+
+	// The word, as it appears in the input text.
+	Word string
+
+	// The start time of the word in seconds from the beginning of the audio.
+	Start float64
+
+	// The end time of the word in seconds from the beginning of the audio.
+	End float64
+}
+
+// MarkTiming : The time of an SSML `<mark>` element of the input text in the synthesized audio
+type MarkTiming struct {
+
+	// The name of the mark.
+	Mark string
+
+	// The time of the mark in seconds from the beginning of the audio.
+	Time float64
+}
+
+// WordTimings : Returns the word timings of a timing message, as received by OnTimingInformation. Entries that do not
+// have the form `["word", start, end]` are skipped.
+func (timings Timings) WordTimings() []WordTiming {
+	wordTimings := make([]WordTiming, 0, len(timings.Words))
+	for _, entry := range timings.Words {
+		if len(entry) != 3 {
+			continue
+		}
+		word, wordOk := entry[0].(string)
+		start, startOk := entry[1].(float64)
+		end, endOk := entry[2].(float64)
+		if wordOk && startOk && endOk {
+			wordTimings = append(wordTimings, WordTiming{Word: word, Start: start, End: end})
+		}
+	}
+	return wordTimings
+}
+
+// MarkTimings : Returns the mark timings of a marks message, as received by OnMarks. Entries that do not have the
+// form `["mark", time]` are skipped.
+func (marks Marks) MarkTimings() []MarkTiming {
+	markTimings := make([]MarkTiming, 0, len(marks.Marks))
+	for _, entry := range marks.Marks {
+		if len(entry) != 2 {
+			continue
+		}
+		mark, markOk := entry[0].(string)
+		time, timeOk := entry[1].(float64)
+		if markOk && timeOk {
+			markTimings = append(markTimings, MarkTiming{Mark: mark, Time: time})
+		}
+	}
+	return markTimings
+}
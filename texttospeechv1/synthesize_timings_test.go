@@ -0,0 +1,31 @@
+package texttospeechv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/edwindvinas/go-sdk/texttospeechv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SynthesizeTimings", func() {
+	Describe("WordTimings()", func() {
+		It("Succeed to call WordTimings", func() {
+			var timings texttospeechv1.Timings
+			Expect(json.Unmarshal([]byte(`{"words": [["Hello", 0.0, 0.259], ["world", 0.259, 0.6], ["bad"]]}`), &timings)).To(Succeed())
+			Expect(timings.WordTimings()).To(Equal([]texttospeechv1.WordTiming{
+				{Word: "Hello", Start: 0.0, End: 0.259},
+				{Word: "world", Start: 0.259, End: 0.6},
+			}))
+		})
+	})
+	Describe("MarkTimings()", func() {
+		It("Succeed to call MarkTimings", func() {
+			var marks texttospeechv1.Marks
+			Expect(json.Unmarshal([]byte(`{"marks": [["here", 0.5]]}`), &marks)).To(Succeed())
+			Expect(marks.MarkTimings()).To(Equal([]texttospeechv1.MarkTiming{
+				{Mark: "here", Time: 0.5},
+			}))
+		})
+	})
+})
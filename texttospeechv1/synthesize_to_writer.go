@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package texttospeechv1
+
+import (
+	"io"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+)
+
+// MAX_WAV_HEADER_SIZE is the largest WAV header that SynthesizeToWriter reads while looking for the data chunk.
+const MAX_WAV_HEADER_SIZE = 64 * 1024
+
+// SynthesizeToWriter : Synthesize audio and write it to a writer as it arrives
+// Returns the number of bytes written. The service streams WAV audio with a header of placeholder sizes; if the writer
+// is an io.WriteSeeker, such as an *os.File, the header is rewritten with the sizes of the audio once all of it has
+// been written. Other writers, such as an http.ResponseWriter, receive the header as the service sends it.
+func (textToSpeech *TextToSpeechV1) SynthesizeToWriter(synthesizeOptions *SynthesizeOptions, writer io.Writer) (written int64, response *core.DetailedResponse, err error) {
+	result, response, err := textToSpeech.Synthesize(synthesizeOptions)
+	if err != nil {
+		return
+	}
+	defer result.Close()
+
+	seeker, seekable := writer.(io.WriteSeeker)
+	var start int64
+	if seekable {
+		start, err = seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return
+		}
+	}
+
+	header, dataOffset, err := readWAVHeader(result)
+	if err != nil {
+		return
+	}
+	n, err := writer.Write(header)
+	written += int64(n)
+	if err != nil {
+		return
+	}
+	copied, err := io.Copy(writer, result)
+	written += copied
+	if err != nil || dataOffset == 0 || !seekable {
+		return
+	}
+
+	// The header is written again, with the sizes of the sample data, over the header of placeholder sizes
+	repairWAVHeader(header[:dataOffset], written-int64(dataOffset))
+	if _, err = seeker.Seek(start, io.SeekStart); err != nil {
+		return
+	}
+	if _, err = seeker.Write(header[:dataOffset]); err != nil {
+		return
+	}
+	_, err = seeker.Seek(start+written, io.SeekStart)
+	return
+}
+
+// readWAVHeader : Reads the start of the audio until it contains the header of WAV audio, and returns the bytes that
+// were read and the size of the header. The size is 0 if the audio is not WAV audio or its header was not found.
+func readWAVHeader(reader io.Reader) ([]byte, int, error) {
+	header := make([]byte, 0, 1024)
+	buffer := make([]byte, 1024)
+	for len(header) < MAX_WAV_HEADER_SIZE {
+		n, err := reader.Read(buffer)
+		header = append(header, buffer[:n]...)
+		if len(header) >= 12 {
+			if !isWAV(header) {
+				return header, 0, nil
+			}
+			if dataOffset, findErr := findWAVData(header); findErr == nil {
+				return header, dataOffset, nil
+			}
+		}
+		if err == io.EOF {
+			return header, 0, nil
+		}
+		if err != nil {
+			return header, 0, err
+		}
+	}
+	return header, 0, nil
+}
@@ -0,0 +1,91 @@
+package texttospeechv1_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/texttospeechv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SynthesizeToWriter", func() {
+	Describe("SynthesizeToWriter(synthesizeOptions *SynthesizeOptions, writer io.Writer)", func() {
+		username := "user1"
+		password := "pass1"
+		samples := "sample data of the streamed audio"
+		Context("Successfully - Synthesize audio to a writer", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v1/synthesize"))
+				res.Header().Set("Content-Type", "audio/wav")
+				res.WriteHeader(http.StatusOK)
+				_, _ = res.Write(streamedWAV(samples))
+			}))
+			testService, testServiceErr := texttospeechv1.
+				NewTextToSpeechV1(&texttospeechv1.TextToSpeechV1Options{
+					URL: testServer.URL,
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+			It("Succeed to call SynthesizeToWriter with a file", func() {
+				Expect(testServiceErr).To(BeNil())
+
+				file, err := ioutil.TempFile("", "synthesize")
+				Expect(err).To(BeNil())
+				defer os.Remove(file.Name())
+				defer file.Close()
+
+				synthesizeOptions := testService.NewSynthesizeOptions("exampleString").SetAccept("audio/wav")
+				written, response, err := testService.SynthesizeToWriter(synthesizeOptions, file)
+				Expect(err).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(written).To(Equal(int64(44 + len(samples))))
+
+				audio, err := ioutil.ReadFile(file.Name())
+				Expect(err).To(BeNil())
+				Expect(string(audio[44:])).To(Equal(samples))
+				Expect(binary.LittleEndian.Uint32(audio[4:8])).To(Equal(uint32(36 + len(samples))))
+				Expect(binary.LittleEndian.Uint32(audio[40:44])).To(Equal(uint32(len(samples))))
+			})
+			It("Succeed to call SynthesizeToWriter with a buffer", func() {
+				defer testServer.Close()
+				Expect(testServiceErr).To(BeNil())
+
+				var buffer bytes.Buffer
+				synthesizeOptions := testService.NewSynthesizeOptions("exampleString").SetAccept("audio/wav")
+				written, _, err := testService.SynthesizeToWriter(synthesizeOptions, &buffer)
+				Expect(err).To(BeNil())
+				Expect(written).To(Equal(int64(buffer.Len())))
+				Expect(buffer.Bytes()).To(Equal(streamedWAV(samples)))
+			})
+		})
+		Context("Unsuccessfully - Synthesize audio to a writer", func() {
+			testService, testServiceErr := texttospeechv1.
+				NewTextToSpeechV1(&texttospeechv1.TextToSpeechV1Options{
+					URL: "http://localhost",
+					Authenticator: &core.BasicAuthenticator{
+						Username: username,
+						Password: password,
+					},
+				})
+			It("Fail to call SynthesizeToWriter", func() {
+				Expect(testServiceErr).To(BeNil())
+
+				var buffer bytes.Buffer
+				_, _, err := testService.SynthesizeToWriter(nil, &buffer)
+				Expect(err).ToNot(BeNil())
+				Expect(buffer.Len()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -22,6 +22,7 @@ import (
 	"github.com/edwindvinas/go-sdk-core/core"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 )
 
 // TextToSpeechV1 : The IBM&reg; Text to Speech service provides APIs that use IBM's speech-synthesis capabilities to
@@ -52,6 +53,7 @@ const defaultServiceURL = "https://stream.watsonplatform.net/text-to-speech/api"
 type TextToSpeechV1Options struct {
 	URL           string
 	Authenticator core.Authenticator
+	HTTPClient    *http.Client
 }
 
 // NewTextToSpeechV1 : Instantiate TextToSpeechV1
@@ -66,7 +68,7 @@ func NewTextToSpeechV1(options *TextToSpeechV1Options) (service *TextToSpeechV1,
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("text_to_speech")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("text_to_speech")
 		if err != nil {
 			return
 		}
@@ -77,6 +79,10 @@ func NewTextToSpeechV1(options *TextToSpeechV1Options) (service *TextToSpeechV1,
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &TextToSpeechV1{
 		Service: baseService,
 	}
@@ -132,6 +138,7 @@ func (textToSpeech *TextToSpeechV1) ListVoices(listVoicesOptions *ListVoicesOpti
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(Voices))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Voices)
@@ -190,6 +197,7 @@ func (textToSpeech *TextToSpeechV1) GetVoice(getVoiceOptions *GetVoiceOptions) (
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(Voice))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Voice)
@@ -310,6 +318,7 @@ func (textToSpeech *TextToSpeechV1) Synthesize(synthesizeOptions *SynthesizeOpti
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(io.ReadCloser))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
@@ -377,6 +386,7 @@ func (textToSpeech *TextToSpeechV1) GetPronunciation(getPronunciationOptions *Ge
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(Pronunciation))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Pronunciation)
@@ -449,6 +459,7 @@ func (textToSpeech *TextToSpeechV1) CreateVoiceModel(createVoiceModelOptions *Cr
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(VoiceModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*VoiceModel)
@@ -506,6 +517,7 @@ func (textToSpeech *TextToSpeechV1) ListVoiceModels(listVoiceModelsOptions *List
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(VoiceModels))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*VoiceModels)
@@ -594,6 +606,7 @@ func (textToSpeech *TextToSpeechV1) UpdateVoiceModel(updateVoiceModelOptions *Up
 	}
 
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -643,6 +656,7 @@ func (textToSpeech *TextToSpeechV1) GetVoiceModel(getVoiceModelOptions *GetVoice
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(VoiceModel))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*VoiceModel)
@@ -696,6 +710,7 @@ func (textToSpeech *TextToSpeechV1) DeleteVoiceModel(deleteVoiceModelOptions *De
 	}
 
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -771,6 +786,7 @@ func (textToSpeech *TextToSpeechV1) AddWords(addWordsOptions *AddWordsOptions) (
 	}
 
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -820,6 +836,7 @@ func (textToSpeech *TextToSpeechV1) ListWords(listWordsOptions *ListWordsOptions
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(Words))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Words)
@@ -903,6 +920,7 @@ func (textToSpeech *TextToSpeechV1) AddWord(addWordOptions *AddWordOptions) (res
 	}
 
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -951,6 +969,7 @@ func (textToSpeech *TextToSpeechV1) GetWord(getWordOptions *GetWordOptions) (res
 	}
 
 	response, err = textToSpeech.Service.Request(request, new(Translation))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Translation)
@@ -1004,6 +1023,7 @@ func (textToSpeech *TextToSpeechV1) DeleteWord(deleteWordOptions *DeleteWordOpti
 	}
 
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -1054,6 +1074,7 @@ func (textToSpeech *TextToSpeechV1) DeleteUserData(deleteUserDataOptions *Delete
 	}
 
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
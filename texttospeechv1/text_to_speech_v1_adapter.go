@@ -25,11 +25,12 @@ import (
 )
 
 // Timings : An array of words and their start and end times in seconds from the beginning of the synthesized audio.
+// Use WordTimings to read them.
 type Timings struct {
 	Words [][]interface{} `json:"words,omitempty"`
 }
 
-// Timings : An array of mark times
+// Marks : An array of mark times. Use MarkTimings to read them.
 type Marks struct {
 	Marks [][]interface{} `json:"marks"`
 }
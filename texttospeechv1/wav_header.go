@@ -0,0 +1,55 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package texttospeechv1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// isWAV : Returns whether the audio starts with a RIFF WAVE header
+func isWAV(audio []byte) bool {
+	return len(audio) >= 12 && bytes.Equal(audio[0:4], []byte("RIFF")) && bytes.Equal(audio[8:12], []byte("WAVE"))
+}
+
+// findWAVData : Returns the offset of the sample data of WAV audio, which is the size of its header. The size fields
+// of the header are not used, because the service writes placeholder sizes in streamed WAV audio.
+func findWAVData(audio []byte) (int, error) {
+	if !isWAV(audio) {
+		return 0, fmt.Errorf("audio is not WAV audio")
+	}
+	offset := 12
+	for offset+8 <= len(audio) {
+		chunkID := string(audio[offset : offset+4])
+		chunkSize := int64(binary.LittleEndian.Uint32(audio[offset+4 : offset+8]))
+		if chunkID == "data" {
+			return offset + 8, nil
+		}
+		offset += 8 + int(chunkSize+chunkSize%2)
+	}
+	return 0, fmt.Errorf("WAV audio has no data chunk")
+}
+
+// repairWAVHeader : Sets the RIFF and data chunk sizes of a WAV header, which ends with the data chunk header, for
+// sample data of the size. Sizes that do not fit in 32 bits are set to the largest size.
+func repairWAVHeader(header []byte, dataSize int64) {
+	riffSize := int64(len(header)) - 8 + dataSize
+	binary.LittleEndian.PutUint32(header[4:8], uint32(math.Min(float64(riffSize), math.MaxUint32)))
+	binary.LittleEndian.PutUint32(header[len(header)-4:], uint32(math.Min(float64(dataSize), math.MaxUint32)))
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"github.com/edwindvinas/go-sdk-core/core"
 	common "github.com/edwindvinas/go-sdk/common"
+	"net/http"
 	"strings"
 )
 
@@ -47,6 +48,7 @@ type ToneAnalyzerV3Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewToneAnalyzerV3 : Instantiate ToneAnalyzerV3
@@ -61,7 +63,7 @@ func NewToneAnalyzerV3(options *ToneAnalyzerV3Options) (service *ToneAnalyzerV3,
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("tone_analyzer")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("tone_analyzer")
 		if err != nil {
 			return
 		}
@@ -72,6 +74,10 @@ func NewToneAnalyzerV3(options *ToneAnalyzerV3Options) (service *ToneAnalyzerV3,
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &ToneAnalyzerV3{
 		Service: baseService,
 		Version: options.Version,
@@ -165,6 +171,7 @@ func (toneAnalyzer *ToneAnalyzerV3) Tone(toneOptions *ToneOptions) (result *Tone
 	}
 
 	response, err = toneAnalyzer.Service.Request(request, new(ToneAnalysis))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ToneAnalysis)
@@ -241,6 +248,7 @@ func (toneAnalyzer *ToneAnalyzerV3) ToneChat(toneChatOptions *ToneChatOptions) (
 	}
 
 	response, err = toneAnalyzer.Service.Request(request, new(UtteranceAnalyses))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*UtteranceAnalyses)
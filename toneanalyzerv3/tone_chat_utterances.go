@@ -0,0 +1,149 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toneanalyzerv3
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+)
+
+const (
+	// MAX_TONE_CHAT_UTTERANCE_LENGTH is the largest number of characters of an utterance that the service analyzes
+	// with ToneChat. Longer turns are split into several utterances.
+	MAX_TONE_CHAT_UTTERANCE_LENGTH = 500
+
+	// DEFAULT_TONE_CHAT_MAX_TURN_GAP is the longest silence in seconds between two speaker labels of the same speaker
+	// that are joined into one utterance by default.
+	DEFAULT_TONE_CHAT_MAX_TURN_GAP = 0.5
+)
+
+// hesitationMarker is the word that Speech to Text emits for hesitations such as "uhm" and "uh"
+const hesitationMarker = "%HESITATION"
+
+// ToneChatUtteranceOptions : Options that control how speaker labels are turned into ToneChat utterances
+type ToneChatUtteranceOptions struct {
+
+	// The longest silence in seconds between two labels of the same speaker that are joined into one utterance.
+	// Zero means the default of DEFAULT_TONE_CHAT_MAX_TURN_GAP.
+	MaxTurnGap float64
+
+	// The users of the utterances, by speaker number. Speakers that are not listed are named `speaker_<number>`.
+	SpeakerNames map[int64]string
+}
+
+// NewToneChatUtteranceOptions : Instantiate ToneChatUtteranceOptions with the default values
+func NewToneChatUtteranceOptions() *ToneChatUtteranceOptions {
+	return &ToneChatUtteranceOptions{
+		MaxTurnGap: DEFAULT_TONE_CHAT_MAX_TURN_GAP,
+	}
+}
+
+// SetMaxTurnGap : Allow user to set MaxTurnGap
+func (options *ToneChatUtteranceOptions) SetMaxTurnGap(maxTurnGap float64) *ToneChatUtteranceOptions {
+	options.MaxTurnGap = maxTurnGap
+	return options
+}
+
+// SetSpeakerName : Allow user to set the name of a speaker
+func (options *ToneChatUtteranceOptions) SetSpeakerName(speaker int64, name string) *ToneChatUtteranceOptions {
+	if options.SpeakerNames == nil {
+		options.SpeakerNames = map[int64]string{}
+	}
+	options.SpeakerNames[speaker] = name
+	return options
+}
+
+// ToneChatUtterances : Turns the speaker turns of Speech to Text recognition results into utterances for ToneChat,
+// one for each turn, in the order of the conversation.
+// The words of the best alternative of each final result are assigned to the turn during which they start; hesitation
+// markers are dropped. Turns longer than MAX_TONE_CHAT_UTTERANCE_LENGTH are split at word boundaries. The results must
+// have been requested with `speaker_labels` and `timestamps` set to `true`.
+func ToneChatUtterances(results *speechtotextv1.SpeechRecognitionResults, options *ToneChatUtteranceOptions) []Utterance {
+	options = toneChatUtteranceOptionsWithDefaults(options)
+	turns := speechtotextv1.SpeakerTurns(results, options.MaxTurnGap)
+	if len(turns) == 0 {
+		return nil
+	}
+
+	words := make([][]string, len(turns))
+	turn := 0
+	for _, result := range results.Results {
+		if result.Final != nil && !*result.Final || len(result.Alternatives) == 0 {
+			continue
+		}
+		for _, timestamp := range result.Alternatives[0].Timestamps {
+			if timestamp.Word == hesitationMarker {
+				continue
+			}
+			for turn+1 < len(turns) && turns[turn+1].Start <= timestamp.Start {
+				turn++
+			}
+			words[turn] = append(words[turn], timestamp.Word)
+		}
+	}
+
+	var utterances []Utterance
+	for i, turn := range turns {
+		user, ok := options.SpeakerNames[turn.Speaker]
+		if !ok {
+			user = fmt.Sprintf("speaker_%d", turn.Speaker)
+		}
+		for _, text := range joinUtteranceWords(words[i], MAX_TONE_CHAT_UTTERANCE_LENGTH) {
+			utterances = append(utterances, Utterance{
+				Text: core.StringPtr(text),
+				User: core.StringPtr(user),
+			})
+		}
+	}
+	return utterances
+}
+
+// joinUtteranceWords : Joins words with spaces into texts of at most maxLength characters. A single word that is
+// longer than maxLength is kept whole.
+func joinUtteranceWords(words []string, maxLength int) []string {
+	var texts []string
+	var text strings.Builder
+	for _, word := range words {
+		if text.Len() > 0 && text.Len()+1+len(word) > maxLength {
+			texts = append(texts, text.String())
+			text.Reset()
+		}
+		if text.Len() > 0 {
+			text.WriteByte(' ')
+		}
+		text.WriteString(word)
+	}
+	if text.Len() > 0 {
+		texts = append(texts, text.String())
+	}
+	return texts
+}
+
+func toneChatUtteranceOptionsWithDefaults(options *ToneChatUtteranceOptions) *ToneChatUtteranceOptions {
+	withDefaults := NewToneChatUtteranceOptions()
+	if options == nil {
+		return withDefaults
+	}
+	if options.MaxTurnGap > 0 {
+		withDefaults.MaxTurnGap = options.MaxTurnGap
+	}
+	withDefaults.SpeakerNames = options.SpeakerNames
+	return withDefaults
+}
@@ -0,0 +1,70 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toneanalyzerv3_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/edwindvinas/go-sdk/speechtotextv1"
+	"github.com/edwindvinas/go-sdk/toneanalyzerv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ToneChatUtterances`, func() {
+	Describe(`ToneChatUtterances(results *speechtotextv1.SpeechRecognitionResults, options *ToneChatUtteranceOptions)`, func() {
+		It(`Succeed to call ToneChatUtterances`, func() {
+			var results speechtotextv1.SpeechRecognitionResults
+			err := json.Unmarshal([]byte(`{"results": [
+				{"final": true, "alternatives": [{"transcript": "hello how can I help",
+					"timestamps": [["hello",0.5,0.9],["how",0.9,1.0],["can",1.0,1.1],["I",1.1,1.2],["help",1.2,1.4]]}]},
+				{"final": true, "alternatives": [{"transcript": "%HESITATION my order is late",
+					"timestamps": [["%HESITATION",2.0,2.2],["my",2.2,2.5],["order",2.6,2.8],["is",2.8,2.9],["late",2.9,3.0]]}]}],
+				"speaker_labels": [
+				{"from": 0.5, "to": 0.9, "speaker": 0, "confidence": 0.6, "final": true},
+				{"from": 0.9, "to": 1.4, "speaker": 0, "confidence": 0.8, "final": true},
+				{"from": 2.0, "to": 2.5, "speaker": 1, "confidence": 0.5, "final": true},
+				{"from": 2.6, "to": 3.0, "speaker": 1, "confidence": 0.7, "final": true}]}`), &results)
+			Expect(err).To(BeNil())
+
+			options := toneanalyzerv3.NewToneChatUtteranceOptions().SetSpeakerName(0, "agent")
+			utterances := toneanalyzerv3.ToneChatUtterances(&results, options)
+			Expect(utterances).To(HaveLen(2))
+			Expect(*utterances[0].Text).To(Equal("hello how can I help"))
+			Expect(*utterances[0].User).To(Equal("agent"))
+			Expect(*utterances[1].Text).To(Equal("my order is late"))
+			Expect(*utterances[1].User).To(Equal("speaker_1"))
+		})
+		It(`Succeed to split long turns`, func() {
+			var results speechtotextv1.SpeechRecognitionResults
+			timestamps := make([]string, 200)
+			for i := range timestamps {
+				timestamps[i] = `["word",1.0,1.1]`
+			}
+			err := json.Unmarshal([]byte(`{"results": [{"final": true, "alternatives": [{"transcript": "",
+				"timestamps": [`+strings.Join(timestamps, ",")+`]}]}],
+				"speaker_labels": [{"from": 1.0, "to": 1.1, "speaker": 0, "confidence": 0.6, "final": true}]}`), &results)
+			Expect(err).To(BeNil())
+
+			utterances := toneanalyzerv3.ToneChatUtterances(&results, nil)
+			Expect(utterances).To(HaveLen(2))
+			Expect(len(*utterances[0].Text)).To(BeNumerically("<=", toneanalyzerv3.MAX_TONE_CHAT_UTTERANCE_LENGTH))
+			Expect(*utterances[1].User).To(Equal("speaker_0"))
+		})
+	})
+})
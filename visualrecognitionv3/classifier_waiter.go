@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2019.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package visualrecognitionv3
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edwindvinas/go-sdk/common"
+)
+
+// DEFAULT_CLASSIFIER_POLL_INTERVAL is the interval at which WaitForClassifier checks the status of a classifier by
+// default.
+const DEFAULT_CLASSIFIER_POLL_INTERVAL = 10 * time.Second
+
+// WaitForClassifierOptions : Options that control how the training of a custom classifier is awaited
+type WaitForClassifierOptions struct {
+
+	// The interval at which the status of the classifier is checked. Defaults to DEFAULT_CLASSIFIER_POLL_INTERVAL.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the classifier to be trained. Zero means no limit.
+	Timeout time.Duration
+}
+
+// NewWaitForClassifierOptions : Instantiate WaitForClassifierOptions
+func (visualRecognition *VisualRecognitionV3) NewWaitForClassifierOptions() *WaitForClassifierOptions {
+	return &WaitForClassifierOptions{
+		PollInterval: DEFAULT_CLASSIFIER_POLL_INTERVAL,
+	}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (options *WaitForClassifierOptions) SetPollInterval(pollInterval time.Duration) *WaitForClassifierOptions {
+	options.PollInterval = pollInterval
+	return options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (options *WaitForClassifierOptions) SetTimeout(timeout time.Duration) *WaitForClassifierOptions {
+	options.Timeout = timeout
+	return options
+}
+
+// WaitForClassifier : Waits until a classifier that was created or updated has been trained, and returns it.
+// The classifier is trained when its status is `ready`. An error is returned if its status is `failed` or the wait
+// times out. The waitForClassifierOptions can be nil.
+func (visualRecognition *VisualRecognitionV3) WaitForClassifier(getClassifierOptions *GetClassifierOptions, waitForClassifierOptions *WaitForClassifierOptions) (*Classifier, error) {
+	return visualRecognition.WaitForClassifierWithContext(context.Background(), getClassifierOptions, waitForClassifierOptions)
+}
+
+// WaitForClassifierWithContext is an alternate form of the WaitForClassifier method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) WaitForClassifierWithContext(ctx context.Context, getClassifierOptions *GetClassifierOptions, waitForClassifierOptions *WaitForClassifierOptions) (*Classifier, error) {
+	if waitForClassifierOptions == nil {
+		waitForClassifierOptions = visualRecognition.NewWaitForClassifierOptions()
+	}
+	pollInterval := waitForClassifierOptions.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DEFAULT_CLASSIFIER_POLL_INTERVAL
+	}
+
+	var classifier *Classifier
+	var status string
+	err := common.Poll(ctx, pollInterval, waitForClassifierOptions.Timeout, func() (bool, error) {
+		var err error
+		classifier, _, err = visualRecognition.GetClassifier(getClassifierOptions)
+		if err != nil {
+			return false, err
+		}
+
+		status = ""
+		if classifier.Status != nil {
+			status = *classifier.Status
+		}
+		switch status {
+		case Classifier_Status_Ready:
+			return true, nil
+		case Classifier_Status_Failed:
+			explanation := ""
+			if classifier.Explanation != nil {
+				explanation = *classifier.Explanation
+			}
+			return true, fmt.Errorf("Classifier %s failed: %s", *getClassifierOptions.ClassifierID, explanation)
+		}
+		return false, nil
+	})
+	if err == common.ErrPollTimeout {
+		err = fmt.Errorf("Timed out waiting for classifier %s, which is %s", *getClassifierOptions.ClassifierID, status)
+	}
+	return classifier, err
+}
@@ -0,0 +1,86 @@
+package visualrecognitionv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/edwindvinas/go-sdk-core/core"
+
+	"github.com/edwindvinas/go-sdk/visualrecognitionv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClassifierWaiter", func() {
+	Describe("WaitForClassifier(getClassifierOptions *GetClassifierOptions, waitForClassifierOptions *WaitForClassifierOptions)", func() {
+		version := "exampleString"
+		newTestService := func(url string) *visualrecognitionv3.VisualRecognitionV3 {
+			testService, testServiceErr := visualrecognitionv3.NewVisualRecognitionV3(&visualrecognitionv3.VisualRecognitionV3Options{
+				URL:     url,
+				Version: version,
+				Authenticator: &core.BearerTokenAuthenticator{
+					BearerToken: "0ui9876453",
+				},
+			})
+			Expect(testServiceErr).To(BeNil())
+			return testService
+		}
+		Context("Successfully - Wait for a classifier", func() {
+			polls := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(Equal("/v3/classifiers/dogs_1"))
+				polls++
+				status := "training"
+				if polls == 3 {
+					status = "ready"
+				}
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"classifier_id": "dogs_1", "name": "dogs", "status": "%s", "core_ml_enabled": true}`, status)
+			}))
+			It("Succeed to call WaitForClassifier", func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				waitForClassifierOptions := testService.NewWaitForClassifierOptions().SetPollInterval(time.Millisecond)
+				classifier, err := testService.WaitForClassifier(testService.NewGetClassifierOptions("dogs_1"), waitForClassifierOptions)
+				Expect(err).To(BeNil())
+				Expect(*classifier.Status).To(Equal(visualrecognitionv3.Classifier_Status_Ready))
+				Expect(polls).To(Equal(3))
+			})
+		})
+		Context("Unsuccessfully - Wait for a classifier", func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				classifierID := strings.TrimPrefix(req.URL.Path, "/v3/classifiers/")
+				status := "training"
+				if classifierID == "failed" {
+					status = "failed"
+				}
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"classifier_id": "%s", "name": "dogs", "status": "%s", "explanation": "Not enough examples"}`, classifierID, status)
+			}))
+			It("Fail to call WaitForClassifier", func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				classifier, err := testService.WaitForClassifier(testService.NewGetClassifierOptions("failed"), nil)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("Not enough examples"))
+				Expect(*classifier.Status).To(Equal(visualrecognitionv3.Classifier_Status_Failed))
+
+				waitForClassifierOptions := testService.NewWaitForClassifierOptions().
+					SetPollInterval(time.Millisecond).
+					SetTimeout(10 * time.Millisecond)
+				classifier, err = testService.WaitForClassifier(testService.NewGetClassifierOptions("training"), waitForClassifierOptions)
+				Expect(err).ToNot(BeNil())
+				Expect(*classifier.Status).To(Equal(visualrecognitionv3.Classifier_Status_Training))
+			})
+		})
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	common "github.com/edwindvinas/go-sdk/common"
 	"io"
+	"net/http"
 	"strings"
 )
 
@@ -44,6 +45,7 @@ type VisualRecognitionV3Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewVisualRecognitionV3 : Instantiate VisualRecognitionV3
@@ -58,7 +60,7 @@ func NewVisualRecognitionV3(options *VisualRecognitionV3Options) (service *Visua
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("watson_vision_combined")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("watson_vision_combined")
 		if err != nil {
 			return
 		}
@@ -69,6 +71,10 @@ func NewVisualRecognitionV3(options *VisualRecognitionV3Options) (service *Visua
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &VisualRecognitionV3{
 		Service: baseService,
 		Version: options.Version,
@@ -150,6 +156,7 @@ func (visualRecognition *VisualRecognitionV3) Classify(classifyOptions *Classify
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(ClassifiedImages))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ClassifiedImages)
@@ -221,6 +228,7 @@ func (visualRecognition *VisualRecognitionV3) CreateClassifier(createClassifierO
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(Classifier))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -270,6 +278,7 @@ func (visualRecognition *VisualRecognitionV3) ListClassifiers(listClassifiersOpt
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(Classifiers))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifiers)
@@ -320,6 +329,7 @@ func (visualRecognition *VisualRecognitionV3) GetClassifier(getClassifierOptions
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(Classifier))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -398,6 +408,7 @@ func (visualRecognition *VisualRecognitionV3) UpdateClassifier(updateClassifierO
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(Classifier))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -447,6 +458,7 @@ func (visualRecognition *VisualRecognitionV3) DeleteClassifier(deleteClassifierO
 	}
 
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -491,6 +503,7 @@ func (visualRecognition *VisualRecognitionV3) GetCoreMlModel(getCoreMlModelOptio
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(io.ReadCloser))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
@@ -548,6 +561,7 @@ func (visualRecognition *VisualRecognitionV3) DeleteUserData(deleteUserDataOptio
 	}
 
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -20,6 +20,7 @@ package visualrecognitionv4
 import (
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/edwindvinas/go-sdk-core/core"
@@ -44,6 +45,7 @@ type VisualRecognitionV4Options struct {
 	URL           string
 	Authenticator core.Authenticator
 	Version       string
+	HTTPClient    *http.Client
 }
 
 // NewVisualRecognitionV4 : Instantiate VisualRecognitionV4
@@ -58,7 +60,7 @@ func NewVisualRecognitionV4(options *VisualRecognitionV4Options) (service *Visua
 	}
 
 	if serviceOptions.Authenticator == nil {
-		serviceOptions.Authenticator, err = core.GetAuthenticatorFromEnvironment("watson_vision_combined")
+		serviceOptions.Authenticator, err = common.GetAuthenticatorFromEnvironment("watson_vision_combined")
 		if err != nil {
 			return
 		}
@@ -69,6 +71,10 @@ func NewVisualRecognitionV4(options *VisualRecognitionV4Options) (service *Visua
 		return
 	}
 
+	if options.HTTPClient != nil {
+		baseService.SetHTTPClient(options.HTTPClient)
+	}
+
 	service = &VisualRecognitionV4{
 		Service: baseService,
 		Version: options.Version,
@@ -150,6 +156,7 @@ func (visualRecognition *VisualRecognitionV4) Analyze(analyzeOptions *AnalyzeOpt
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(AnalyzeResponse))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AnalyzeResponse)
@@ -218,6 +225,7 @@ func (visualRecognition *VisualRecognitionV4) CreateCollection(createCollectionO
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(Collection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -264,6 +272,7 @@ func (visualRecognition *VisualRecognitionV4) ListCollections(listCollectionsOpt
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(CollectionsList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CollectionsList)
@@ -314,6 +323,7 @@ func (visualRecognition *VisualRecognitionV4) GetCollection(getCollectionOptions
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(Collection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -380,6 +390,7 @@ func (visualRecognition *VisualRecognitionV4) UpdateCollection(updateCollectionO
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(Collection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -430,6 +441,7 @@ func (visualRecognition *VisualRecognitionV4) DeleteCollection(deleteCollectionO
 	}
 
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -494,6 +506,7 @@ func (visualRecognition *VisualRecognitionV4) AddImages(addImagesOptions *AddIma
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(ImageDetailsList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ImageDetailsList)
@@ -544,6 +557,7 @@ func (visualRecognition *VisualRecognitionV4) ListImages(listImagesOptions *List
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(ImageSummaryList))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ImageSummaryList)
@@ -594,6 +608,7 @@ func (visualRecognition *VisualRecognitionV4) GetImageDetails(getImageDetailsOpt
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(ImageDetails))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ImageDetails)
@@ -644,6 +659,7 @@ func (visualRecognition *VisualRecognitionV4) DeleteImage(deleteImageOptions *De
 	}
 
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }
@@ -691,6 +707,7 @@ func (visualRecognition *VisualRecognitionV4) GetJpegImage(getJpegImageOptions *
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(io.ReadCloser))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
@@ -743,6 +760,7 @@ func (visualRecognition *VisualRecognitionV4) Train(trainOptions *TrainOptions)
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(Collection))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -810,6 +828,7 @@ func (visualRecognition *VisualRecognitionV4) AddImageTrainingData(addImageTrain
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(TrainingDataObjects))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingDataObjects)
@@ -864,6 +883,7 @@ func (visualRecognition *VisualRecognitionV4) GetTrainingUsage(getTrainingUsageO
 	}
 
 	response, err = visualRecognition.Service.Request(request, new(TrainingEvents))
+	err = common.NewServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingEvents)
@@ -921,6 +941,7 @@ func (visualRecognition *VisualRecognitionV4) DeleteUserData(deleteUserDataOptio
 	}
 
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.NewServiceError(response, err)
 
 	return
 }